@@ -139,10 +139,12 @@ type ListBloggersRequest struct {
 	Page *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	// 模糊查询关键字 (将作用于 blogger_name 字段)
 	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	// 排序字段 (例如: "blogger_fans_num", "updated_at")
+	// 排序字段，仅支持白名单内的字段：blogger_fans_num, created_at, updated_at
 	SortBy string `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 	// 排序方向 (ASC / DESC)
-	SortOrder     SortOrder `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=SortOrder" json:"sort_order,omitempty"`
+	SortOrder SortOrder `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=SortOrder" json:"sort_order,omitempty"`
+	// 结构化过滤条件
+	Filter        *BloggerFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -205,6 +207,13 @@ func (x *ListBloggersRequest) GetSortOrder() SortOrder {
 	return SortOrder_UNSORTED
 }
 
+func (x *ListBloggersRequest) GetFilter() *BloggerFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 // 分页查询视频博主响应
 type ListBloggersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -347,11 +356,718 @@ func (x *BloggerQueryResponse) GetBlogger() *BloggerDTO {
 	return nil
 }
 
+// 博主画像查询请求
+type BloggerProfileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 博主ID
+	BloggerId int64 `protobuf:"varint,1,opt,name=blogger_id,json=bloggerId,proto3" json:"blogger_id,omitempty"`
+	// 统计带货、销量和上榜情况使用的榜单周期：day, week, month，默认 day
+	RankType string `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 开始日期 (YYYYMMDD)，为空时不限制，作用于榜单和粉丝趋势
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 结束日期 (YYYYMMDD)，为空时不限制，作用于榜单和粉丝趋势
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 返回的作品数量，按发布时间倒序，默认 20，最大 100
+	VideoLimit    int32 `protobuf:"varint,5,opt,name=video_limit,json=videoLimit,proto3" json:"video_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BloggerProfileRequest) Reset() {
+	*x = BloggerProfileRequest{}
+	mi := &file_v1_blogger_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BloggerProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloggerProfileRequest) ProtoMessage() {}
+
+func (x *BloggerProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_blogger_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloggerProfileRequest.ProtoReflect.Descriptor instead.
+func (*BloggerProfileRequest) Descriptor() ([]byte, []int) {
+	return file_v1_blogger_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BloggerProfileRequest) GetBloggerId() int64 {
+	if x != nil {
+		return x.BloggerId
+	}
+	return 0
+}
+
+func (x *BloggerProfileRequest) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *BloggerProfileRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *BloggerProfileRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *BloggerProfileRequest) GetVideoLimit() int32 {
+	if x != nil {
+		return x.VideoLimit
+	}
+	return 0
+}
+
+// 博主带货的商品
+type BloggerProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       string                 `protobuf:"bytes,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	GoodsTitle    string                 `protobuf:"bytes,2,opt,name=goods_title,json=goodsTitle,proto3" json:"goods_title,omitempty"`
+	GoodsCoverUrl string                 `protobuf:"bytes,3,opt,name=goods_cover_url,json=goodsCoverUrl,proto3" json:"goods_cover_url,omitempty"`
+	BrandName     string                 `protobuf:"bytes,4,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"`
+	// 带货该商品并上榜的视频数
+	VideoCount int64 `protobuf:"varint,5,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	// 上榜记录数
+	RankAppearances int64 `protobuf:"varint,6,opt,name=rank_appearances,json=rankAppearances,proto3" json:"rank_appearances,omitempty"`
+	// 累计榜单销量范围
+	SalesCountLow  int64 `protobuf:"varint,7,opt,name=sales_count_low,json=salesCountLow,proto3" json:"sales_count_low,omitempty"`
+	SalesCountHigh int64 `protobuf:"varint,8,opt,name=sales_count_high,json=salesCountHigh,proto3" json:"sales_count_high,omitempty"`
+	// 累计榜单销售额范围（分）
+	TotalSalesLow  int64 `protobuf:"varint,9,opt,name=total_sales_low,json=totalSalesLow,proto3" json:"total_sales_low,omitempty"`
+	TotalSalesHigh int64 `protobuf:"varint,10,opt,name=total_sales_high,json=totalSalesHigh,proto3" json:"total_sales_high,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BloggerProduct) Reset() {
+	*x = BloggerProduct{}
+	mi := &file_v1_blogger_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BloggerProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloggerProduct) ProtoMessage() {}
+
+func (x *BloggerProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_blogger_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloggerProduct.ProtoReflect.Descriptor instead.
+func (*BloggerProduct) Descriptor() ([]byte, []int) {
+	return file_v1_blogger_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BloggerProduct) GetGoodsId() string {
+	if x != nil {
+		return x.GoodsId
+	}
+	return ""
+}
+
+func (x *BloggerProduct) GetGoodsTitle() string {
+	if x != nil {
+		return x.GoodsTitle
+	}
+	return ""
+}
+
+func (x *BloggerProduct) GetGoodsCoverUrl() string {
+	if x != nil {
+		return x.GoodsCoverUrl
+	}
+	return ""
+}
+
+func (x *BloggerProduct) GetBrandName() string {
+	if x != nil {
+		return x.BrandName
+	}
+	return ""
+}
+
+func (x *BloggerProduct) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *BloggerProduct) GetRankAppearances() int64 {
+	if x != nil {
+		return x.RankAppearances
+	}
+	return 0
+}
+
+func (x *BloggerProduct) GetSalesCountLow() int64 {
+	if x != nil {
+		return x.SalesCountLow
+	}
+	return 0
+}
+
+func (x *BloggerProduct) GetSalesCountHigh() int64 {
+	if x != nil {
+		return x.SalesCountHigh
+	}
+	return 0
+}
+
+func (x *BloggerProduct) GetTotalSalesLow() int64 {
+	if x != nil {
+		return x.TotalSalesLow
+	}
+	return 0
+}
+
+func (x *BloggerProduct) GetTotalSalesHigh() int64 {
+	if x != nil {
+		return x.TotalSalesHigh
+	}
+	return 0
+}
+
+// 博主粉丝数的一个观测点
+type BloggerFansPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 日期 (YYYYMMDD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// 粉丝数
+	Fans int64 `protobuf:"varint,2,opt,name=fans,proto3" json:"fans,omitempty"`
+	// 数据来源：trend（视频趋势）或 rank（榜单快照），同一天两者都有时取 trend
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BloggerFansPoint) Reset() {
+	*x = BloggerFansPoint{}
+	mi := &file_v1_blogger_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BloggerFansPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloggerFansPoint) ProtoMessage() {}
+
+func (x *BloggerFansPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_blogger_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloggerFansPoint.ProtoReflect.Descriptor instead.
+func (*BloggerFansPoint) Descriptor() ([]byte, []int) {
+	return file_v1_blogger_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BloggerFansPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BloggerFansPoint) GetFans() int64 {
+	if x != nil {
+		return x.Fans
+	}
+	return 0
+}
+
+func (x *BloggerFansPoint) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// 博主上榜情况
+type BloggerRankStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 上榜记录数
+	Appearances int64 `protobuf:"varint,1,opt,name=appearances,proto3" json:"appearances,omitempty"`
+	// 上榜的不同视频数
+	VideoCount int64 `protobuf:"varint,2,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	// 上榜的期数
+	RankDates int64 `protobuf:"varint,3,opt,name=rank_dates,json=rankDates,proto3" json:"rank_dates,omitempty"`
+	// 最好名次，0 表示未知
+	BestPosition int32 `protobuf:"varint,4,opt,name=best_position,json=bestPosition,proto3" json:"best_position,omitempty"`
+	// 首次、最近一次上榜日期 (YYYYMMDD)
+	FirstSeenDate string `protobuf:"bytes,5,opt,name=first_seen_date,json=firstSeenDate,proto3" json:"first_seen_date,omitempty"`
+	LastSeenDate  string `protobuf:"bytes,6,opt,name=last_seen_date,json=lastSeenDate,proto3" json:"last_seen_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BloggerRankStats) Reset() {
+	*x = BloggerRankStats{}
+	mi := &file_v1_blogger_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BloggerRankStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloggerRankStats) ProtoMessage() {}
+
+func (x *BloggerRankStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_blogger_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloggerRankStats.ProtoReflect.Descriptor instead.
+func (*BloggerRankStats) Descriptor() ([]byte, []int) {
+	return file_v1_blogger_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BloggerRankStats) GetAppearances() int64 {
+	if x != nil {
+		return x.Appearances
+	}
+	return 0
+}
+
+func (x *BloggerRankStats) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *BloggerRankStats) GetRankDates() int64 {
+	if x != nil {
+		return x.RankDates
+	}
+	return 0
+}
+
+func (x *BloggerRankStats) GetBestPosition() int32 {
+	if x != nil {
+		return x.BestPosition
+	}
+	return 0
+}
+
+func (x *BloggerRankStats) GetFirstSeenDate() string {
+	if x != nil {
+		return x.FirstSeenDate
+	}
+	return ""
+}
+
+func (x *BloggerRankStats) GetLastSeenDate() string {
+	if x != nil {
+		return x.LastSeenDate
+	}
+	return ""
+}
+
+// 博主画像查询响应
+type BloggerProfileResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Blogger  *BloggerDTO            `protobuf:"bytes,1,opt,name=blogger,proto3" json:"blogger,omitempty"`
+	RankType string                 `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 作品总数
+	VideoCount int64 `protobuf:"varint,3,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	// 最近的作品
+	Videos []*VideoDTO `protobuf:"bytes,4,rep,name=videos,proto3" json:"videos,omitempty"`
+	// 带货商品，按累计销售额倒序
+	Products []*BloggerProduct `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`
+	// 累计榜单销量范围
+	SalesCountLow  int64 `protobuf:"varint,6,opt,name=sales_count_low,json=salesCountLow,proto3" json:"sales_count_low,omitempty"`
+	SalesCountHigh int64 `protobuf:"varint,7,opt,name=sales_count_high,json=salesCountHigh,proto3" json:"sales_count_high,omitempty"`
+	// 累计榜单销售额范围（分）
+	TotalSalesLow  int64 `protobuf:"varint,8,opt,name=total_sales_low,json=totalSalesLow,proto3" json:"total_sales_low,omitempty"`
+	TotalSalesHigh int64 `protobuf:"varint,9,opt,name=total_sales_high,json=totalSalesHigh,proto3" json:"total_sales_high,omitempty"`
+	// 按日期升序的粉丝数变化
+	FansHistory []*BloggerFansPoint `protobuf:"bytes,10,rep,name=fans_history,json=fansHistory,proto3" json:"fans_history,omitempty"`
+	// 上榜情况
+	RankStats     *BloggerRankStats `protobuf:"bytes,11,opt,name=rank_stats,json=rankStats,proto3" json:"rank_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BloggerProfileResponse) Reset() {
+	*x = BloggerProfileResponse{}
+	mi := &file_v1_blogger_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BloggerProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloggerProfileResponse) ProtoMessage() {}
+
+func (x *BloggerProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_blogger_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloggerProfileResponse.ProtoReflect.Descriptor instead.
+func (*BloggerProfileResponse) Descriptor() ([]byte, []int) {
+	return file_v1_blogger_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BloggerProfileResponse) GetBlogger() *BloggerDTO {
+	if x != nil {
+		return x.Blogger
+	}
+	return nil
+}
+
+func (x *BloggerProfileResponse) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *BloggerProfileResponse) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *BloggerProfileResponse) GetVideos() []*VideoDTO {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *BloggerProfileResponse) GetProducts() []*BloggerProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *BloggerProfileResponse) GetSalesCountLow() int64 {
+	if x != nil {
+		return x.SalesCountLow
+	}
+	return 0
+}
+
+func (x *BloggerProfileResponse) GetSalesCountHigh() int64 {
+	if x != nil {
+		return x.SalesCountHigh
+	}
+	return 0
+}
+
+func (x *BloggerProfileResponse) GetTotalSalesLow() int64 {
+	if x != nil {
+		return x.TotalSalesLow
+	}
+	return 0
+}
+
+func (x *BloggerProfileResponse) GetTotalSalesHigh() int64 {
+	if x != nil {
+		return x.TotalSalesHigh
+	}
+	return 0
+}
+
+func (x *BloggerProfileResponse) GetFansHistory() []*BloggerFansPoint {
+	if x != nil {
+		return x.FansHistory
+	}
+	return nil
+}
+
+func (x *BloggerProfileResponse) GetRankStats() *BloggerRankStats {
+	if x != nil {
+		return x.RankStats
+	}
+	return nil
+}
+
+// 博主粉丝增长曲线查询请求
+type BloggerFansHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 博主ID
+	BloggerId int64 `protobuf:"varint,1,opt,name=blogger_id,json=bloggerId,proto3" json:"blogger_id,omitempty"`
+	// 开始日期 (YYYYMMDD)，为空时不限制
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 结束日期 (YYYYMMDD)，为空时不限制
+	EndDate       string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BloggerFansHistoryRequest) Reset() {
+	*x = BloggerFansHistoryRequest{}
+	mi := &file_v1_blogger_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BloggerFansHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloggerFansHistoryRequest) ProtoMessage() {}
+
+func (x *BloggerFansHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_blogger_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloggerFansHistoryRequest.ProtoReflect.Descriptor instead.
+func (*BloggerFansHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_blogger_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BloggerFansHistoryRequest) GetBloggerId() int64 {
+	if x != nil {
+		return x.BloggerId
+	}
+	return 0
+}
+
+func (x *BloggerFansHistoryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *BloggerFansHistoryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 博主某天的粉丝快照
+type BloggerFansSnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 快照日期 (YYYYMMDD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// 粉丝数
+	Fans int64 `protobuf:"varint,2,opt,name=fans,proto3" json:"fans,omitempty"`
+	// 博主标签
+	BloggerTag string `protobuf:"bytes,3,opt,name=blogger_tag,json=bloggerTag,proto3" json:"blogger_tag,omitempty"`
+	// 相对上一个快照的粉丝增量，第一个快照为 0
+	Delta int64 `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 距上一个快照的天数，连续每日采集时为 1，第一个快照为 0
+	DaysSincePrevious int32 `protobuf:"varint,5,opt,name=days_since_previous,json=daysSincePrevious,proto3" json:"days_since_previous,omitempty"`
+	// 相对上一个快照的增长率，上一个快照粉丝数为 0 时为 0
+	GrowthRate    float64 `protobuf:"fixed64,6,opt,name=growth_rate,json=growthRate,proto3" json:"growth_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BloggerFansSnapshot) Reset() {
+	*x = BloggerFansSnapshot{}
+	mi := &file_v1_blogger_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BloggerFansSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloggerFansSnapshot) ProtoMessage() {}
+
+func (x *BloggerFansSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_blogger_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloggerFansSnapshot.ProtoReflect.Descriptor instead.
+func (*BloggerFansSnapshot) Descriptor() ([]byte, []int) {
+	return file_v1_blogger_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BloggerFansSnapshot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BloggerFansSnapshot) GetFans() int64 {
+	if x != nil {
+		return x.Fans
+	}
+	return 0
+}
+
+func (x *BloggerFansSnapshot) GetBloggerTag() string {
+	if x != nil {
+		return x.BloggerTag
+	}
+	return ""
+}
+
+func (x *BloggerFansSnapshot) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *BloggerFansSnapshot) GetDaysSincePrevious() int32 {
+	if x != nil {
+		return x.DaysSincePrevious
+	}
+	return 0
+}
+
+func (x *BloggerFansSnapshot) GetGrowthRate() float64 {
+	if x != nil {
+		return x.GrowthRate
+	}
+	return 0
+}
+
+// 博主粉丝增长曲线查询响应
+type BloggerFansHistoryResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BloggerId int64                  `protobuf:"varint,1,opt,name=blogger_id,json=bloggerId,proto3" json:"blogger_id,omitempty"`
+	// 按日期升序的快照
+	Points []*BloggerFansSnapshot `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	// 区间内首末快照的粉丝增量
+	TotalDelta    int64 `protobuf:"varint,3,opt,name=total_delta,json=totalDelta,proto3" json:"total_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BloggerFansHistoryResponse) Reset() {
+	*x = BloggerFansHistoryResponse{}
+	mi := &file_v1_blogger_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BloggerFansHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BloggerFansHistoryResponse) ProtoMessage() {}
+
+func (x *BloggerFansHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_blogger_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BloggerFansHistoryResponse.ProtoReflect.Descriptor instead.
+func (*BloggerFansHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_blogger_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BloggerFansHistoryResponse) GetBloggerId() int64 {
+	if x != nil {
+		return x.BloggerId
+	}
+	return 0
+}
+
+func (x *BloggerFansHistoryResponse) GetPoints() []*BloggerFansSnapshot {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *BloggerFansHistoryResponse) GetTotalDelta() int64 {
+	if x != nil {
+		return x.TotalDelta
+	}
+	return 0
+}
+
 var File_v1_blogger_proto protoreflect.FileDescriptor
 
 const file_v1_blogger_proto_rawDesc = "" +
 	"\n" +
-	"\x10v1/blogger.proto\x1a\x1cgoogle/api/annotations.proto\x1a\rv1/page.proto\"\x9f\x02\n" +
+	"\x10v1/blogger.proto\x1a\x1cgoogle/api/annotations.proto\x1a\rv1/page.proto\x1a\x0fv1/filter.proto\x1a\x0ev1/video.proto\"\x9f\x02\n" +
 	"\n" +
 	"BloggerDTO\x12\x1d\n" +
 	"\n" +
@@ -366,14 +1082,15 @@ const file_v1_blogger_proto_rawDesc = "" +
 	"\x0eblogger_avatar\x18\x06 \x01(\tR\rbloggerAvatar\x12(\n" +
 	"\x10blogger_fans_num\x18\a \x01(\x03R\x0ebloggerFansNum\x12\x1f\n" +
 	"\vblogger_tag\x18\b \x01(\tR\n" +
-	"bloggerTag\"\x91\x01\n" +
+	"bloggerTag\"\xb9\x01\n" +
 	"\x13ListBloggersRequest\x12 \n" +
 	"\x04page\x18\x01 \x01(\v2\f.PageRequestR\x04page\x12\x14\n" +
 	"\x05query\x18\x02 \x01(\tR\x05query\x12\x17\n" +
 	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12)\n" +
 	"\n" +
 	"sort_order\x18\x04 \x01(\x0e2\n" +
-	".SortOrderR\tsortOrder\"b\n" +
+	".SortOrderR\tsortOrder\x12&\n" +
+	"\x06filter\x18\x05 \x01(\v2\x0e.BloggerFilterR\x06filter\"b\n" +
 	"\x14ListBloggersResponse\x12!\n" +
 	"\x04page\x18\x01 \x01(\v2\r.PageResponseR\x04page\x12'\n" +
 	"\bbloggers\x18\x02 \x03(\v2\v.BloggerDTOR\bbloggers\"4\n" +
@@ -381,11 +1098,86 @@ const file_v1_blogger_proto_rawDesc = "" +
 	"\n" +
 	"blogger_id\x18\x01 \x01(\x03R\tbloggerId\"=\n" +
 	"\x14BloggerQueryResponse\x12%\n" +
-	"\ablogger\x18\x01 \x01(\v2\v.BloggerDTOR\ablogger2\xc6\x01\n" +
+	"\ablogger\x18\x01 \x01(\v2\v.BloggerDTOR\ablogger\"\xae\x01\n" +
+	"\x15BloggerProfileRequest\x12\x1d\n" +
+	"\n" +
+	"blogger_id\x18\x01 \x01(\x03R\tbloggerId\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1f\n" +
+	"\vvideo_limit\x18\x05 \x01(\x05R\n" +
+	"videoLimit\"\x83\x03\n" +
+	"\x0eBloggerProduct\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\x12\x1f\n" +
+	"\vgoods_title\x18\x02 \x01(\tR\n" +
+	"goodsTitle\x12&\n" +
+	"\x0fgoods_cover_url\x18\x03 \x01(\tR\rgoodsCoverUrl\x12\x1d\n" +
+	"\n" +
+	"brand_name\x18\x04 \x01(\tR\tbrandName\x12\x1f\n" +
+	"\vvideo_count\x18\x05 \x01(\x03R\n" +
+	"videoCount\x12)\n" +
+	"\x10rank_appearances\x18\x06 \x01(\x03R\x0frankAppearances\x12&\n" +
+	"\x0fsales_count_low\x18\a \x01(\x03R\rsalesCountLow\x12(\n" +
+	"\x10sales_count_high\x18\b \x01(\x03R\x0esalesCountHigh\x12&\n" +
+	"\x0ftotal_sales_low\x18\t \x01(\x03R\rtotalSalesLow\x12(\n" +
+	"\x10total_sales_high\x18\n" +
+	" \x01(\x03R\x0etotalSalesHigh\"R\n" +
+	"\x10BloggerFansPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04fans\x18\x02 \x01(\x03R\x04fans\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\"\xe7\x01\n" +
+	"\x10BloggerRankStats\x12 \n" +
+	"\vappearances\x18\x01 \x01(\x03R\vappearances\x12\x1f\n" +
+	"\vvideo_count\x18\x02 \x01(\x03R\n" +
+	"videoCount\x12\x1d\n" +
+	"\n" +
+	"rank_dates\x18\x03 \x01(\x03R\trankDates\x12#\n" +
+	"\rbest_position\x18\x04 \x01(\x05R\fbestPosition\x12&\n" +
+	"\x0ffirst_seen_date\x18\x05 \x01(\tR\rfirstSeenDate\x12$\n" +
+	"\x0elast_seen_date\x18\x06 \x01(\tR\flastSeenDate\"\xd9\x03\n" +
+	"\x16BloggerProfileResponse\x12%\n" +
+	"\ablogger\x18\x01 \x01(\v2\v.BloggerDTOR\ablogger\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12\x1f\n" +
+	"\vvideo_count\x18\x03 \x01(\x03R\n" +
+	"videoCount\x12!\n" +
+	"\x06videos\x18\x04 \x03(\v2\t.VideoDTOR\x06videos\x12+\n" +
+	"\bproducts\x18\x05 \x03(\v2\x0f.BloggerProductR\bproducts\x12&\n" +
+	"\x0fsales_count_low\x18\x06 \x01(\x03R\rsalesCountLow\x12(\n" +
+	"\x10sales_count_high\x18\a \x01(\x03R\x0esalesCountHigh\x12&\n" +
+	"\x0ftotal_sales_low\x18\b \x01(\x03R\rtotalSalesLow\x12(\n" +
+	"\x10total_sales_high\x18\t \x01(\x03R\x0etotalSalesHigh\x124\n" +
+	"\ffans_history\x18\n" +
+	" \x03(\v2\x11.BloggerFansPointR\vfansHistory\x120\n" +
+	"\n" +
+	"rank_stats\x18\v \x01(\v2\x11.BloggerRankStatsR\trankStats\"t\n" +
+	"\x19BloggerFansHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"blogger_id\x18\x01 \x01(\x03R\tbloggerId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"\xc5\x01\n" +
+	"\x13BloggerFansSnapshot\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04fans\x18\x02 \x01(\x03R\x04fans\x12\x1f\n" +
+	"\vblogger_tag\x18\x03 \x01(\tR\n" +
+	"bloggerTag\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x03R\x05delta\x12.\n" +
+	"\x13days_since_previous\x18\x05 \x01(\x05R\x11daysSincePrevious\x12\x1f\n" +
+	"\vgrowth_rate\x18\x06 \x01(\x01R\n" +
+	"growthRate\"\x8a\x01\n" +
+	"\x1aBloggerFansHistoryResponse\x12\x1d\n" +
+	"\n" +
+	"blogger_id\x18\x01 \x01(\x03R\tbloggerId\x12,\n" +
+	"\x06points\x18\x02 \x03(\v2\x14.BloggerFansSnapshotR\x06points\x12\x1f\n" +
+	"\vtotal_delta\x18\x03 \x01(\x03R\n" +
+	"totalDelta2\xa5\x03\n" +
 	"\x0eBloggerService\x12Y\n" +
 	"\n" +
 	"GetBlogger\x12\x14.BloggerQueryRequest\x1a\x15.BloggerQueryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/bloggers/detail\x12Y\n" +
-	"\fListBloggers\x12\x14.ListBloggersRequest\x1a\x15.ListBloggersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/bloggers/listB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
+	"\fListBloggers\x12\x14.ListBloggersRequest\x1a\x15.ListBloggersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/bloggers/list\x12e\n" +
+	"\x11GetBloggerProfile\x12\x16.BloggerProfileRequest\x1a\x17.BloggerProfileResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/bloggers/profile\x12v\n" +
+	"\x15GetBloggerFansHistory\x12\x1a.BloggerFansHistoryRequest\x1a\x1b.BloggerFansHistoryResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/bloggers/fans_historyB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
 
 var (
 	file_v1_blogger_proto_rawDescOnce sync.Once
@@ -399,32 +1191,53 @@ func file_v1_blogger_proto_rawDescGZIP() []byte {
 	return file_v1_blogger_proto_rawDescData
 }
 
-var file_v1_blogger_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_blogger_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_v1_blogger_proto_goTypes = []any{
-	(*BloggerDTO)(nil),           // 0: BloggerDTO
-	(*ListBloggersRequest)(nil),  // 1: ListBloggersRequest
-	(*ListBloggersResponse)(nil), // 2: ListBloggersResponse
-	(*BloggerQueryRequest)(nil),  // 3: BloggerQueryRequest
-	(*BloggerQueryResponse)(nil), // 4: BloggerQueryResponse
-	(*PageRequest)(nil),          // 5: PageRequest
-	(SortOrder)(0),               // 6: SortOrder
-	(*PageResponse)(nil),         // 7: PageResponse
+	(*BloggerDTO)(nil),                 // 0: BloggerDTO
+	(*ListBloggersRequest)(nil),        // 1: ListBloggersRequest
+	(*ListBloggersResponse)(nil),       // 2: ListBloggersResponse
+	(*BloggerQueryRequest)(nil),        // 3: BloggerQueryRequest
+	(*BloggerQueryResponse)(nil),       // 4: BloggerQueryResponse
+	(*BloggerProfileRequest)(nil),      // 5: BloggerProfileRequest
+	(*BloggerProduct)(nil),             // 6: BloggerProduct
+	(*BloggerFansPoint)(nil),           // 7: BloggerFansPoint
+	(*BloggerRankStats)(nil),           // 8: BloggerRankStats
+	(*BloggerProfileResponse)(nil),     // 9: BloggerProfileResponse
+	(*BloggerFansHistoryRequest)(nil),  // 10: BloggerFansHistoryRequest
+	(*BloggerFansSnapshot)(nil),        // 11: BloggerFansSnapshot
+	(*BloggerFansHistoryResponse)(nil), // 12: BloggerFansHistoryResponse
+	(*PageRequest)(nil),                // 13: PageRequest
+	(SortOrder)(0),                     // 14: SortOrder
+	(*BloggerFilter)(nil),              // 15: BloggerFilter
+	(*PageResponse)(nil),               // 16: PageResponse
+	(*VideoDTO)(nil),                   // 17: VideoDTO
 }
 var file_v1_blogger_proto_depIdxs = []int32{
-	5, // 0: ListBloggersRequest.page:type_name -> PageRequest
-	6, // 1: ListBloggersRequest.sort_order:type_name -> SortOrder
-	7, // 2: ListBloggersResponse.page:type_name -> PageResponse
-	0, // 3: ListBloggersResponse.bloggers:type_name -> BloggerDTO
-	0, // 4: BloggerQueryResponse.blogger:type_name -> BloggerDTO
-	3, // 5: BloggerService.GetBlogger:input_type -> BloggerQueryRequest
-	1, // 6: BloggerService.ListBloggers:input_type -> ListBloggersRequest
-	4, // 7: BloggerService.GetBlogger:output_type -> BloggerQueryResponse
-	2, // 8: BloggerService.ListBloggers:output_type -> ListBloggersResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	13, // 0: ListBloggersRequest.page:type_name -> PageRequest
+	14, // 1: ListBloggersRequest.sort_order:type_name -> SortOrder
+	15, // 2: ListBloggersRequest.filter:type_name -> BloggerFilter
+	16, // 3: ListBloggersResponse.page:type_name -> PageResponse
+	0,  // 4: ListBloggersResponse.bloggers:type_name -> BloggerDTO
+	0,  // 5: BloggerQueryResponse.blogger:type_name -> BloggerDTO
+	0,  // 6: BloggerProfileResponse.blogger:type_name -> BloggerDTO
+	17, // 7: BloggerProfileResponse.videos:type_name -> VideoDTO
+	6,  // 8: BloggerProfileResponse.products:type_name -> BloggerProduct
+	7,  // 9: BloggerProfileResponse.fans_history:type_name -> BloggerFansPoint
+	8,  // 10: BloggerProfileResponse.rank_stats:type_name -> BloggerRankStats
+	11, // 11: BloggerFansHistoryResponse.points:type_name -> BloggerFansSnapshot
+	3,  // 12: BloggerService.GetBlogger:input_type -> BloggerQueryRequest
+	1,  // 13: BloggerService.ListBloggers:input_type -> ListBloggersRequest
+	5,  // 14: BloggerService.GetBloggerProfile:input_type -> BloggerProfileRequest
+	10, // 15: BloggerService.GetBloggerFansHistory:input_type -> BloggerFansHistoryRequest
+	4,  // 16: BloggerService.GetBlogger:output_type -> BloggerQueryResponse
+	2,  // 17: BloggerService.ListBloggers:output_type -> ListBloggersResponse
+	9,  // 18: BloggerService.GetBloggerProfile:output_type -> BloggerProfileResponse
+	12, // 19: BloggerService.GetBloggerFansHistory:output_type -> BloggerFansHistoryResponse
+	16, // [16:20] is the sub-list for method output_type
+	12, // [12:16] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_v1_blogger_proto_init() }
@@ -433,13 +1246,15 @@ func file_v1_blogger_proto_init() {
 		return
 	}
 	file_v1_page_proto_init()
+	file_v1_filter_proto_init()
+	file_v1_video_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_blogger_proto_rawDesc), len(file_v1_blogger_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
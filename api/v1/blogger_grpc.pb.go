@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BloggerService_GetBlogger_FullMethodName   = "/BloggerService/GetBlogger"
-	BloggerService_ListBloggers_FullMethodName = "/BloggerService/ListBloggers"
+	BloggerService_GetBlogger_FullMethodName            = "/BloggerService/GetBlogger"
+	BloggerService_ListBloggers_FullMethodName          = "/BloggerService/ListBloggers"
+	BloggerService_GetBloggerProfile_FullMethodName     = "/BloggerService/GetBloggerProfile"
+	BloggerService_GetBloggerFansHistory_FullMethodName = "/BloggerService/GetBloggerFansHistory"
 )
 
 // BloggerServiceClient is the client API for BloggerService service.
@@ -33,6 +35,10 @@ type BloggerServiceClient interface {
 	GetBlogger(ctx context.Context, in *BloggerQueryRequest, opts ...grpc.CallOption) (*BloggerQueryResponse, error)
 	// 分页查询视频博主信息
 	ListBloggers(ctx context.Context, in *ListBloggersRequest, opts ...grpc.CallOption) (*ListBloggersResponse, error)
+	// 查询博主画像：作品、带货商品、累计销量、粉丝变化和上榜情况
+	GetBloggerProfile(ctx context.Context, in *BloggerProfileRequest, opts ...grpc.CallOption) (*BloggerProfileResponse, error)
+	// 查询博主粉丝增长曲线（基于每日快照），包含相邻快照之间的增量
+	GetBloggerFansHistory(ctx context.Context, in *BloggerFansHistoryRequest, opts ...grpc.CallOption) (*BloggerFansHistoryResponse, error)
 }
 
 type bloggerServiceClient struct {
@@ -63,6 +69,26 @@ func (c *bloggerServiceClient) ListBloggers(ctx context.Context, in *ListBlogger
 	return out, nil
 }
 
+func (c *bloggerServiceClient) GetBloggerProfile(ctx context.Context, in *BloggerProfileRequest, opts ...grpc.CallOption) (*BloggerProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BloggerProfileResponse)
+	err := c.cc.Invoke(ctx, BloggerService_GetBloggerProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bloggerServiceClient) GetBloggerFansHistory(ctx context.Context, in *BloggerFansHistoryRequest, opts ...grpc.CallOption) (*BloggerFansHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BloggerFansHistoryResponse)
+	err := c.cc.Invoke(ctx, BloggerService_GetBloggerFansHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BloggerServiceServer is the server API for BloggerService service.
 // All implementations must embed UnimplementedBloggerServiceServer
 // for forward compatibility.
@@ -73,6 +99,10 @@ type BloggerServiceServer interface {
 	GetBlogger(context.Context, *BloggerQueryRequest) (*BloggerQueryResponse, error)
 	// 分页查询视频博主信息
 	ListBloggers(context.Context, *ListBloggersRequest) (*ListBloggersResponse, error)
+	// 查询博主画像：作品、带货商品、累计销量、粉丝变化和上榜情况
+	GetBloggerProfile(context.Context, *BloggerProfileRequest) (*BloggerProfileResponse, error)
+	// 查询博主粉丝增长曲线（基于每日快照），包含相邻快照之间的增量
+	GetBloggerFansHistory(context.Context, *BloggerFansHistoryRequest) (*BloggerFansHistoryResponse, error)
 	mustEmbedUnimplementedBloggerServiceServer()
 }
 
@@ -89,6 +119,12 @@ func (UnimplementedBloggerServiceServer) GetBlogger(context.Context, *BloggerQue
 func (UnimplementedBloggerServiceServer) ListBloggers(context.Context, *ListBloggersRequest) (*ListBloggersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBloggers not implemented")
 }
+func (UnimplementedBloggerServiceServer) GetBloggerProfile(context.Context, *BloggerProfileRequest) (*BloggerProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBloggerProfile not implemented")
+}
+func (UnimplementedBloggerServiceServer) GetBloggerFansHistory(context.Context, *BloggerFansHistoryRequest) (*BloggerFansHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBloggerFansHistory not implemented")
+}
 func (UnimplementedBloggerServiceServer) mustEmbedUnimplementedBloggerServiceServer() {}
 func (UnimplementedBloggerServiceServer) testEmbeddedByValue()                        {}
 
@@ -146,6 +182,42 @@ func _BloggerService_ListBloggers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BloggerService_GetBloggerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BloggerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloggerServiceServer).GetBloggerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BloggerService_GetBloggerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloggerServiceServer).GetBloggerProfile(ctx, req.(*BloggerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BloggerService_GetBloggerFansHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BloggerFansHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BloggerServiceServer).GetBloggerFansHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BloggerService_GetBloggerFansHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BloggerServiceServer).GetBloggerFansHistory(ctx, req.(*BloggerFansHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BloggerService_ServiceDesc is the grpc.ServiceDesc for BloggerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +233,14 @@ var BloggerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBloggers",
 			Handler:    _BloggerService_ListBloggers_Handler,
 		},
+		{
+			MethodName: "GetBloggerProfile",
+			Handler:    _BloggerService_GetBloggerProfile_Handler,
+		},
+		{
+			MethodName: "GetBloggerFansHistory",
+			Handler:    _BloggerService_GetBloggerFansHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/blogger.proto",
@@ -20,11 +20,17 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationBloggerServiceGetBlogger = "/BloggerService/GetBlogger"
+const OperationBloggerServiceGetBloggerFansHistory = "/BloggerService/GetBloggerFansHistory"
+const OperationBloggerServiceGetBloggerProfile = "/BloggerService/GetBloggerProfile"
 const OperationBloggerServiceListBloggers = "/BloggerService/ListBloggers"
 
 type BloggerServiceHTTPServer interface {
 	// GetBlogger 查询单个视频博主信息
 	GetBlogger(context.Context, *BloggerQueryRequest) (*BloggerQueryResponse, error)
+	// GetBloggerFansHistory 查询博主粉丝增长曲线（基于每日快照），包含相邻快照之间的增量
+	GetBloggerFansHistory(context.Context, *BloggerFansHistoryRequest) (*BloggerFansHistoryResponse, error)
+	// GetBloggerProfile 查询博主画像：作品、带货商品、累计销量、粉丝变化和上榜情况
+	GetBloggerProfile(context.Context, *BloggerProfileRequest) (*BloggerProfileResponse, error)
 	// ListBloggers 分页查询视频博主信息
 	ListBloggers(context.Context, *ListBloggersRequest) (*ListBloggersResponse, error)
 }
@@ -33,6 +39,8 @@ func RegisterBloggerServiceHTTPServer(s *http.Server, srv BloggerServiceHTTPServ
 	r := s.Route("/")
 	r.POST("/v1/bloggers/detail", _BloggerService_GetBlogger0_HTTP_Handler(srv))
 	r.POST("/v1/bloggers/list", _BloggerService_ListBloggers0_HTTP_Handler(srv))
+	r.POST("/v1/bloggers/profile", _BloggerService_GetBloggerProfile0_HTTP_Handler(srv))
+	r.POST("/v1/bloggers/fans_history", _BloggerService_GetBloggerFansHistory0_HTTP_Handler(srv))
 }
 
 func _BloggerService_GetBlogger0_HTTP_Handler(srv BloggerServiceHTTPServer) func(ctx http.Context) error {
@@ -79,8 +87,54 @@ func _BloggerService_ListBloggers0_HTTP_Handler(srv BloggerServiceHTTPServer) fu
 	}
 }
 
+func _BloggerService_GetBloggerProfile0_HTTP_Handler(srv BloggerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BloggerProfileRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBloggerServiceGetBloggerProfile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBloggerProfile(ctx, req.(*BloggerProfileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BloggerProfileResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BloggerService_GetBloggerFansHistory0_HTTP_Handler(srv BloggerServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BloggerFansHistoryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBloggerServiceGetBloggerFansHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBloggerFansHistory(ctx, req.(*BloggerFansHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BloggerFansHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type BloggerServiceHTTPClient interface {
 	GetBlogger(ctx context.Context, req *BloggerQueryRequest, opts ...http.CallOption) (rsp *BloggerQueryResponse, err error)
+	GetBloggerFansHistory(ctx context.Context, req *BloggerFansHistoryRequest, opts ...http.CallOption) (rsp *BloggerFansHistoryResponse, err error)
+	GetBloggerProfile(ctx context.Context, req *BloggerProfileRequest, opts ...http.CallOption) (rsp *BloggerProfileResponse, err error)
 	ListBloggers(ctx context.Context, req *ListBloggersRequest, opts ...http.CallOption) (rsp *ListBloggersResponse, err error)
 }
 
@@ -105,6 +159,32 @@ func (c *BloggerServiceHTTPClientImpl) GetBlogger(ctx context.Context, in *Blogg
 	return &out, nil
 }
 
+func (c *BloggerServiceHTTPClientImpl) GetBloggerFansHistory(ctx context.Context, in *BloggerFansHistoryRequest, opts ...http.CallOption) (*BloggerFansHistoryResponse, error) {
+	var out BloggerFansHistoryResponse
+	pattern := "/v1/bloggers/fans_history"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBloggerServiceGetBloggerFansHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BloggerServiceHTTPClientImpl) GetBloggerProfile(ctx context.Context, in *BloggerProfileRequest, opts ...http.CallOption) (*BloggerProfileResponse, error) {
+	var out BloggerProfileResponse
+	pattern := "/v1/bloggers/profile"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBloggerServiceGetBloggerProfile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BloggerServiceHTTPClientImpl) ListBloggers(ctx context.Context, in *ListBloggersRequest, opts ...http.CallOption) (*ListBloggersResponse, error) {
 	var out ListBloggersResponse
 	pattern := "/v1/bloggers/list"
@@ -21,6 +21,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TotalMode 定义了分页时总数的统计方式
+type TotalMode int32
+
+const (
+	// 默认：偏移分页精确统计，游标分页不统计
+	TotalMode_TOTAL_MODE_DEFAULT TotalMode = 0
+	// 不统计总数
+	TotalMode_TOTAL_MODE_NONE TotalMode = 1
+	// 精确统计（COUNT(*)，数据量大时较慢）
+	TotalMode_TOTAL_MODE_EXACT TotalMode = 2
+	// 使用查询计划估算，速度快但不精确
+	TotalMode_TOTAL_MODE_APPROXIMATE TotalMode = 3
+)
+
+// Enum value maps for TotalMode.
+var (
+	TotalMode_name = map[int32]string{
+		0: "TOTAL_MODE_DEFAULT",
+		1: "TOTAL_MODE_NONE",
+		2: "TOTAL_MODE_EXACT",
+		3: "TOTAL_MODE_APPROXIMATE",
+	}
+	TotalMode_value = map[string]int32{
+		"TOTAL_MODE_DEFAULT":     0,
+		"TOTAL_MODE_NONE":        1,
+		"TOTAL_MODE_EXACT":       2,
+		"TOTAL_MODE_APPROXIMATE": 3,
+	}
+)
+
+func (x TotalMode) Enum() *TotalMode {
+	p := new(TotalMode)
+	*p = x
+	return p
+}
+
+func (x TotalMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TotalMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_page_proto_enumTypes[0].Descriptor()
+}
+
+func (TotalMode) Type() protoreflect.EnumType {
+	return &file_v1_page_proto_enumTypes[0]
+}
+
+func (x TotalMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TotalMode.Descriptor instead.
+func (TotalMode) EnumDescriptor() ([]byte, []int) {
+	return file_v1_page_proto_rawDescGZIP(), []int{0}
+}
+
 // SortOrder 定义了排序方向
 type SortOrder int32
 
@@ -58,11 +115,11 @@ func (x SortOrder) String() string {
 }
 
 func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_page_proto_enumTypes[0].Descriptor()
+	return file_v1_page_proto_enumTypes[1].Descriptor()
 }
 
 func (SortOrder) Type() protoreflect.EnumType {
-	return &file_v1_page_proto_enumTypes[0]
+	return &file_v1_page_proto_enumTypes[1]
 }
 
 func (x SortOrder) Number() protoreflect.EnumNumber {
@@ -71,14 +128,18 @@ func (x SortOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortOrder.Descriptor instead.
 func (SortOrder) EnumDescriptor() ([]byte, []int) {
-	return file_v1_page_proto_rawDescGZIP(), []int{0}
+	return file_v1_page_proto_rawDescGZIP(), []int{1}
 }
 
 // 分页请求
+// 默认使用 page/size 偏移分页；cursor 为 true 或 page_token 非空时使用游标（keyset）分页，此时忽略 page
 type PageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // 页码
-	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"` // 每页数量
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                                           // 页码
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                                           // 每页数量
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                 // 游标，取上一页响应中的 next_page_token，首页为空
+	Cursor        bool                   `protobuf:"varint,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                                       // 是否使用游标分页，首页请求 page_token 为空时需要置为 true
+	TotalMode     TotalMode              `protobuf:"varint,5,opt,name=total_mode,json=totalMode,proto3,enum=TotalMode" json:"total_mode,omitempty"` // 总数统计方式
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -127,12 +188,35 @@ func (x *PageRequest) GetSize() int64 {
 	return 0
 }
 
+func (x *PageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *PageRequest) GetCursor() bool {
+	if x != nil {
+		return x.Cursor
+	}
+	return false
+}
+
+func (x *PageRequest) GetTotalMode() TotalMode {
+	if x != nil {
+		return x.TotalMode
+	}
+	return TotalMode_TOTAL_MODE_DEFAULT
+}
+
 // 分页响应
 type PageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总记录数
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Total            int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                                               // 总记录数，total_mode 为 NONE 时为0
+	NextPageToken    string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`         // 下一页游标，为空表示没有更多数据（仅游标分页）
+	TotalApproximate bool                   `protobuf:"varint,3,opt,name=total_approximate,json=totalApproximate,proto3" json:"total_approximate,omitempty"` // total 是否为估算值
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *PageResponse) Reset() {
@@ -172,16 +256,43 @@ func (x *PageResponse) GetTotal() int64 {
 	return 0
 }
 
+func (x *PageResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *PageResponse) GetTotalApproximate() bool {
+	if x != nil {
+		return x.TotalApproximate
+	}
+	return false
+}
+
 var File_v1_page_proto protoreflect.FileDescriptor
 
 const file_v1_page_proto_rawDesc = "" +
 	"\n" +
-	"\rv1/page.proto\"5\n" +
+	"\rv1/page.proto\"\x97\x01\n" +
 	"\vPageRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
-	"\x04size\x18\x02 \x01(\x03R\x04size\"$\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\bR\x06cursor\x12)\n" +
+	"\n" +
+	"total_mode\x18\x05 \x01(\x0e2\n" +
+	".TotalModeR\ttotalMode\"y\n" +
 	"\fPageResponse\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x03R\x05total*,\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12+\n" +
+	"\x11total_approximate\x18\x03 \x01(\bR\x10totalApproximate*j\n" +
+	"\tTotalMode\x12\x16\n" +
+	"\x12TOTAL_MODE_DEFAULT\x10\x00\x12\x13\n" +
+	"\x0fTOTAL_MODE_NONE\x10\x01\x12\x14\n" +
+	"\x10TOTAL_MODE_EXACT\x10\x02\x12\x1a\n" +
+	"\x16TOTAL_MODE_APPROXIMATE\x10\x03*,\n" +
 	"\tSortOrder\x12\f\n" +
 	"\bUNSORTED\x10\x00\x12\a\n" +
 	"\x03ASC\x10\x01\x12\b\n" +
@@ -199,19 +310,21 @@ func file_v1_page_proto_rawDescGZIP() []byte {
 	return file_v1_page_proto_rawDescData
 }
 
-var file_v1_page_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_page_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_v1_page_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_v1_page_proto_goTypes = []any{
-	(SortOrder)(0),       // 0: SortOrder
-	(*PageRequest)(nil),  // 1: PageRequest
-	(*PageResponse)(nil), // 2: PageResponse
+	(TotalMode)(0),       // 0: TotalMode
+	(SortOrder)(0),       // 1: SortOrder
+	(*PageRequest)(nil),  // 2: PageRequest
+	(*PageResponse)(nil), // 3: PageResponse
 }
 var file_v1_page_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: PageRequest.total_mode:type_name -> TotalMode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_v1_page_proto_init() }
@@ -224,7 +337,7 @@ func file_v1_page_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_page_proto_rawDesc), len(file_v1_page_proto_rawDesc)),
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
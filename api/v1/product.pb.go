@@ -49,6 +49,10 @@ type ProductDTO struct {
 	BrandName string `protobuf:"bytes,11,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"`
 	// 商品类目名称
 	CategoryNames string `protobuf:"bytes,12,opt,name=category_names,json=categoryNames,proto3" json:"category_names,omitempty"`
+	// 归并后的品牌ID，0 表示无品牌
+	BrandId uint64 `protobuf:"varint,13,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	// 归并后的店铺ID，0 表示无店铺
+	ShopId        uint64 `protobuf:"varint,14,opt,name=shop_id,json=shopId,proto3" json:"shop_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -167,6 +171,20 @@ func (x *ProductDTO) GetCategoryNames() string {
 	return ""
 }
 
+func (x *ProductDTO) GetBrandId() uint64 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *ProductDTO) GetShopId() uint64 {
+	if x != nil {
+		return x.ShopId
+	}
+	return 0
+}
+
 // 分页查询商品请求
 type ListProductsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -174,10 +192,12 @@ type ListProductsRequest struct {
 	Page *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	// 模糊查询关键字 (将作用于 goods_title 字段)
 	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	// 排序字段 (例如: "created_at", "goods_price")
+	// 排序字段，仅支持白名单内的字段：created_at, updated_at, goods_price
 	SortBy string `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 	// 排序方向（1: ASC, 2: DESC）
-	SortOrder     SortOrder `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=SortOrder" json:"sort_order,omitempty"`
+	SortOrder SortOrder `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=SortOrder" json:"sort_order,omitempty"`
+	// 结构化过滤条件
+	Filter        *ProductFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -240,6 +260,13 @@ func (x *ListProductsRequest) GetSortOrder() SortOrder {
 	return SortOrder_UNSORTED
 }
 
+func (x *ListProductsRequest) GetFilter() *ProductFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 // 分页查询商品响应
 type ListProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -384,49 +411,1005 @@ func (x *ProductQueryResponse) GetProduct() *ProductDTO {
 	return nil
 }
 
-var File_v1_product_proto protoreflect.FileDescriptor
+// 商品带货表现查询请求
+type ProductPerformanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 商品 ID
+	GoodsId string `protobuf:"bytes,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	// 榜单周期：day, week, month，默认 day
+	RankType string `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 开始日期 (YYYYMMDD)，为空时不限制
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 结束日期 (YYYYMMDD)，为空时不限制
+	EndDate       string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_v1_product_proto_rawDesc = "" +
-	"\n" +
-	"\x10v1/product.proto\x1a\x1cgoogle/api/annotations.proto\x1a\rv1/page.proto\"\xa6\x03\n" +
-	"\n" +
-	"ProductDTO\x12\x19\n" +
-	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1f\n" +
-	"\vgoods_title\x18\x04 \x01(\tR\n" +
-	"goodsTitle\x12&\n" +
-	"\x0fgoods_cover_url\x18\x05 \x01(\tR\rgoodsCoverUrl\x12*\n" +
-	"\x11goods_price_range\x18\x06 \x01(\tR\x0fgoodsPriceRange\x12\x1f\n" +
-	"\vgoods_price\x18\a \x01(\x01R\n" +
-	"goodsPrice\x12\x1b\n" +
-	"\tcos_ratio\x18\b \x01(\tR\bcosRatio\x12)\n" +
-	"\x10commission_price\x18\t \x01(\tR\x0fcommissionPrice\x12\x1b\n" +
-	"\tshop_name\x18\n" +
-	" \x01(\tR\bshopName\x12\x1d\n" +
-	"\n" +
-	"brand_name\x18\v \x01(\tR\tbrandName\x12%\n" +
-	"\x0ecategory_names\x18\f \x01(\tR\rcategoryNames\"\x91\x01\n" +
-	"\x13ListProductsRequest\x12 \n" +
-	"\x04page\x18\x01 \x01(\v2\f.PageRequestR\x04page\x12\x14\n" +
-	"\x05query\x18\x02 \x01(\tR\x05query\x12\x17\n" +
-	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12)\n" +
-	"\n" +
-	"sort_order\x18\x04 \x01(\x0e2\n" +
-	".SortOrderR\tsortOrder\"b\n" +
-	"\x14ListProductsResponse\x12!\n" +
-	"\x04page\x18\x01 \x01(\v2\r.PageResponseR\x04page\x12'\n" +
-	"\bproducts\x18\x02 \x03(\v2\v.ProductDTOR\bproducts\"0\n" +
-	"\x13ProductQueryRequest\x12\x19\n" +
-	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\"=\n" +
-	"\x14ProductQueryResponse\x12%\n" +
-	"\aproduct\x18\x01 \x01(\v2\v.ProductDTOR\aproduct2\xc6\x01\n" +
+func (x *ProductPerformanceRequest) Reset() {
+	*x = ProductPerformanceRequest{}
+	mi := &file_v1_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPerformanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPerformanceRequest) ProtoMessage() {}
+
+func (x *ProductPerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPerformanceRequest.ProtoReflect.Descriptor instead.
+func (*ProductPerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProductPerformanceRequest) GetGoodsId() string {
+	if x != nil {
+		return x.GoodsId
+	}
+	return ""
+}
+
+func (x *ProductPerformanceRequest) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *ProductPerformanceRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ProductPerformanceRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 商品每期的销量汇总
+type ProductDailySales struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 榜单日期 (YYYYMMDD)
+	RankDate string `protobuf:"bytes,1,opt,name=rank_date,json=rankDate,proto3" json:"rank_date,omitempty"`
+	// 当期上榜的带货视频数
+	VideoCount int64 `protobuf:"varint,2,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	// 榜单销量范围之和
+	SalesCountLow  int64 `protobuf:"varint,3,opt,name=sales_count_low,json=salesCountLow,proto3" json:"sales_count_low,omitempty"`
+	SalesCountHigh int64 `protobuf:"varint,4,opt,name=sales_count_high,json=salesCountHigh,proto3" json:"sales_count_high,omitempty"`
+	// 榜单销售额范围之和（分）
+	TotalSalesLow  int64 `protobuf:"varint,5,opt,name=total_sales_low,json=totalSalesLow,proto3" json:"total_sales_low,omitempty"`
+	TotalSalesHigh int64 `protobuf:"varint,6,opt,name=total_sales_high,json=totalSalesHigh,proto3" json:"total_sales_high,omitempty"`
+	// 带货视频在趋势数据中的销量增量之和（仅日榜有值）
+	TrendIncSalesCount int64 `protobuf:"varint,7,opt,name=trend_inc_sales_count,json=trendIncSalesCount,proto3" json:"trend_inc_sales_count,omitempty"`
+	// 带货视频在趋势数据中的销售额增量之和，单位元（仅日榜有值）
+	TrendIncSalesGmv float64 `protobuf:"fixed64,8,opt,name=trend_inc_sales_gmv,json=trendIncSalesGmv,proto3" json:"trend_inc_sales_gmv,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProductDailySales) Reset() {
+	*x = ProductDailySales{}
+	mi := &file_v1_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductDailySales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDailySales) ProtoMessage() {}
+
+func (x *ProductDailySales) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductDailySales.ProtoReflect.Descriptor instead.
+func (*ProductDailySales) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProductDailySales) GetRankDate() string {
+	if x != nil {
+		return x.RankDate
+	}
+	return ""
+}
+
+func (x *ProductDailySales) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *ProductDailySales) GetSalesCountLow() int64 {
+	if x != nil {
+		return x.SalesCountLow
+	}
+	return 0
+}
+
+func (x *ProductDailySales) GetSalesCountHigh() int64 {
+	if x != nil {
+		return x.SalesCountHigh
+	}
+	return 0
+}
+
+func (x *ProductDailySales) GetTotalSalesLow() int64 {
+	if x != nil {
+		return x.TotalSalesLow
+	}
+	return 0
+}
+
+func (x *ProductDailySales) GetTotalSalesHigh() int64 {
+	if x != nil {
+		return x.TotalSalesHigh
+	}
+	return 0
+}
+
+func (x *ProductDailySales) GetTrendIncSalesCount() int64 {
+	if x != nil {
+		return x.TrendIncSalesCount
+	}
+	return 0
+}
+
+func (x *ProductDailySales) GetTrendIncSalesGmv() float64 {
+	if x != nil {
+		return x.TrendIncSalesGmv
+	}
+	return 0
+}
+
+// 商品带货表现查询响应
+type ProductPerformanceResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId  string                 `protobuf:"bytes,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	RankType string                 `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 商品维度信息，商品不存在于维度表时为空
+	Product *ProductDTO `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	// 带货视频数（去重）
+	VideoCount int64 `protobuf:"varint,4,opt,name=video_count,json=videoCount,proto3" json:"video_count,omitempty"`
+	// 带货博主数（去重）
+	BloggerCount int64 `protobuf:"varint,5,opt,name=blogger_count,json=bloggerCount,proto3" json:"blogger_count,omitempty"`
+	// 上榜记录数
+	RankAppearances int64 `protobuf:"varint,6,opt,name=rank_appearances,json=rankAppearances,proto3" json:"rank_appearances,omitempty"`
+	// 首次、最近一次上榜日期 (YYYYMMDD)
+	FirstSeenDate string `protobuf:"bytes,7,opt,name=first_seen_date,json=firstSeenDate,proto3" json:"first_seen_date,omitempty"`
+	LastSeenDate  string `protobuf:"bytes,8,opt,name=last_seen_date,json=lastSeenDate,proto3" json:"last_seen_date,omitempty"`
+	// 累计榜单销量范围
+	SalesCountLow  int64 `protobuf:"varint,9,opt,name=sales_count_low,json=salesCountLow,proto3" json:"sales_count_low,omitempty"`
+	SalesCountHigh int64 `protobuf:"varint,10,opt,name=sales_count_high,json=salesCountHigh,proto3" json:"sales_count_high,omitempty"`
+	// 累计榜单销售额范围（分）
+	TotalSalesLow  int64 `protobuf:"varint,11,opt,name=total_sales_low,json=totalSalesLow,proto3" json:"total_sales_low,omitempty"`
+	TotalSalesHigh int64 `protobuf:"varint,12,opt,name=total_sales_high,json=totalSalesHigh,proto3" json:"total_sales_high,omitempty"`
+	// 按日期升序的每期汇总
+	Daily         []*ProductDailySales `protobuf:"bytes,13,rep,name=daily,proto3" json:"daily,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPerformanceResponse) Reset() {
+	*x = ProductPerformanceResponse{}
+	mi := &file_v1_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPerformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPerformanceResponse) ProtoMessage() {}
+
+func (x *ProductPerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPerformanceResponse.ProtoReflect.Descriptor instead.
+func (*ProductPerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProductPerformanceResponse) GetGoodsId() string {
+	if x != nil {
+		return x.GoodsId
+	}
+	return ""
+}
+
+func (x *ProductPerformanceResponse) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *ProductPerformanceResponse) GetProduct() *ProductDTO {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ProductPerformanceResponse) GetVideoCount() int64 {
+	if x != nil {
+		return x.VideoCount
+	}
+	return 0
+}
+
+func (x *ProductPerformanceResponse) GetBloggerCount() int64 {
+	if x != nil {
+		return x.BloggerCount
+	}
+	return 0
+}
+
+func (x *ProductPerformanceResponse) GetRankAppearances() int64 {
+	if x != nil {
+		return x.RankAppearances
+	}
+	return 0
+}
+
+func (x *ProductPerformanceResponse) GetFirstSeenDate() string {
+	if x != nil {
+		return x.FirstSeenDate
+	}
+	return ""
+}
+
+func (x *ProductPerformanceResponse) GetLastSeenDate() string {
+	if x != nil {
+		return x.LastSeenDate
+	}
+	return ""
+}
+
+func (x *ProductPerformanceResponse) GetSalesCountLow() int64 {
+	if x != nil {
+		return x.SalesCountLow
+	}
+	return 0
+}
+
+func (x *ProductPerformanceResponse) GetSalesCountHigh() int64 {
+	if x != nil {
+		return x.SalesCountHigh
+	}
+	return 0
+}
+
+func (x *ProductPerformanceResponse) GetTotalSalesLow() int64 {
+	if x != nil {
+		return x.TotalSalesLow
+	}
+	return 0
+}
+
+func (x *ProductPerformanceResponse) GetTotalSalesHigh() int64 {
+	if x != nil {
+		return x.TotalSalesHigh
+	}
+	return 0
+}
+
+func (x *ProductPerformanceResponse) GetDaily() []*ProductDailySales {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+// 商品带货视频查询请求
+type ListProductTopVideosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 商品 ID
+	GoodsId string `protobuf:"bytes,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	// 榜单周期：day, week, month，默认 day
+	RankType string `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 开始日期 (YYYYMMDD)，为空时不限制
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 结束日期 (YYYYMMDD)，为空时不限制
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 返回条数，默认 20，最大 100
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductTopVideosRequest) Reset() {
+	*x = ListProductTopVideosRequest{}
+	mi := &file_v1_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductTopVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductTopVideosRequest) ProtoMessage() {}
+
+func (x *ListProductTopVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductTopVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListProductTopVideosRequest) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListProductTopVideosRequest) GetGoodsId() string {
+	if x != nil {
+		return x.GoodsId
+	}
+	return ""
+}
+
+func (x *ListProductTopVideosRequest) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *ListProductTopVideosRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListProductTopVideosRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListProductTopVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 商品的带货视频
+type ProductTopVideo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AwemeId       string                 `protobuf:"bytes,1,opt,name=aweme_id,json=awemeId,proto3" json:"aweme_id,omitempty"`
+	AwemeDesc     string                 `protobuf:"bytes,2,opt,name=aweme_desc,json=awemeDesc,proto3" json:"aweme_desc,omitempty"`
+	AwemeCoverUrl string                 `protobuf:"bytes,3,opt,name=aweme_cover_url,json=awemeCoverUrl,proto3" json:"aweme_cover_url,omitempty"`
+	BloggerId     int64                  `protobuf:"varint,4,opt,name=blogger_id,json=bloggerId,proto3" json:"blogger_id,omitempty"`
+	BloggerName   string                 `protobuf:"bytes,5,opt,name=blogger_name,json=bloggerName,proto3" json:"blogger_name,omitempty"`
+	// 上榜次数
+	RankAppearances int64 `protobuf:"varint,6,opt,name=rank_appearances,json=rankAppearances,proto3" json:"rank_appearances,omitempty"`
+	// 最好名次，0 表示未知
+	BestPosition int32 `protobuf:"varint,7,opt,name=best_position,json=bestPosition,proto3" json:"best_position,omitempty"`
+	// 累计榜单销量范围
+	SalesCountLow  int64 `protobuf:"varint,8,opt,name=sales_count_low,json=salesCountLow,proto3" json:"sales_count_low,omitempty"`
+	SalesCountHigh int64 `protobuf:"varint,9,opt,name=sales_count_high,json=salesCountHigh,proto3" json:"sales_count_high,omitempty"`
+	// 累计榜单销售额范围（分）
+	TotalSalesLow  int64 `protobuf:"varint,10,opt,name=total_sales_low,json=totalSalesLow,proto3" json:"total_sales_low,omitempty"`
+	TotalSalesHigh int64 `protobuf:"varint,11,opt,name=total_sales_high,json=totalSalesHigh,proto3" json:"total_sales_high,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProductTopVideo) Reset() {
+	*x = ProductTopVideo{}
+	mi := &file_v1_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductTopVideo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductTopVideo) ProtoMessage() {}
+
+func (x *ProductTopVideo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductTopVideo.ProtoReflect.Descriptor instead.
+func (*ProductTopVideo) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProductTopVideo) GetAwemeId() string {
+	if x != nil {
+		return x.AwemeId
+	}
+	return ""
+}
+
+func (x *ProductTopVideo) GetAwemeDesc() string {
+	if x != nil {
+		return x.AwemeDesc
+	}
+	return ""
+}
+
+func (x *ProductTopVideo) GetAwemeCoverUrl() string {
+	if x != nil {
+		return x.AwemeCoverUrl
+	}
+	return ""
+}
+
+func (x *ProductTopVideo) GetBloggerId() int64 {
+	if x != nil {
+		return x.BloggerId
+	}
+	return 0
+}
+
+func (x *ProductTopVideo) GetBloggerName() string {
+	if x != nil {
+		return x.BloggerName
+	}
+	return ""
+}
+
+func (x *ProductTopVideo) GetRankAppearances() int64 {
+	if x != nil {
+		return x.RankAppearances
+	}
+	return 0
+}
+
+func (x *ProductTopVideo) GetBestPosition() int32 {
+	if x != nil {
+		return x.BestPosition
+	}
+	return 0
+}
+
+func (x *ProductTopVideo) GetSalesCountLow() int64 {
+	if x != nil {
+		return x.SalesCountLow
+	}
+	return 0
+}
+
+func (x *ProductTopVideo) GetSalesCountHigh() int64 {
+	if x != nil {
+		return x.SalesCountHigh
+	}
+	return 0
+}
+
+func (x *ProductTopVideo) GetTotalSalesLow() int64 {
+	if x != nil {
+		return x.TotalSalesLow
+	}
+	return 0
+}
+
+func (x *ProductTopVideo) GetTotalSalesHigh() int64 {
+	if x != nil {
+		return x.TotalSalesHigh
+	}
+	return 0
+}
+
+// 商品带货视频查询响应
+type ListProductTopVideosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*ProductTopVideo     `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductTopVideosResponse) Reset() {
+	*x = ListProductTopVideosResponse{}
+	mi := &file_v1_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductTopVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductTopVideosResponse) ProtoMessage() {}
+
+func (x *ListProductTopVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductTopVideosResponse.ProtoReflect.Descriptor instead.
+func (*ListProductTopVideosResponse) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListProductTopVideosResponse) GetVideos() []*ProductTopVideo {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+// 商品价格历史查询请求
+type ProductPriceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 商品 ID
+	GoodsId string `protobuf:"bytes,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	// 开始日期 (YYYYMMDD)，为空时不限制
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 结束日期 (YYYYMMDD)，为空时不限制
+	EndDate       string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPriceHistoryRequest) Reset() {
+	*x = ProductPriceHistoryRequest{}
+	mi := &file_v1_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPriceHistoryRequest) ProtoMessage() {}
+
+func (x *ProductPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ProductPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProductPriceHistoryRequest) GetGoodsId() string {
+	if x != nil {
+		return x.GoodsId
+	}
+	return ""
+}
+
+func (x *ProductPriceHistoryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ProductPriceHistoryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 商品某天的价格/佣金快照
+type ProductPriceSnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 快照日期 (YYYYMMDD)
+	Date            string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	GoodsPrice      float64 `protobuf:"fixed64,2,opt,name=goods_price,json=goodsPrice,proto3" json:"goods_price,omitempty"`
+	GoodsPriceRange string  `protobuf:"bytes,3,opt,name=goods_price_range,json=goodsPriceRange,proto3" json:"goods_price_range,omitempty"`
+	CosRatio        string  `protobuf:"bytes,4,opt,name=cos_ratio,json=cosRatio,proto3" json:"cos_ratio,omitempty"`
+	CommissionPrice string  `protobuf:"bytes,5,opt,name=commission_price,json=commissionPrice,proto3" json:"commission_price,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProductPriceSnapshot) Reset() {
+	*x = ProductPriceSnapshot{}
+	mi := &file_v1_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPriceSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPriceSnapshot) ProtoMessage() {}
+
+func (x *ProductPriceSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPriceSnapshot.ProtoReflect.Descriptor instead.
+func (*ProductPriceSnapshot) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProductPriceSnapshot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ProductPriceSnapshot) GetGoodsPrice() float64 {
+	if x != nil {
+		return x.GoodsPrice
+	}
+	return 0
+}
+
+func (x *ProductPriceSnapshot) GetGoodsPriceRange() string {
+	if x != nil {
+		return x.GoodsPriceRange
+	}
+	return ""
+}
+
+func (x *ProductPriceSnapshot) GetCosRatio() string {
+	if x != nil {
+		return x.CosRatio
+	}
+	return ""
+}
+
+func (x *ProductPriceSnapshot) GetCommissionPrice() string {
+	if x != nil {
+		return x.CommissionPrice
+	}
+	return ""
+}
+
+// 商品价格或佣金的一次变动
+type ProductChangeEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 发生变动的快照日期 (YYYYMMDD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// 变动字段：goods_price, goods_price_range, cos_ratio, commission_price
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// 变动前、后的值
+	OldValue string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	// 变动幅度 (new-old)/old，两端都能解析为数值且 old 不为 0 时才有值
+	ChangeRate    float64 `protobuf:"fixed64,5,opt,name=change_rate,json=changeRate,proto3" json:"change_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductChangeEvent) Reset() {
+	*x = ProductChangeEvent{}
+	mi := &file_v1_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductChangeEvent) ProtoMessage() {}
+
+func (x *ProductChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductChangeEvent.ProtoReflect.Descriptor instead.
+func (*ProductChangeEvent) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProductChangeEvent) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ProductChangeEvent) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ProductChangeEvent) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *ProductChangeEvent) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *ProductChangeEvent) GetChangeRate() float64 {
+	if x != nil {
+		return x.ChangeRate
+	}
+	return 0
+}
+
+// 商品价格历史查询响应
+type ProductPriceHistoryResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId string                 `protobuf:"bytes,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	// 按日期升序的快照
+	Points []*ProductPriceSnapshot `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	// 按日期升序的变动事件
+	Events        []*ProductChangeEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPriceHistoryResponse) Reset() {
+	*x = ProductPriceHistoryResponse{}
+	mi := &file_v1_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPriceHistoryResponse) ProtoMessage() {}
+
+func (x *ProductPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ProductPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProductPriceHistoryResponse) GetGoodsId() string {
+	if x != nil {
+		return x.GoodsId
+	}
+	return ""
+}
+
+func (x *ProductPriceHistoryResponse) GetPoints() []*ProductPriceSnapshot {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *ProductPriceHistoryResponse) GetEvents() []*ProductChangeEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_v1_product_proto protoreflect.FileDescriptor
+
+const file_v1_product_proto_rawDesc = "" +
+	"\n" +
+	"\x10v1/product.proto\x1a\x1cgoogle/api/annotations.proto\x1a\rv1/page.proto\x1a\x0fv1/filter.proto\"\xda\x03\n" +
+	"\n" +
+	"ProductDTO\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\vgoods_title\x18\x04 \x01(\tR\n" +
+	"goodsTitle\x12&\n" +
+	"\x0fgoods_cover_url\x18\x05 \x01(\tR\rgoodsCoverUrl\x12*\n" +
+	"\x11goods_price_range\x18\x06 \x01(\tR\x0fgoodsPriceRange\x12\x1f\n" +
+	"\vgoods_price\x18\a \x01(\x01R\n" +
+	"goodsPrice\x12\x1b\n" +
+	"\tcos_ratio\x18\b \x01(\tR\bcosRatio\x12)\n" +
+	"\x10commission_price\x18\t \x01(\tR\x0fcommissionPrice\x12\x1b\n" +
+	"\tshop_name\x18\n" +
+	" \x01(\tR\bshopName\x12\x1d\n" +
+	"\n" +
+	"brand_name\x18\v \x01(\tR\tbrandName\x12%\n" +
+	"\x0ecategory_names\x18\f \x01(\tR\rcategoryNames\x12\x19\n" +
+	"\bbrand_id\x18\r \x01(\x04R\abrandId\x12\x17\n" +
+	"\ashop_id\x18\x0e \x01(\x04R\x06shopId\"\xb9\x01\n" +
+	"\x13ListProductsRequest\x12 \n" +
+	"\x04page\x18\x01 \x01(\v2\f.PageRequestR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x17\n" +
+	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12)\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x0e2\n" +
+	".SortOrderR\tsortOrder\x12&\n" +
+	"\x06filter\x18\x05 \x01(\v2\x0e.ProductFilterR\x06filter\"b\n" +
+	"\x14ListProductsResponse\x12!\n" +
+	"\x04page\x18\x01 \x01(\v2\r.PageResponseR\x04page\x12'\n" +
+	"\bproducts\x18\x02 \x03(\v2\v.ProductDTOR\bproducts\"0\n" +
+	"\x13ProductQueryRequest\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\"=\n" +
+	"\x14ProductQueryResponse\x12%\n" +
+	"\aproduct\x18\x01 \x01(\v2\v.ProductDTOR\aproduct\"\x8d\x01\n" +
+	"\x19ProductPerformanceRequest\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"\xd7\x02\n" +
+	"\x11ProductDailySales\x12\x1b\n" +
+	"\trank_date\x18\x01 \x01(\tR\brankDate\x12\x1f\n" +
+	"\vvideo_count\x18\x02 \x01(\x03R\n" +
+	"videoCount\x12&\n" +
+	"\x0fsales_count_low\x18\x03 \x01(\x03R\rsalesCountLow\x12(\n" +
+	"\x10sales_count_high\x18\x04 \x01(\x03R\x0esalesCountHigh\x12&\n" +
+	"\x0ftotal_sales_low\x18\x05 \x01(\x03R\rtotalSalesLow\x12(\n" +
+	"\x10total_sales_high\x18\x06 \x01(\x03R\x0etotalSalesHigh\x121\n" +
+	"\x15trend_inc_sales_count\x18\a \x01(\x03R\x12trendIncSalesCount\x12-\n" +
+	"\x13trend_inc_sales_gmv\x18\b \x01(\x01R\x10trendIncSalesGmv\"\x88\x04\n" +
+	"\x1aProductPerformanceResponse\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12%\n" +
+	"\aproduct\x18\x03 \x01(\v2\v.ProductDTOR\aproduct\x12\x1f\n" +
+	"\vvideo_count\x18\x04 \x01(\x03R\n" +
+	"videoCount\x12#\n" +
+	"\rblogger_count\x18\x05 \x01(\x03R\fbloggerCount\x12)\n" +
+	"\x10rank_appearances\x18\x06 \x01(\x03R\x0frankAppearances\x12&\n" +
+	"\x0ffirst_seen_date\x18\a \x01(\tR\rfirstSeenDate\x12$\n" +
+	"\x0elast_seen_date\x18\b \x01(\tR\flastSeenDate\x12&\n" +
+	"\x0fsales_count_low\x18\t \x01(\x03R\rsalesCountLow\x12(\n" +
+	"\x10sales_count_high\x18\n" +
+	" \x01(\x03R\x0esalesCountHigh\x12&\n" +
+	"\x0ftotal_sales_low\x18\v \x01(\x03R\rtotalSalesLow\x12(\n" +
+	"\x10total_sales_high\x18\f \x01(\x03R\x0etotalSalesHigh\x12(\n" +
+	"\x05daily\x18\r \x03(\v2\x12.ProductDailySalesR\x05daily\"\xa5\x01\n" +
+	"\x1bListProductTopVideosRequest\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xa9\x03\n" +
+	"\x0fProductTopVideo\x12\x19\n" +
+	"\baweme_id\x18\x01 \x01(\tR\aawemeId\x12\x1d\n" +
+	"\n" +
+	"aweme_desc\x18\x02 \x01(\tR\tawemeDesc\x12&\n" +
+	"\x0faweme_cover_url\x18\x03 \x01(\tR\rawemeCoverUrl\x12\x1d\n" +
+	"\n" +
+	"blogger_id\x18\x04 \x01(\x03R\tbloggerId\x12!\n" +
+	"\fblogger_name\x18\x05 \x01(\tR\vbloggerName\x12)\n" +
+	"\x10rank_appearances\x18\x06 \x01(\x03R\x0frankAppearances\x12#\n" +
+	"\rbest_position\x18\a \x01(\x05R\fbestPosition\x12&\n" +
+	"\x0fsales_count_low\x18\b \x01(\x03R\rsalesCountLow\x12(\n" +
+	"\x10sales_count_high\x18\t \x01(\x03R\x0esalesCountHigh\x12&\n" +
+	"\x0ftotal_sales_low\x18\n" +
+	" \x01(\x03R\rtotalSalesLow\x12(\n" +
+	"\x10total_sales_high\x18\v \x01(\x03R\x0etotalSalesHigh\"H\n" +
+	"\x1cListProductTopVideosResponse\x12(\n" +
+	"\x06videos\x18\x01 \x03(\v2\x10.ProductTopVideoR\x06videos\"q\n" +
+	"\x1aProductPriceHistoryRequest\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"\xbf\x01\n" +
+	"\x14ProductPriceSnapshot\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1f\n" +
+	"\vgoods_price\x18\x02 \x01(\x01R\n" +
+	"goodsPrice\x12*\n" +
+	"\x11goods_price_range\x18\x03 \x01(\tR\x0fgoodsPriceRange\x12\x1b\n" +
+	"\tcos_ratio\x18\x04 \x01(\tR\bcosRatio\x12)\n" +
+	"\x10commission_price\x18\x05 \x01(\tR\x0fcommissionPrice\"\x99\x01\n" +
+	"\x12ProductChangeEvent\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x03 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x04 \x01(\tR\bnewValue\x12\x1f\n" +
+	"\vchange_rate\x18\x05 \x01(\x01R\n" +
+	"changeRate\"\x94\x01\n" +
+	"\x1bProductPriceHistoryResponse\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\tR\agoodsId\x12-\n" +
+	"\x06points\x18\x02 \x03(\v2\x15.ProductPriceSnapshotR\x06points\x12+\n" +
+	"\x06events\x18\x03 \x03(\v2\x13.ProductChangeEventR\x06events2\xb2\x04\n" +
 	"\x0eProductService\x12Y\n" +
 	"\fListProducts\x12\x14.ListProductsRequest\x1a\x15.ListProductsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/products/list\x12Y\n" +
 	"\n" +
-	"GetProduct\x12\x14.ProductQueryRequest\x1a\x15.ProductQueryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/products/detailB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
+	"GetProduct\x12\x14.ProductQueryRequest\x1a\x15.ProductQueryResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/products/detail\x12u\n" +
+	"\x15GetProductPerformance\x12\x1a.ProductPerformanceRequest\x1a\x1b.ProductPerformanceResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/products/performance\x12w\n" +
+	"\x14ListProductTopVideos\x12\x1c.ListProductTopVideosRequest\x1a\x1d.ListProductTopVideosResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/products/top_videos\x12z\n" +
+	"\x16GetProductPriceHistory\x12\x1b.ProductPriceHistoryRequest\x1a\x1c.ProductPriceHistoryResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/products/price_historyB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
 
 var (
 	file_v1_product_proto_rawDescOnce sync.Once
@@ -440,32 +1423,55 @@ func file_v1_product_proto_rawDescGZIP() []byte {
 	return file_v1_product_proto_rawDescData
 }
 
-var file_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_v1_product_proto_goTypes = []any{
-	(*ProductDTO)(nil),           // 0: ProductDTO
-	(*ListProductsRequest)(nil),  // 1: ListProductsRequest
-	(*ListProductsResponse)(nil), // 2: ListProductsResponse
-	(*ProductQueryRequest)(nil),  // 3: ProductQueryRequest
-	(*ProductQueryResponse)(nil), // 4: ProductQueryResponse
-	(*PageRequest)(nil),          // 5: PageRequest
-	(SortOrder)(0),               // 6: SortOrder
-	(*PageResponse)(nil),         // 7: PageResponse
+	(*ProductDTO)(nil),                   // 0: ProductDTO
+	(*ListProductsRequest)(nil),          // 1: ListProductsRequest
+	(*ListProductsResponse)(nil),         // 2: ListProductsResponse
+	(*ProductQueryRequest)(nil),          // 3: ProductQueryRequest
+	(*ProductQueryResponse)(nil),         // 4: ProductQueryResponse
+	(*ProductPerformanceRequest)(nil),    // 5: ProductPerformanceRequest
+	(*ProductDailySales)(nil),            // 6: ProductDailySales
+	(*ProductPerformanceResponse)(nil),   // 7: ProductPerformanceResponse
+	(*ListProductTopVideosRequest)(nil),  // 8: ListProductTopVideosRequest
+	(*ProductTopVideo)(nil),              // 9: ProductTopVideo
+	(*ListProductTopVideosResponse)(nil), // 10: ListProductTopVideosResponse
+	(*ProductPriceHistoryRequest)(nil),   // 11: ProductPriceHistoryRequest
+	(*ProductPriceSnapshot)(nil),         // 12: ProductPriceSnapshot
+	(*ProductChangeEvent)(nil),           // 13: ProductChangeEvent
+	(*ProductPriceHistoryResponse)(nil),  // 14: ProductPriceHistoryResponse
+	(*PageRequest)(nil),                  // 15: PageRequest
+	(SortOrder)(0),                       // 16: SortOrder
+	(*ProductFilter)(nil),                // 17: ProductFilter
+	(*PageResponse)(nil),                 // 18: PageResponse
 }
 var file_v1_product_proto_depIdxs = []int32{
-	5, // 0: ListProductsRequest.page:type_name -> PageRequest
-	6, // 1: ListProductsRequest.sort_order:type_name -> SortOrder
-	7, // 2: ListProductsResponse.page:type_name -> PageResponse
-	0, // 3: ListProductsResponse.products:type_name -> ProductDTO
-	0, // 4: ProductQueryResponse.product:type_name -> ProductDTO
-	1, // 5: ProductService.ListProducts:input_type -> ListProductsRequest
-	3, // 6: ProductService.GetProduct:input_type -> ProductQueryRequest
-	2, // 7: ProductService.ListProducts:output_type -> ListProductsResponse
-	4, // 8: ProductService.GetProduct:output_type -> ProductQueryResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	15, // 0: ListProductsRequest.page:type_name -> PageRequest
+	16, // 1: ListProductsRequest.sort_order:type_name -> SortOrder
+	17, // 2: ListProductsRequest.filter:type_name -> ProductFilter
+	18, // 3: ListProductsResponse.page:type_name -> PageResponse
+	0,  // 4: ListProductsResponse.products:type_name -> ProductDTO
+	0,  // 5: ProductQueryResponse.product:type_name -> ProductDTO
+	0,  // 6: ProductPerformanceResponse.product:type_name -> ProductDTO
+	6,  // 7: ProductPerformanceResponse.daily:type_name -> ProductDailySales
+	9,  // 8: ListProductTopVideosResponse.videos:type_name -> ProductTopVideo
+	12, // 9: ProductPriceHistoryResponse.points:type_name -> ProductPriceSnapshot
+	13, // 10: ProductPriceHistoryResponse.events:type_name -> ProductChangeEvent
+	1,  // 11: ProductService.ListProducts:input_type -> ListProductsRequest
+	3,  // 12: ProductService.GetProduct:input_type -> ProductQueryRequest
+	5,  // 13: ProductService.GetProductPerformance:input_type -> ProductPerformanceRequest
+	8,  // 14: ProductService.ListProductTopVideos:input_type -> ListProductTopVideosRequest
+	11, // 15: ProductService.GetProductPriceHistory:input_type -> ProductPriceHistoryRequest
+	2,  // 16: ProductService.ListProducts:output_type -> ListProductsResponse
+	4,  // 17: ProductService.GetProduct:output_type -> ProductQueryResponse
+	7,  // 18: ProductService.GetProductPerformance:output_type -> ProductPerformanceResponse
+	10, // 19: ProductService.ListProductTopVideos:output_type -> ListProductTopVideosResponse
+	14, // 20: ProductService.GetProductPriceHistory:output_type -> ProductPriceHistoryResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_v1_product_proto_init() }
@@ -474,13 +1480,14 @@ func file_v1_product_proto_init() {
 		return
 	}
 	file_v1_page_proto_init()
+	file_v1_filter_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_product_proto_rawDesc), len(file_v1_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_ListProducts_FullMethodName = "/ProductService/ListProducts"
-	ProductService_GetProduct_FullMethodName   = "/ProductService/GetProduct"
+	ProductService_ListProducts_FullMethodName           = "/ProductService/ListProducts"
+	ProductService_GetProduct_FullMethodName             = "/ProductService/GetProduct"
+	ProductService_GetProductPerformance_FullMethodName  = "/ProductService/GetProductPerformance"
+	ProductService_ListProductTopVideos_FullMethodName   = "/ProductService/ListProductTopVideos"
+	ProductService_GetProductPriceHistory_FullMethodName = "/ProductService/GetProductPriceHistory"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -33,6 +36,12 @@ type ProductServiceClient interface {
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 	// 查询单个商品信息
 	GetProduct(ctx context.Context, in *ProductQueryRequest, opts ...grpc.CallOption) (*ProductQueryResponse, error)
+	// 查询商品的带货表现：带货视频数、博主数、每期销量/销售额汇总、首末上榜日期
+	GetProductPerformance(ctx context.Context, in *ProductPerformanceRequest, opts ...grpc.CallOption) (*ProductPerformanceResponse, error)
+	// 查询商品销售额最高的带货视频
+	ListProductTopVideos(ctx context.Context, in *ListProductTopVideosRequest, opts ...grpc.CallOption) (*ListProductTopVideosResponse, error)
+	// 查询商品价格和佣金的历史时间线及变动事件
+	GetProductPriceHistory(ctx context.Context, in *ProductPriceHistoryRequest, opts ...grpc.CallOption) (*ProductPriceHistoryResponse, error)
 }
 
 type productServiceClient struct {
@@ -63,6 +72,36 @@ func (c *productServiceClient) GetProduct(ctx context.Context, in *ProductQueryR
 	return out, nil
 }
 
+func (c *productServiceClient) GetProductPerformance(ctx context.Context, in *ProductPerformanceRequest, opts ...grpc.CallOption) (*ProductPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductPerformanceResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductTopVideos(ctx context.Context, in *ListProductTopVideosRequest, opts ...grpc.CallOption) (*ListProductTopVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductTopVideosResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductTopVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductPriceHistory(ctx context.Context, in *ProductPriceHistoryRequest, opts ...grpc.CallOption) (*ProductPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -73,6 +112,12 @@ type ProductServiceServer interface {
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
 	// 查询单个商品信息
 	GetProduct(context.Context, *ProductQueryRequest) (*ProductQueryResponse, error)
+	// 查询商品的带货表现：带货视频数、博主数、每期销量/销售额汇总、首末上榜日期
+	GetProductPerformance(context.Context, *ProductPerformanceRequest) (*ProductPerformanceResponse, error)
+	// 查询商品销售额最高的带货视频
+	ListProductTopVideos(context.Context, *ListProductTopVideosRequest) (*ListProductTopVideosResponse, error)
+	// 查询商品价格和佣金的历史时间线及变动事件
+	GetProductPriceHistory(context.Context, *ProductPriceHistoryRequest) (*ProductPriceHistoryResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -89,6 +134,15 @@ func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProd
 func (UnimplementedProductServiceServer) GetProduct(context.Context, *ProductQueryRequest) (*ProductQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
 }
+func (UnimplementedProductServiceServer) GetProductPerformance(context.Context, *ProductPerformanceRequest) (*ProductPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductPerformance not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductTopVideos(context.Context, *ListProductTopVideosRequest) (*ListProductTopVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductTopVideos not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductPriceHistory(context.Context, *ProductPriceHistoryRequest) (*ProductPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductPriceHistory not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -146,6 +200,60 @@ func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetProductPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductPerformance(ctx, req.(*ProductPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductTopVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductTopVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductTopVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductTopVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductTopVideos(ctx, req.(*ListProductTopVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductPriceHistory(ctx, req.(*ProductPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +269,18 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProduct",
 			Handler:    _ProductService_GetProduct_Handler,
 		},
+		{
+			MethodName: "GetProductPerformance",
+			Handler:    _ProductService_GetProductPerformance_Handler,
+		},
+		{
+			MethodName: "ListProductTopVideos",
+			Handler:    _ProductService_ListProductTopVideos_Handler,
+		},
+		{
+			MethodName: "GetProductPriceHistory",
+			Handler:    _ProductService_GetProductPriceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/product.proto",
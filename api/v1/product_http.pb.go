@@ -20,11 +20,20 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationProductServiceGetProduct = "/ProductService/GetProduct"
+const OperationProductServiceGetProductPerformance = "/ProductService/GetProductPerformance"
+const OperationProductServiceGetProductPriceHistory = "/ProductService/GetProductPriceHistory"
+const OperationProductServiceListProductTopVideos = "/ProductService/ListProductTopVideos"
 const OperationProductServiceListProducts = "/ProductService/ListProducts"
 
 type ProductServiceHTTPServer interface {
 	// GetProduct 查询单个商品信息
 	GetProduct(context.Context, *ProductQueryRequest) (*ProductQueryResponse, error)
+	// GetProductPerformance 查询商品的带货表现：带货视频数、博主数、每期销量/销售额汇总、首末上榜日期
+	GetProductPerformance(context.Context, *ProductPerformanceRequest) (*ProductPerformanceResponse, error)
+	// GetProductPriceHistory 查询商品价格和佣金的历史时间线及变动事件
+	GetProductPriceHistory(context.Context, *ProductPriceHistoryRequest) (*ProductPriceHistoryResponse, error)
+	// ListProductTopVideos 查询商品销售额最高的带货视频
+	ListProductTopVideos(context.Context, *ListProductTopVideosRequest) (*ListProductTopVideosResponse, error)
 	// ListProducts 分页查询商品信息
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
 }
@@ -33,6 +42,9 @@ func RegisterProductServiceHTTPServer(s *http.Server, srv ProductServiceHTTPServ
 	r := s.Route("/")
 	r.POST("/v1/products/list", _ProductService_ListProducts0_HTTP_Handler(srv))
 	r.POST("/v1/products/detail", _ProductService_GetProduct0_HTTP_Handler(srv))
+	r.POST("/v1/products/performance", _ProductService_GetProductPerformance0_HTTP_Handler(srv))
+	r.POST("/v1/products/top_videos", _ProductService_ListProductTopVideos0_HTTP_Handler(srv))
+	r.POST("/v1/products/price_history", _ProductService_GetProductPriceHistory0_HTTP_Handler(srv))
 }
 
 func _ProductService_ListProducts0_HTTP_Handler(srv ProductServiceHTTPServer) func(ctx http.Context) error {
@@ -79,8 +91,77 @@ func _ProductService_GetProduct0_HTTP_Handler(srv ProductServiceHTTPServer) func
 	}
 }
 
+func _ProductService_GetProductPerformance0_HTTP_Handler(srv ProductServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ProductPerformanceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductServiceGetProductPerformance)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProductPerformance(ctx, req.(*ProductPerformanceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProductPerformanceResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProductService_ListProductTopVideos0_HTTP_Handler(srv ProductServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListProductTopVideosRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductServiceListProductTopVideos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListProductTopVideos(ctx, req.(*ListProductTopVideosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListProductTopVideosResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _ProductService_GetProductPriceHistory0_HTTP_Handler(srv ProductServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ProductPriceHistoryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProductServiceGetProductPriceHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProductPriceHistory(ctx, req.(*ProductPriceHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProductPriceHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ProductServiceHTTPClient interface {
 	GetProduct(ctx context.Context, req *ProductQueryRequest, opts ...http.CallOption) (rsp *ProductQueryResponse, err error)
+	GetProductPerformance(ctx context.Context, req *ProductPerformanceRequest, opts ...http.CallOption) (rsp *ProductPerformanceResponse, err error)
+	GetProductPriceHistory(ctx context.Context, req *ProductPriceHistoryRequest, opts ...http.CallOption) (rsp *ProductPriceHistoryResponse, err error)
+	ListProductTopVideos(ctx context.Context, req *ListProductTopVideosRequest, opts ...http.CallOption) (rsp *ListProductTopVideosResponse, err error)
 	ListProducts(ctx context.Context, req *ListProductsRequest, opts ...http.CallOption) (rsp *ListProductsResponse, err error)
 }
 
@@ -105,6 +186,45 @@ func (c *ProductServiceHTTPClientImpl) GetProduct(ctx context.Context, in *Produ
 	return &out, nil
 }
 
+func (c *ProductServiceHTTPClientImpl) GetProductPerformance(ctx context.Context, in *ProductPerformanceRequest, opts ...http.CallOption) (*ProductPerformanceResponse, error) {
+	var out ProductPerformanceResponse
+	pattern := "/v1/products/performance"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductServiceGetProductPerformance))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ProductServiceHTTPClientImpl) GetProductPriceHistory(ctx context.Context, in *ProductPriceHistoryRequest, opts ...http.CallOption) (*ProductPriceHistoryResponse, error) {
+	var out ProductPriceHistoryResponse
+	pattern := "/v1/products/price_history"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductServiceGetProductPriceHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ProductServiceHTTPClientImpl) ListProductTopVideos(ctx context.Context, in *ListProductTopVideosRequest, opts ...http.CallOption) (*ListProductTopVideosResponse, error) {
+	var out ListProductTopVideosResponse
+	pattern := "/v1/products/top_videos"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProductServiceListProductTopVideos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ProductServiceHTTPClientImpl) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...http.CallOption) (*ListProductsResponse, error) {
 	var out ListProductsResponse
 	pattern := "/v1/products/list"
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,6 +22,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// SourceDataStatus 定义了原始数据在 ETL 流程中的处理状态
+type SourceDataStatus int32
+
+const (
+	// 未处理，等待 ETL
+	SourceDataStatus_SOURCE_DATA_STATUS_UNPROCESSED SourceDataStatus = 0
+	// 已处理
+	SourceDataStatus_SOURCE_DATA_STATUS_PROCESSED SourceDataStatus = 1
+	// 已过滤，数据有效但不满足入库条件
+	SourceDataStatus_SOURCE_DATA_STATUS_FILTERED SourceDataStatus = 2
+	// 采集或处理出错
+	SourceDataStatus_SOURCE_DATA_STATUS_ERROR SourceDataStatus = -1
+)
+
+// Enum value maps for SourceDataStatus.
+var (
+	SourceDataStatus_name = map[int32]string{
+		0:  "SOURCE_DATA_STATUS_UNPROCESSED",
+		1:  "SOURCE_DATA_STATUS_PROCESSED",
+		2:  "SOURCE_DATA_STATUS_FILTERED",
+		-1: "SOURCE_DATA_STATUS_ERROR",
+	}
+	SourceDataStatus_value = map[string]int32{
+		"SOURCE_DATA_STATUS_UNPROCESSED": 0,
+		"SOURCE_DATA_STATUS_PROCESSED":   1,
+		"SOURCE_DATA_STATUS_FILTERED":    2,
+		"SOURCE_DATA_STATUS_ERROR":       -1,
+	}
+)
+
+func (x SourceDataStatus) Enum() *SourceDataStatus {
+	p := new(SourceDataStatus)
+	*p = x
+	return p
+}
+
+func (x SourceDataStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SourceDataStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_source_data_proto_enumTypes[0].Descriptor()
+}
+
+func (SourceDataStatus) Type() protoreflect.EnumType {
+	return &file_v1_source_data_proto_enumTypes[0]
+}
+
+func (x SourceDataStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SourceDataStatus.Descriptor instead.
+func (SourceDataStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v1_source_data_proto_rawDescGZIP(), []int{0}
+}
+
 // 原始数据条目
 type SourceData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -29,7 +87,7 @@ type SourceData struct {
 	DataType      string                 `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
 	RawContent    string                 `protobuf:"bytes,4,opt,name=raw_content,json=rawContent,proto3" json:"raw_content,omitempty"` // 存储原始的JSON字符串
 	FetchedAt     string                 `protobuf:"bytes,5,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
-	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Status        SourceDataStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=SourceDataStatus" json:"status,omitempty"`
 	EntityId      string                 `protobuf:"bytes,7,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	Date          string                 `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`
 	ProcessingLog string                 `protobuf:"bytes,9,opt,name=processing_log,json=processingLog,proto3" json:"processing_log,omitempty"` // 存储ETL处理过程中的错误信息
@@ -108,11 +166,11 @@ func (x *SourceData) GetFetchedAt() string {
 	return ""
 }
 
-func (x *SourceData) GetStatus() int32 {
+func (x *SourceData) GetStatus() SourceDataStatus {
 	if x != nil {
 		return x.Status
 	}
-	return 0
+	return SourceDataStatus_SOURCE_DATA_STATUS_UNPROCESSED
 }
 
 func (x *SourceData) GetEntityId() string {
@@ -171,11 +229,366 @@ func (x *SourceData) GetRequestHeaders() string {
 	return ""
 }
 
+// 状态统计请求
+type SourceDataStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 数据类型，为空时统计全部类型
+	DataType      string `protobuf:"bytes,1,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceDataStatsRequest) Reset() {
+	*x = SourceDataStatsRequest{}
+	mi := &file_v1_source_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceDataStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceDataStatsRequest) ProtoMessage() {}
+
+func (x *SourceDataStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_source_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceDataStatsRequest.ProtoReflect.Descriptor instead.
+func (*SourceDataStatsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_source_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SourceDataStatsRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+// 单个数据类型 + 状态的数量
+type SourceDataStatusCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 数据类型
+	DataType string `protobuf:"bytes,1,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	// 处理状态
+	Status SourceDataStatus `protobuf:"varint,2,opt,name=status,proto3,enum=SourceDataStatus" json:"status,omitempty"`
+	// 数量
+	Count         int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceDataStatusCount) Reset() {
+	*x = SourceDataStatusCount{}
+	mi := &file_v1_source_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceDataStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceDataStatusCount) ProtoMessage() {}
+
+func (x *SourceDataStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_source_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceDataStatusCount.ProtoReflect.Descriptor instead.
+func (*SourceDataStatusCount) Descriptor() ([]byte, []int) {
+	return file_v1_source_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SourceDataStatusCount) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *SourceDataStatusCount) GetStatus() SourceDataStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SourceDataStatus_SOURCE_DATA_STATUS_UNPROCESSED
+}
+
+func (x *SourceDataStatusCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 状态统计响应
+type SourceDataStatsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Counts        []*SourceDataStatusCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceDataStatsResponse) Reset() {
+	*x = SourceDataStatsResponse{}
+	mi := &file_v1_source_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceDataStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceDataStatsResponse) ProtoMessage() {}
+
+func (x *SourceDataStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_source_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceDataStatsResponse.ProtoReflect.Descriptor instead.
+func (*SourceDataStatsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_source_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SourceDataStatsResponse) GetCounts() []*SourceDataStatusCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// 重放请求，各条件之间为 AND 关系，至少需要指定一个条件
+type ReprocessSourceDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 指定的原始数据ID列表
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// 数据类型，例如 "video_rank_day"
+	DataType string `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	// 起始日期（对应 source_data.date），格式 "20060102"，包含
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 结束日期（对应 source_data.date），格式 "20060102"，包含
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 数据源名称，例如 "feigua_http_backup"
+	ProviderName string `protobuf:"bytes,5,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+	// 只重放处于这些状态的数据，为空时表示所有可重放状态
+	Statuses []SourceDataStatus `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=SourceDataStatus" json:"statuses,omitempty"`
+	// 为 true 时只返回将会发生的变化，不修改任何数据
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// 单次最多重放的记录数，为 0 时使用服务端默认值
+	Limit         int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReprocessSourceDataRequest) Reset() {
+	*x = ReprocessSourceDataRequest{}
+	mi := &file_v1_source_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReprocessSourceDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReprocessSourceDataRequest) ProtoMessage() {}
+
+func (x *ReprocessSourceDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_source_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReprocessSourceDataRequest.ProtoReflect.Descriptor instead.
+func (*ReprocessSourceDataRequest) Descriptor() ([]byte, []int) {
+	return file_v1_source_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReprocessSourceDataRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ReprocessSourceDataRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *ReprocessSourceDataRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ReprocessSourceDataRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ReprocessSourceDataRequest) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+func (x *ReprocessSourceDataRequest) GetStatuses() []SourceDataStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ReprocessSourceDataRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReprocessSourceDataRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 重放响应
+type ReprocessSourceDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否为预览模式
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// 匹配条件的记录数
+	Matched int64 `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	// 被重置为未处理的记录数（dry_run 时为将要重置的记录数）
+	ResetCount int64 `protobuf:"varint,3,opt,name=reset_count,json=resetCount,proto3" json:"reset_count,omitempty"`
+	// 因没有对应处理器而跳过的记录数
+	Skipped int64 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// 匹配记录按数据类型和状态的分布
+	Counts []*SourceDataStatusCount `protobuf:"bytes,5,rep,name=counts,proto3" json:"counts,omitempty"`
+	// 将被（或已被）重放的原始数据ID
+	Ids           []int64 `protobuf:"varint,6,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReprocessSourceDataResponse) Reset() {
+	*x = ReprocessSourceDataResponse{}
+	mi := &file_v1_source_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReprocessSourceDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReprocessSourceDataResponse) ProtoMessage() {}
+
+func (x *ReprocessSourceDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_source_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReprocessSourceDataResponse.ProtoReflect.Descriptor instead.
+func (*ReprocessSourceDataResponse) Descriptor() ([]byte, []int) {
+	return file_v1_source_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReprocessSourceDataResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReprocessSourceDataResponse) GetMatched() int64 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ReprocessSourceDataResponse) GetResetCount() int64 {
+	if x != nil {
+		return x.ResetCount
+	}
+	return 0
+}
+
+func (x *ReprocessSourceDataResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ReprocessSourceDataResponse) GetCounts() []*SourceDataStatusCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *ReprocessSourceDataResponse) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
 var File_v1_source_data_proto protoreflect.FileDescriptor
 
 const file_v1_source_data_proto_rawDesc = "" +
 	"\n" +
-	"\x14v1/source_data.proto\"\xc0\x03\n" +
+	"\x14v1/source_data.proto\x1a\x1cgoogle/api/annotations.proto\"\xd3\x03\n" +
 	"\n" +
 	"SourceData\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
@@ -184,8 +597,8 @@ const file_v1_source_data_proto_rawDesc = "" +
 	"\vraw_content\x18\x04 \x01(\tR\n" +
 	"rawContent\x12\x1d\n" +
 	"\n" +
-	"fetched_at\x18\x05 \x01(\tR\tfetchedAt\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1b\n" +
+	"fetched_at\x18\x05 \x01(\tR\tfetchedAt\x12)\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x11.SourceDataStatusR\x06status\x12\x1b\n" +
 	"\tentity_id\x18\a \x01(\tR\bentityId\x12\x12\n" +
 	"\x04date\x18\b \x01(\tR\x04date\x12%\n" +
 	"\x0eprocessing_log\x18\t \x01(\tR\rprocessingLog\x12\x18\n" +
@@ -195,7 +608,41 @@ const file_v1_source_data_proto_rawDesc = "" +
 	"\vrequest_url\x18\f \x01(\tR\n" +
 	"requestUrl\x12%\n" +
 	"\x0erequest_params\x18\r \x01(\tR\rrequestParams\x12'\n" +
-	"\x0frequest_headers\x18\x0e \x01(\tR\x0erequestHeadersB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
+	"\x0frequest_headers\x18\x0e \x01(\tR\x0erequestHeaders\"5\n" +
+	"\x16SourceDataStatsRequest\x12\x1b\n" +
+	"\tdata_type\x18\x01 \x01(\tR\bdataType\"u\n" +
+	"\x15SourceDataStatusCount\x12\x1b\n" +
+	"\tdata_type\x18\x01 \x01(\tR\bdataType\x12)\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x11.SourceDataStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\"I\n" +
+	"\x17SourceDataStatsResponse\x12.\n" +
+	"\x06counts\x18\x01 \x03(\v2\x16.SourceDataStatusCountR\x06counts\"\x88\x02\n" +
+	"\x1aReprocessSourceDataRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x12\x1b\n" +
+	"\tdata_type\x18\x02 \x01(\tR\bdataType\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12#\n" +
+	"\rprovider_name\x18\x05 \x01(\tR\fproviderName\x12-\n" +
+	"\bstatuses\x18\x06 \x03(\x0e2\x11.SourceDataStatusR\bstatuses\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\"\xcd\x01\n" +
+	"\x1bReprocessSourceDataResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\x03R\amatched\x12\x1f\n" +
+	"\vreset_count\x18\x03 \x01(\x03R\n" +
+	"resetCount\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x03R\askipped\x12.\n" +
+	"\x06counts\x18\x05 \x03(\v2\x16.SourceDataStatusCountR\x06counts\x12\x10\n" +
+	"\x03ids\x18\x06 \x03(\x03R\x03ids*\xa0\x01\n" +
+	"\x10SourceDataStatus\x12\"\n" +
+	"\x1eSOURCE_DATA_STATUS_UNPROCESSED\x10\x00\x12 \n" +
+	"\x1cSOURCE_DATA_STATUS_PROCESSED\x10\x01\x12\x1f\n" +
+	"\x1bSOURCE_DATA_STATUS_FILTERED\x10\x02\x12%\n" +
+	"\x18SOURCE_DATA_STATUS_ERROR\x10\xff\xff\xff\xff\xff\xff\xff\xff\xff\x012\xf6\x01\n" +
+	"\x11SourceDataService\x12i\n" +
+	"\x12GetSourceDataStats\x12\x17.SourceDataStatsRequest\x1a\x18.SourceDataStatsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/source_data/stats\x12v\n" +
+	"\x13ReprocessSourceData\x12\x1b.ReprocessSourceDataRequest\x1a\x1c.ReprocessSourceDataResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/source_data/reprocessB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
 
 var (
 	file_v1_source_data_proto_rawDescOnce sync.Once
@@ -209,16 +656,32 @@ func file_v1_source_data_proto_rawDescGZIP() []byte {
 	return file_v1_source_data_proto_rawDescData
 }
 
-var file_v1_source_data_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_v1_source_data_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_source_data_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_v1_source_data_proto_goTypes = []any{
-	(*SourceData)(nil), // 0: SourceData
+	(SourceDataStatus)(0),               // 0: SourceDataStatus
+	(*SourceData)(nil),                  // 1: SourceData
+	(*SourceDataStatsRequest)(nil),      // 2: SourceDataStatsRequest
+	(*SourceDataStatusCount)(nil),       // 3: SourceDataStatusCount
+	(*SourceDataStatsResponse)(nil),     // 4: SourceDataStatsResponse
+	(*ReprocessSourceDataRequest)(nil),  // 5: ReprocessSourceDataRequest
+	(*ReprocessSourceDataResponse)(nil), // 6: ReprocessSourceDataResponse
 }
 var file_v1_source_data_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: SourceData.status:type_name -> SourceDataStatus
+	0, // 1: SourceDataStatusCount.status:type_name -> SourceDataStatus
+	3, // 2: SourceDataStatsResponse.counts:type_name -> SourceDataStatusCount
+	0, // 3: ReprocessSourceDataRequest.statuses:type_name -> SourceDataStatus
+	3, // 4: ReprocessSourceDataResponse.counts:type_name -> SourceDataStatusCount
+	2, // 5: SourceDataService.GetSourceDataStats:input_type -> SourceDataStatsRequest
+	5, // 6: SourceDataService.ReprocessSourceData:input_type -> ReprocessSourceDataRequest
+	4, // 7: SourceDataService.GetSourceDataStats:output_type -> SourceDataStatsResponse
+	6, // 8: SourceDataService.ReprocessSourceData:output_type -> ReprocessSourceDataResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_v1_source_data_proto_init() }
@@ -231,13 +694,14 @@ func file_v1_source_data_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_source_data_proto_rawDesc), len(file_v1_source_data_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_v1_source_data_proto_goTypes,
 		DependencyIndexes: file_v1_source_data_proto_depIdxs,
+		EnumInfos:         file_v1_source_data_proto_enumTypes,
 		MessageInfos:      file_v1_source_data_proto_msgTypes,
 	}.Build()
 	File_v1_source_data_proto = out.File
@@ -72,7 +72,56 @@ type VideoDTO struct {
 	// 汇总更新时间
 	SummaryUpdatedAt string `protobuf:"bytes,21,opt,name=summary_updated_at,json=summaryUpdatedAt,proto3" json:"summary_updated_at,omitempty"`
 	// 绑定的商品ID
-	GoodsId       string `protobuf:"bytes,22,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	GoodsId string `protobuf:"bytes,22,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	// 数值化指标，由对应的 *_str 字段解析得到
+	// 播放量低值
+	PlayCountLow int64 `protobuf:"varint,23,opt,name=play_count_low,json=playCountLow,proto3" json:"play_count_low,omitempty"`
+	// 播放量高值
+	PlayCountHigh int64 `protobuf:"varint,24,opt,name=play_count_high,json=playCountHigh,proto3" json:"play_count_high,omitempty"`
+	// 点赞量低值
+	LikeCountLow int64 `protobuf:"varint,25,opt,name=like_count_low,json=likeCountLow,proto3" json:"like_count_low,omitempty"`
+	// 点赞量高值
+	LikeCountHigh int64 `protobuf:"varint,26,opt,name=like_count_high,json=likeCountHigh,proto3" json:"like_count_high,omitempty"`
+	// 评论量低值
+	CommentCountLow int64 `protobuf:"varint,27,opt,name=comment_count_low,json=commentCountLow,proto3" json:"comment_count_low,omitempty"`
+	// 评论量高值
+	CommentCountHigh int64 `protobuf:"varint,28,opt,name=comment_count_high,json=commentCountHigh,proto3" json:"comment_count_high,omitempty"`
+	// 分享量低值
+	ShareCountLow int64 `protobuf:"varint,29,opt,name=share_count_low,json=shareCountLow,proto3" json:"share_count_low,omitempty"`
+	// 分享量高值
+	ShareCountHigh int64 `protobuf:"varint,30,opt,name=share_count_high,json=shareCountHigh,proto3" json:"share_count_high,omitempty"`
+	// 收藏量低值
+	CollectCountLow int64 `protobuf:"varint,31,opt,name=collect_count_low,json=collectCountLow,proto3" json:"collect_count_low,omitempty"`
+	// 收藏量高值
+	CollectCountHigh int64 `protobuf:"varint,32,opt,name=collect_count_high,json=collectCountHigh,proto3" json:"collect_count_high,omitempty"`
+	// 互动率(%)低值
+	InteractionRateLow float64 `protobuf:"fixed64,33,opt,name=interaction_rate_low,json=interactionRateLow,proto3" json:"interaction_rate_low,omitempty"`
+	// 互动率(%)高值
+	InteractionRateHigh float64 `protobuf:"fixed64,34,opt,name=interaction_rate_high,json=interactionRateHigh,proto3" json:"interaction_rate_high,omitempty"`
+	// 视频分数低值
+	ScoreLow float64 `protobuf:"fixed64,35,opt,name=score_low,json=scoreLow,proto3" json:"score_low,omitempty"`
+	// 视频分数高值
+	ScoreHigh float64 `protobuf:"fixed64,36,opt,name=score_high,json=scoreHigh,proto3" json:"score_high,omitempty"`
+	// 点赞评论率(%)低值
+	LikeCommentRateLow float64 `protobuf:"fixed64,37,opt,name=like_comment_rate_low,json=likeCommentRateLow,proto3" json:"like_comment_rate_low,omitempty"`
+	// 点赞评论率(%)高值
+	LikeCommentRateHigh float64 `protobuf:"fixed64,38,opt,name=like_comment_rate_high,json=likeCommentRateHigh,proto3" json:"like_comment_rate_high,omitempty"`
+	// 销售额(元)低值
+	SalesGmvLow float64 `protobuf:"fixed64,41,opt,name=sales_gmv_low,json=salesGmvLow,proto3" json:"sales_gmv_low,omitempty"`
+	// 销售额(元)高值
+	SalesGmvHigh float64 `protobuf:"fixed64,42,opt,name=sales_gmv_high,json=salesGmvHigh,proto3" json:"sales_gmv_high,omitempty"`
+	// 销量低值
+	SalesCountLow int64 `protobuf:"varint,43,opt,name=sales_count_low,json=salesCountLow,proto3" json:"sales_count_low,omitempty"`
+	// 销量高值
+	SalesCountHigh int64 `protobuf:"varint,44,opt,name=sales_count_high,json=salesCountHigh,proto3" json:"sales_count_high,omitempty"`
+	// 商品数低值
+	GoodsCountLow int64 `protobuf:"varint,45,opt,name=goods_count_low,json=goodsCountLow,proto3" json:"goods_count_low,omitempty"`
+	// 商品数高值
+	GoodsCountHigh int64 `protobuf:"varint,46,opt,name=goods_count_high,json=goodsCountHigh,proto3" json:"goods_count_high,omitempty"`
+	// GPM(元)低值
+	GpmLow float64 `protobuf:"fixed64,47,opt,name=gpm_low,json=gpmLow,proto3" json:"gpm_low,omitempty"`
+	// GPM(元)高值
+	GpmHigh       float64 `protobuf:"fixed64,48,opt,name=gpm_high,json=gpmHigh,proto3" json:"gpm_high,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -275,6 +324,174 @@ func (x *VideoDTO) GetGoodsId() string {
 	return ""
 }
 
+func (x *VideoDTO) GetPlayCountLow() int64 {
+	if x != nil {
+		return x.PlayCountLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetPlayCountHigh() int64 {
+	if x != nil {
+		return x.PlayCountHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetLikeCountLow() int64 {
+	if x != nil {
+		return x.LikeCountLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetLikeCountHigh() int64 {
+	if x != nil {
+		return x.LikeCountHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetCommentCountLow() int64 {
+	if x != nil {
+		return x.CommentCountLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetCommentCountHigh() int64 {
+	if x != nil {
+		return x.CommentCountHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetShareCountLow() int64 {
+	if x != nil {
+		return x.ShareCountLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetShareCountHigh() int64 {
+	if x != nil {
+		return x.ShareCountHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetCollectCountLow() int64 {
+	if x != nil {
+		return x.CollectCountLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetCollectCountHigh() int64 {
+	if x != nil {
+		return x.CollectCountHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetInteractionRateLow() float64 {
+	if x != nil {
+		return x.InteractionRateLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetInteractionRateHigh() float64 {
+	if x != nil {
+		return x.InteractionRateHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetScoreLow() float64 {
+	if x != nil {
+		return x.ScoreLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetScoreHigh() float64 {
+	if x != nil {
+		return x.ScoreHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetLikeCommentRateLow() float64 {
+	if x != nil {
+		return x.LikeCommentRateLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetLikeCommentRateHigh() float64 {
+	if x != nil {
+		return x.LikeCommentRateHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetSalesGmvLow() float64 {
+	if x != nil {
+		return x.SalesGmvLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetSalesGmvHigh() float64 {
+	if x != nil {
+		return x.SalesGmvHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetSalesCountLow() int64 {
+	if x != nil {
+		return x.SalesCountLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetSalesCountHigh() int64 {
+	if x != nil {
+		return x.SalesCountHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetGoodsCountLow() int64 {
+	if x != nil {
+		return x.GoodsCountLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetGoodsCountHigh() int64 {
+	if x != nil {
+		return x.GoodsCountHigh
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetGpmLow() float64 {
+	if x != nil {
+		return x.GpmLow
+	}
+	return 0
+}
+
+func (x *VideoDTO) GetGpmHigh() float64 {
+	if x != nil {
+		return x.GpmHigh
+	}
+	return 0
+}
+
 // 分页查询视频请求
 type ListVideosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -282,10 +499,14 @@ type ListVideosRequest struct {
 	Page *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
 	// 模糊查询关键字 (将作用于 aweme_desc 字段)
 	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	// 排序字段 (例如: "aweme_pub_time", "summary_updated_at")
+	// 排序字段，仅支持白名单内的字段：aweme_pub_time, summary_updated_at, created_at, updated_at,
+	// play_count, like_count, comment_count, share_count, collect_count, interaction_rate, score,
+	// like_comment_rate, sales_gmv, sales_count, goods_count, gpm（*_str 形式同样支持，按解析后的数值排序）
 	SortBy string `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 	// 排序方向（1:ASC / 2:DESC）
-	SortOrder     SortOrder `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=SortOrder" json:"sort_order,omitempty"`
+	SortOrder SortOrder `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3,enum=SortOrder" json:"sort_order,omitempty"`
+	// 结构化过滤条件
+	Filter        *VideoFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -348,6 +569,13 @@ func (x *ListVideosRequest) GetSortOrder() SortOrder {
 	return SortOrder_UNSORTED
 }
 
+func (x *ListVideosRequest) GetFilter() *VideoFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 // 分页查询视频响应
 type ListVideosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -495,7 +723,7 @@ var File_v1_video_proto protoreflect.FileDescriptor
 
 const file_v1_video_proto_rawDesc = "" +
 	"\n" +
-	"\x0ev1/video.proto\x1a\x1cgoogle/api/annotations.proto\x1a\rv1/page.proto\"\x84\a\n" +
+	"\x0ev1/video.proto\x1a\x1cgoogle/api/annotations.proto\x1a\rv1/page.proto\x1a\x0fv1/filter.proto\"\xd2\x0e\n" +
 	"\bVideoDTO\x12\x19\n" +
 	"\baweme_id\x18\x01 \x01(\tR\aawemeId\x12\x1d\n" +
 	"\n" +
@@ -526,14 +754,40 @@ const file_v1_video_proto_rawDesc = "" +
 	"\n" +
 	"aweme_type\x18\x14 \x01(\x05R\tawemeType\x12,\n" +
 	"\x12summary_updated_at\x18\x15 \x01(\tR\x10summaryUpdatedAt\x12\x19\n" +
-	"\bgoods_id\x18\x16 \x01(\tR\agoodsId\"\x8f\x01\n" +
+	"\bgoods_id\x18\x16 \x01(\tR\agoodsId\x12$\n" +
+	"\x0eplay_count_low\x18\x17 \x01(\x03R\fplayCountLow\x12&\n" +
+	"\x0fplay_count_high\x18\x18 \x01(\x03R\rplayCountHigh\x12$\n" +
+	"\x0elike_count_low\x18\x19 \x01(\x03R\flikeCountLow\x12&\n" +
+	"\x0flike_count_high\x18\x1a \x01(\x03R\rlikeCountHigh\x12*\n" +
+	"\x11comment_count_low\x18\x1b \x01(\x03R\x0fcommentCountLow\x12,\n" +
+	"\x12comment_count_high\x18\x1c \x01(\x03R\x10commentCountHigh\x12&\n" +
+	"\x0fshare_count_low\x18\x1d \x01(\x03R\rshareCountLow\x12(\n" +
+	"\x10share_count_high\x18\x1e \x01(\x03R\x0eshareCountHigh\x12*\n" +
+	"\x11collect_count_low\x18\x1f \x01(\x03R\x0fcollectCountLow\x12,\n" +
+	"\x12collect_count_high\x18  \x01(\x03R\x10collectCountHigh\x120\n" +
+	"\x14interaction_rate_low\x18! \x01(\x01R\x12interactionRateLow\x122\n" +
+	"\x15interaction_rate_high\x18\" \x01(\x01R\x13interactionRateHigh\x12\x1b\n" +
+	"\tscore_low\x18# \x01(\x01R\bscoreLow\x12\x1d\n" +
+	"\n" +
+	"score_high\x18$ \x01(\x01R\tscoreHigh\x121\n" +
+	"\x15like_comment_rate_low\x18% \x01(\x01R\x12likeCommentRateLow\x123\n" +
+	"\x16like_comment_rate_high\x18& \x01(\x01R\x13likeCommentRateHigh\x12\"\n" +
+	"\rsales_gmv_low\x18) \x01(\x01R\vsalesGmvLow\x12$\n" +
+	"\x0esales_gmv_high\x18* \x01(\x01R\fsalesGmvHigh\x12&\n" +
+	"\x0fsales_count_low\x18+ \x01(\x03R\rsalesCountLow\x12(\n" +
+	"\x10sales_count_high\x18, \x01(\x03R\x0esalesCountHigh\x12&\n" +
+	"\x0fgoods_count_low\x18- \x01(\x03R\rgoodsCountLow\x12(\n" +
+	"\x10goods_count_high\x18. \x01(\x03R\x0egoodsCountHigh\x12\x17\n" +
+	"\agpm_low\x18/ \x01(\x01R\x06gpmLow\x12\x19\n" +
+	"\bgpm_high\x180 \x01(\x01R\agpmHigh\"\xb5\x01\n" +
 	"\x11ListVideosRequest\x12 \n" +
 	"\x04page\x18\x01 \x01(\v2\f.PageRequestR\x04page\x12\x14\n" +
 	"\x05query\x18\x02 \x01(\tR\x05query\x12\x17\n" +
 	"\asort_by\x18\x03 \x01(\tR\x06sortBy\x12)\n" +
 	"\n" +
 	"sort_order\x18\x04 \x01(\x0e2\n" +
-	".SortOrderR\tsortOrder\"Z\n" +
+	".SortOrderR\tsortOrder\x12$\n" +
+	"\x06filter\x18\x05 \x01(\v2\f.VideoFilterR\x06filter\"Z\n" +
 	"\x12ListVideosResponse\x12!\n" +
 	"\x04page\x18\x01 \x01(\v2\r.PageResponseR\x04page\x12!\n" +
 	"\x06videos\x18\x02 \x03(\v2\t.VideoDTOR\x06videos\".\n" +
@@ -567,23 +821,25 @@ var file_v1_video_proto_goTypes = []any{
 	(*VideoQueryResponse)(nil), // 4: VideoQueryResponse
 	(*PageRequest)(nil),        // 5: PageRequest
 	(SortOrder)(0),             // 6: SortOrder
-	(*PageResponse)(nil),       // 7: PageResponse
+	(*VideoFilter)(nil),        // 7: VideoFilter
+	(*PageResponse)(nil),       // 8: PageResponse
 }
 var file_v1_video_proto_depIdxs = []int32{
 	5, // 0: ListVideosRequest.page:type_name -> PageRequest
 	6, // 1: ListVideosRequest.sort_order:type_name -> SortOrder
-	7, // 2: ListVideosResponse.page:type_name -> PageResponse
-	0, // 3: ListVideosResponse.videos:type_name -> VideoDTO
-	0, // 4: VideoQueryResponse.video:type_name -> VideoDTO
-	1, // 5: VideoService.ListVideos:input_type -> ListVideosRequest
-	3, // 6: VideoService.GetVideo:input_type -> VideoQueryRequest
-	2, // 7: VideoService.ListVideos:output_type -> ListVideosResponse
-	4, // 8: VideoService.GetVideo:output_type -> VideoQueryResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	7, // 2: ListVideosRequest.filter:type_name -> VideoFilter
+	8, // 3: ListVideosResponse.page:type_name -> PageResponse
+	0, // 4: ListVideosResponse.videos:type_name -> VideoDTO
+	0, // 5: VideoQueryResponse.video:type_name -> VideoDTO
+	1, // 6: VideoService.ListVideos:input_type -> ListVideosRequest
+	3, // 7: VideoService.GetVideo:input_type -> VideoQueryRequest
+	2, // 8: VideoService.ListVideos:output_type -> ListVideosResponse
+	4, // 9: VideoService.GetVideo:output_type -> VideoQueryResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_v1_video_proto_init() }
@@ -592,6 +848,7 @@ func file_v1_video_proto_init() {
 		return
 	}
 	file_v1_page_proto_init()
+	file_v1_filter_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
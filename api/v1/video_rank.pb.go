@@ -140,7 +140,9 @@ type ListVideoRankRequest struct {
 	// 排序字段，例如 "salesCountStr", "totalSalesStr"
 	SortBy string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 	// 排序方式，例如"asc", "desc"
-	SortOrder     string `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	SortOrder string `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// 商品类目节点ID，包含其全部子类目
+	CategoryId    uint64 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +212,13 @@ func (x *ListVideoRankRequest) GetSortOrder() string {
 	return ""
 }
 
+func (x *ListVideoRankRequest) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
 // 分页查询响应
 type ListVideoRankResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -265,6 +274,534 @@ func (x *ListVideoRankResponse) GetRanks() []*VideoRankDTO {
 	return nil
 }
 
+// 上榜历史查询请求
+type GetRankHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 抖音视频ID
+	AwemeId string `protobuf:"bytes,1,opt,name=aweme_id,json=awemeId,proto3" json:"aweme_id,omitempty"`
+	// 榜单周期类型，例如"day", "week", "month"，默认为"day"
+	RankType string `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 起始榜单日期，格式如"20250701"，为空时不限制
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 结束榜单日期，格式如"20250731"，为空时不限制
+	EndDate       string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRankHistoryRequest) Reset() {
+	*x = GetRankHistoryRequest{}
+	mi := &file_v1_video_rank_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRankHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankHistoryRequest) ProtoMessage() {}
+
+func (x *GetRankHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_rank_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRankHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_video_rank_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRankHistoryRequest) GetAwemeId() string {
+	if x != nil {
+		return x.AwemeId
+	}
+	return ""
+}
+
+func (x *GetRankHistoryRequest) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *GetRankHistoryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetRankHistoryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// 上榜历史查询响应
+type GetRankHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 抖音视频ID
+	AwemeId string `protobuf:"bytes,1,opt,name=aweme_id,json=awemeId,proto3" json:"aweme_id,omitempty"`
+	// 榜单周期类型
+	RankType string `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 首次上榜日期
+	FirstSeenDate string `protobuf:"bytes,3,opt,name=first_seen_date,json=firstSeenDate,proto3" json:"first_seen_date,omitempty"`
+	// 最近一次上榜日期
+	LastSeenDate string `protobuf:"bytes,4,opt,name=last_seen_date,json=lastSeenDate,proto3" json:"last_seen_date,omitempty"`
+	// 累计上榜期数
+	DaysOnList int32 `protobuf:"varint,5,opt,name=days_on_list,json=daysOnList,proto3" json:"days_on_list,omitempty"`
+	// 截至最近一次上榜的连续上榜期数
+	CurrentStreak int32 `protobuf:"varint,6,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	// 历史最长连续上榜期数
+	LongestStreak int32 `protobuf:"varint,7,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	// 按日期升序的上榜记录
+	Points        []*RankHistoryPoint `protobuf:"bytes,8,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRankHistoryResponse) Reset() {
+	*x = GetRankHistoryResponse{}
+	mi := &file_v1_video_rank_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRankHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankHistoryResponse) ProtoMessage() {}
+
+func (x *GetRankHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_rank_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRankHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_video_rank_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRankHistoryResponse) GetAwemeId() string {
+	if x != nil {
+		return x.AwemeId
+	}
+	return ""
+}
+
+func (x *GetRankHistoryResponse) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *GetRankHistoryResponse) GetFirstSeenDate() string {
+	if x != nil {
+		return x.FirstSeenDate
+	}
+	return ""
+}
+
+func (x *GetRankHistoryResponse) GetLastSeenDate() string {
+	if x != nil {
+		return x.LastSeenDate
+	}
+	return ""
+}
+
+func (x *GetRankHistoryResponse) GetDaysOnList() int32 {
+	if x != nil {
+		return x.DaysOnList
+	}
+	return 0
+}
+
+func (x *GetRankHistoryResponse) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *GetRankHistoryResponse) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+func (x *GetRankHistoryResponse) GetPoints() []*RankHistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// 单期上榜记录
+type RankHistoryPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 榜单日期
+	RankDate string `protobuf:"bytes,1,opt,name=rank_date,json=rankDate,proto3" json:"rank_date,omitempty"`
+	// 商品ID
+	GoodsId string `protobuf:"bytes,2,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	// 本期名次
+	RankPosition int32 `protobuf:"varint,3,opt,name=rank_position,json=rankPosition,proto3" json:"rank_position,omitempty"`
+	// 上一期名次，上一期未上榜时为0
+	PreviousPosition int32 `protobuf:"varint,4,opt,name=previous_position,json=previousPosition,proto3" json:"previous_position,omitempty"`
+	// 名次变化，正数表示上升，负数表示下降；新上榜时为0
+	PositionDelta int32 `protobuf:"varint,5,opt,name=position_delta,json=positionDelta,proto3" json:"position_delta,omitempty"`
+	// 是否为新上榜（上一期不在榜单中）
+	IsNew bool `protobuf:"varint,6,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`
+	// 销量范围高值
+	SalesCountHigh int64 `protobuf:"varint,7,opt,name=sales_count_high,json=salesCountHigh,proto3" json:"sales_count_high,omitempty"`
+	// 销售额范围高值（分）
+	TotalSalesHigh int64 `protobuf:"varint,8,opt,name=total_sales_high,json=totalSalesHigh,proto3" json:"total_sales_high,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RankHistoryPoint) Reset() {
+	*x = RankHistoryPoint{}
+	mi := &file_v1_video_rank_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankHistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankHistoryPoint) ProtoMessage() {}
+
+func (x *RankHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_rank_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankHistoryPoint.ProtoReflect.Descriptor instead.
+func (*RankHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_v1_video_rank_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RankHistoryPoint) GetRankDate() string {
+	if x != nil {
+		return x.RankDate
+	}
+	return ""
+}
+
+func (x *RankHistoryPoint) GetGoodsId() string {
+	if x != nil {
+		return x.GoodsId
+	}
+	return ""
+}
+
+func (x *RankHistoryPoint) GetRankPosition() int32 {
+	if x != nil {
+		return x.RankPosition
+	}
+	return 0
+}
+
+func (x *RankHistoryPoint) GetPreviousPosition() int32 {
+	if x != nil {
+		return x.PreviousPosition
+	}
+	return 0
+}
+
+func (x *RankHistoryPoint) GetPositionDelta() int32 {
+	if x != nil {
+		return x.PositionDelta
+	}
+	return 0
+}
+
+func (x *RankHistoryPoint) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *RankHistoryPoint) GetSalesCountHigh() int64 {
+	if x != nil {
+		return x.SalesCountHigh
+	}
+	return 0
+}
+
+func (x *RankHistoryPoint) GetTotalSalesHigh() int64 {
+	if x != nil {
+		return x.TotalSalesHigh
+	}
+	return 0
+}
+
+// 榜单变动查询请求
+type ListRankMoversRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 榜单日期，格式如"20250716"
+	RankDate string `protobuf:"bytes,1,opt,name=rank_date,json=rankDate,proto3" json:"rank_date,omitempty"`
+	// 榜单周期类型，例如"day", "week", "month"，默认为"day"
+	RankType string `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 变动方向："up" 上升，"down" 下降，"new" 新上榜；为空时按变动幅度返回全部
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	// 返回条数，默认50，最大200
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRankMoversRequest) Reset() {
+	*x = ListRankMoversRequest{}
+	mi := &file_v1_video_rank_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRankMoversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRankMoversRequest) ProtoMessage() {}
+
+func (x *ListRankMoversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_rank_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRankMoversRequest.ProtoReflect.Descriptor instead.
+func (*ListRankMoversRequest) Descriptor() ([]byte, []int) {
+	return file_v1_video_rank_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListRankMoversRequest) GetRankDate() string {
+	if x != nil {
+		return x.RankDate
+	}
+	return ""
+}
+
+func (x *ListRankMoversRequest) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *ListRankMoversRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ListRankMoversRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 榜单变动查询响应
+type ListRankMoversResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 榜单日期
+	RankDate string `protobuf:"bytes,1,opt,name=rank_date,json=rankDate,proto3" json:"rank_date,omitempty"`
+	// 榜单周期类型
+	RankType string `protobuf:"bytes,2,opt,name=rank_type,json=rankType,proto3" json:"rank_type,omitempty"`
+	// 用于对比的上一期榜单日期
+	PreviousRankDate string `protobuf:"bytes,3,opt,name=previous_rank_date,json=previousRankDate,proto3" json:"previous_rank_date,omitempty"`
+	// 变动列表
+	Movers        []*RankMover `protobuf:"bytes,4,rep,name=movers,proto3" json:"movers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRankMoversResponse) Reset() {
+	*x = ListRankMoversResponse{}
+	mi := &file_v1_video_rank_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRankMoversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRankMoversResponse) ProtoMessage() {}
+
+func (x *ListRankMoversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_rank_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRankMoversResponse.ProtoReflect.Descriptor instead.
+func (*ListRankMoversResponse) Descriptor() ([]byte, []int) {
+	return file_v1_video_rank_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListRankMoversResponse) GetRankDate() string {
+	if x != nil {
+		return x.RankDate
+	}
+	return ""
+}
+
+func (x *ListRankMoversResponse) GetRankType() string {
+	if x != nil {
+		return x.RankType
+	}
+	return ""
+}
+
+func (x *ListRankMoversResponse) GetPreviousRankDate() string {
+	if x != nil {
+		return x.PreviousRankDate
+	}
+	return ""
+}
+
+func (x *ListRankMoversResponse) GetMovers() []*RankMover {
+	if x != nil {
+		return x.Movers
+	}
+	return nil
+}
+
+// 榜单变动条目
+type RankMover struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 本期榜单记录
+	Rank *VideoRankDTO `protobuf:"bytes,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// 上一期名次，新上榜时为0
+	PreviousPosition int32 `protobuf:"varint,2,opt,name=previous_position,json=previousPosition,proto3" json:"previous_position,omitempty"`
+	// 名次变化，正数表示上升，负数表示下降；新上榜时为0
+	PositionDelta int32 `protobuf:"varint,3,opt,name=position_delta,json=positionDelta,proto3" json:"position_delta,omitempty"`
+	// 是否为新上榜
+	IsNew bool `protobuf:"varint,4,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`
+	// 截至本期的连续上榜期数
+	Streak int32 `protobuf:"varint,5,opt,name=streak,proto3" json:"streak,omitempty"`
+	// 首次上榜日期
+	FirstSeenDate string `protobuf:"bytes,6,opt,name=first_seen_date,json=firstSeenDate,proto3" json:"first_seen_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankMover) Reset() {
+	*x = RankMover{}
+	mi := &file_v1_video_rank_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankMover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankMover) ProtoMessage() {}
+
+func (x *RankMover) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_rank_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankMover.ProtoReflect.Descriptor instead.
+func (*RankMover) Descriptor() ([]byte, []int) {
+	return file_v1_video_rank_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RankMover) GetRank() *VideoRankDTO {
+	if x != nil {
+		return x.Rank
+	}
+	return nil
+}
+
+func (x *RankMover) GetPreviousPosition() int32 {
+	if x != nil {
+		return x.PreviousPosition
+	}
+	return 0
+}
+
+func (x *RankMover) GetPositionDelta() int32 {
+	if x != nil {
+		return x.PositionDelta
+	}
+	return 0
+}
+
+func (x *RankMover) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *RankMover) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *RankMover) GetFirstSeenDate() string {
+	if x != nil {
+		return x.FirstSeenDate
+	}
+	return ""
+}
+
 // 榜单视频榜结构
 type VideoRankDTO struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -280,6 +817,8 @@ type VideoRankDTO struct {
 	StartDate string `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	// 榜单结束日期
 	EndDate string `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 榜单名次，从1开始，0表示未知
+	RankPosition int32 `protobuf:"varint,42,opt,name=rank_position,json=rankPosition,proto3" json:"rank_position,omitempty"`
 	// 视频信息
 	// 抖音视频ID
 	AwemeId string `protobuf:"bytes,8,opt,name=aweme_id,json=awemeId,proto3" json:"aweme_id,omitempty"`
@@ -347,13 +886,15 @@ type VideoRankDTO struct {
 	TotalSalesLow int64 `protobuf:"varint,37,opt,name=total_sales_low,json=totalSalesLow,proto3" json:"total_sales_low,omitempty"`
 	// 销售额范围高值
 	TotalSalesHigh int64 `protobuf:"varint,38,opt,name=total_sales_high,json=totalSalesHigh,proto3" json:"total_sales_high,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// 来源 source_data 的ID，用于重放时的幂等写入
+	SourceDataId  int64 `protobuf:"varint,41,opt,name=source_data_id,json=sourceDataId,proto3" json:"source_data_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VideoRankDTO) Reset() {
 	*x = VideoRankDTO{}
-	mi := &file_v1_video_rank_proto_msgTypes[4]
+	mi := &file_v1_video_rank_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +906,7 @@ func (x *VideoRankDTO) String() string {
 func (*VideoRankDTO) ProtoMessage() {}
 
 func (x *VideoRankDTO) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_video_rank_proto_msgTypes[4]
+	mi := &file_v1_video_rank_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +919,7 @@ func (x *VideoRankDTO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VideoRankDTO.ProtoReflect.Descriptor instead.
 func (*VideoRankDTO) Descriptor() ([]byte, []int) {
-	return file_v1_video_rank_proto_rawDescGZIP(), []int{4}
+	return file_v1_video_rank_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *VideoRankDTO) GetId() int64 {
@@ -423,6 +964,13 @@ func (x *VideoRankDTO) GetEndDate() string {
 	return ""
 }
 
+func (x *VideoRankDTO) GetRankPosition() int32 {
+	if x != nil {
+		return x.RankPosition
+	}
+	return 0
+}
+
 func (x *VideoRankDTO) GetAwemeId() string {
 	if x != nil {
 		return x.AwemeId
@@ -647,6 +1195,13 @@ func (x *VideoRankDTO) GetTotalSalesHigh() int64 {
 	return 0
 }
 
+func (x *VideoRankDTO) GetSourceDataId() int64 {
+	if x != nil {
+		return x.SourceDataId
+	}
+	return 0
+}
+
 var File_v1_video_rank_proto protoreflect.FileDescriptor
 
 const file_v1_video_rank_proto_rawDesc = "" +
@@ -657,18 +1212,62 @@ const file_v1_video_rank_proto_rawDesc = "" +
 	"\trank_type\x18\x02 \x01(\tR\brankType\x12\x1b\n" +
 	"\trank_date\x18\x03 \x01(\tR\brankDate\";\n" +
 	"\x16VideoRankQueryResponse\x12!\n" +
-	"\x04rank\x18\x01 \x01(\v2\r.VideoRankDTOR\x04rank\"\xaa\x01\n" +
+	"\x04rank\x18\x01 \x01(\v2\r.VideoRankDTOR\x04rank\"\xcb\x01\n" +
 	"\x14ListVideoRankRequest\x12 \n" +
 	"\x04page\x18\x01 \x01(\v2\f.PageRequestR\x04page\x12\x1b\n" +
 	"\trank_type\x18\x02 \x01(\tR\brankType\x12\x1b\n" +
 	"\trank_date\x18\x03 \x01(\tR\brankDate\x12\x17\n" +
 	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12\x1d\n" +
 	"\n" +
-	"sort_order\x18\x05 \x01(\tR\tsortOrder\"_\n" +
+	"sort_order\x18\x05 \x01(\tR\tsortOrder\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\x04R\n" +
+	"categoryId\"_\n" +
 	"\x15ListVideoRankResponse\x12!\n" +
 	"\x04page\x18\x01 \x01(\v2\r.PageResponseR\x04page\x12#\n" +
-	"\x05ranks\x18\x02 \x03(\v2\r.VideoRankDTOR\x05ranks\"\xd9\n" +
+	"\x05ranks\x18\x02 \x03(\v2\r.VideoRankDTOR\x05ranks\"\x89\x01\n" +
+	"\x15GetRankHistoryRequest\x12\x19\n" +
+	"\baweme_id\x18\x01 \x01(\tR\aawemeId\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12\x1d\n" +
 	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"\xb9\x02\n" +
+	"\x16GetRankHistoryResponse\x12\x19\n" +
+	"\baweme_id\x18\x01 \x01(\tR\aawemeId\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12&\n" +
+	"\x0ffirst_seen_date\x18\x03 \x01(\tR\rfirstSeenDate\x12$\n" +
+	"\x0elast_seen_date\x18\x04 \x01(\tR\flastSeenDate\x12 \n" +
+	"\fdays_on_list\x18\x05 \x01(\x05R\n" +
+	"daysOnList\x12%\n" +
+	"\x0ecurrent_streak\x18\x06 \x01(\x05R\rcurrentStreak\x12%\n" +
+	"\x0elongest_streak\x18\a \x01(\x05R\rlongestStreak\x12)\n" +
+	"\x06points\x18\b \x03(\v2\x11.RankHistoryPointR\x06points\"\xae\x02\n" +
+	"\x10RankHistoryPoint\x12\x1b\n" +
+	"\trank_date\x18\x01 \x01(\tR\brankDate\x12\x19\n" +
+	"\bgoods_id\x18\x02 \x01(\tR\agoodsId\x12#\n" +
+	"\rrank_position\x18\x03 \x01(\x05R\frankPosition\x12+\n" +
+	"\x11previous_position\x18\x04 \x01(\x05R\x10previousPosition\x12%\n" +
+	"\x0eposition_delta\x18\x05 \x01(\x05R\rpositionDelta\x12\x15\n" +
+	"\x06is_new\x18\x06 \x01(\bR\x05isNew\x12(\n" +
+	"\x10sales_count_high\x18\a \x01(\x03R\x0esalesCountHigh\x12(\n" +
+	"\x10total_sales_high\x18\b \x01(\x03R\x0etotalSalesHigh\"\x85\x01\n" +
+	"\x15ListRankMoversRequest\x12\x1b\n" +
+	"\trank_date\x18\x01 \x01(\tR\brankDate\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xa4\x01\n" +
+	"\x16ListRankMoversResponse\x12\x1b\n" +
+	"\trank_date\x18\x01 \x01(\tR\brankDate\x12\x1b\n" +
+	"\trank_type\x18\x02 \x01(\tR\brankType\x12,\n" +
+	"\x12previous_rank_date\x18\x03 \x01(\tR\x10previousRankDate\x12\"\n" +
+	"\x06movers\x18\x04 \x03(\v2\n" +
+	".RankMoverR\x06movers\"\xd9\x01\n" +
+	"\tRankMover\x12!\n" +
+	"\x04rank\x18\x01 \x01(\v2\r.VideoRankDTOR\x04rank\x12+\n" +
+	"\x11previous_position\x18\x02 \x01(\x05R\x10previousPosition\x12%\n" +
+	"\x0eposition_delta\x18\x03 \x01(\x05R\rpositionDelta\x12\x15\n" +
+	"\x06is_new\x18\x04 \x01(\bR\x05isNew\x12\x16\n" +
+	"\x06streak\x18\x05 \x01(\x05R\x06streak\x12&\n" +
+	"\x0ffirst_seen_date\x18\x06 \x01(\tR\rfirstSeenDate\"\xa4\v\n" +
 	"\fVideoRankDTO\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -678,7 +1277,8 @@ const file_v1_video_rank_proto_rawDesc = "" +
 	"\trank_date\x18\x05 \x01(\tR\brankDate\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\a \x01(\tR\aendDate\x12\x19\n" +
+	"\bend_date\x18\a \x01(\tR\aendDate\x12#\n" +
+	"\rrank_position\x18* \x01(\x05R\frankPosition\x12\x19\n" +
 	"\baweme_id\x18\b \x01(\tR\aawemeId\x12&\n" +
 	"\x0faweme_cover_url\x18\t \x01(\tR\rawemeCoverUrl\x12\x1d\n" +
 	"\n" +
@@ -718,10 +1318,13 @@ const file_v1_video_rank_proto_rawDesc = "" +
 	"\x0fsales_count_low\x18# \x01(\x03R\rsalesCountLow\x12(\n" +
 	"\x10sales_count_high\x18$ \x01(\x03R\x0esalesCountHigh\x12&\n" +
 	"\x0ftotal_sales_low\x18% \x01(\x03R\rtotalSalesLow\x12(\n" +
-	"\x10total_sales_high\x18& \x01(\x03R\x0etotalSalesHigh2\xc7\x01\n" +
+	"\x10total_sales_high\x18& \x01(\x03R\x0etotalSalesHigh\x12$\n" +
+	"\x0esource_data_id\x18) \x01(\x03R\fsourceDataId2\x92\x03\n" +
 	"\tVideoRank\x12Z\n" +
 	"\fGetVideoRank\x12\x16.VideoRankQueryRequest\x1a\x17.VideoRankQueryResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/video_rank\x12^\n" +
-	"\rListVideoRank\x12\x15.ListVideoRankRequest\x1a\x16.ListVideoRankResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/video_rank/listB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
+	"\rListVideoRank\x12\x15.ListVideoRankRequest\x1a\x16.ListVideoRankResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/video_rank/list\x12d\n" +
+	"\x0eGetRankHistory\x12\x16.GetRankHistoryRequest\x1a\x17.GetRankHistoryResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/video_rank/history\x12c\n" +
+	"\x0eListRankMovers\x12\x16.ListRankMoversRequest\x1a\x17.ListRankMoversResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/video_rank/moversB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
 
 var (
 	file_v1_video_rank_proto_rawDescOnce sync.Once
@@ -735,30 +1338,43 @@ func file_v1_video_rank_proto_rawDescGZIP() []byte {
 	return file_v1_video_rank_proto_rawDescData
 }
 
-var file_v1_video_rank_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_video_rank_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_v1_video_rank_proto_goTypes = []any{
 	(*VideoRankQueryRequest)(nil),  // 0: VideoRankQueryRequest
 	(*VideoRankQueryResponse)(nil), // 1: VideoRankQueryResponse
 	(*ListVideoRankRequest)(nil),   // 2: ListVideoRankRequest
 	(*ListVideoRankResponse)(nil),  // 3: ListVideoRankResponse
-	(*VideoRankDTO)(nil),           // 4: VideoRankDTO
-	(*PageRequest)(nil),            // 5: PageRequest
-	(*PageResponse)(nil),           // 6: PageResponse
+	(*GetRankHistoryRequest)(nil),  // 4: GetRankHistoryRequest
+	(*GetRankHistoryResponse)(nil), // 5: GetRankHistoryResponse
+	(*RankHistoryPoint)(nil),       // 6: RankHistoryPoint
+	(*ListRankMoversRequest)(nil),  // 7: ListRankMoversRequest
+	(*ListRankMoversResponse)(nil), // 8: ListRankMoversResponse
+	(*RankMover)(nil),              // 9: RankMover
+	(*VideoRankDTO)(nil),           // 10: VideoRankDTO
+	(*PageRequest)(nil),            // 11: PageRequest
+	(*PageResponse)(nil),           // 12: PageResponse
 }
 var file_v1_video_rank_proto_depIdxs = []int32{
-	4, // 0: VideoRankQueryResponse.rank:type_name -> VideoRankDTO
-	5, // 1: ListVideoRankRequest.page:type_name -> PageRequest
-	6, // 2: ListVideoRankResponse.page:type_name -> PageResponse
-	4, // 3: ListVideoRankResponse.ranks:type_name -> VideoRankDTO
-	0, // 4: VideoRank.GetVideoRank:input_type -> VideoRankQueryRequest
-	2, // 5: VideoRank.ListVideoRank:input_type -> ListVideoRankRequest
-	1, // 6: VideoRank.GetVideoRank:output_type -> VideoRankQueryResponse
-	3, // 7: VideoRank.ListVideoRank:output_type -> ListVideoRankResponse
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	10, // 0: VideoRankQueryResponse.rank:type_name -> VideoRankDTO
+	11, // 1: ListVideoRankRequest.page:type_name -> PageRequest
+	12, // 2: ListVideoRankResponse.page:type_name -> PageResponse
+	10, // 3: ListVideoRankResponse.ranks:type_name -> VideoRankDTO
+	6,  // 4: GetRankHistoryResponse.points:type_name -> RankHistoryPoint
+	9,  // 5: ListRankMoversResponse.movers:type_name -> RankMover
+	10, // 6: RankMover.rank:type_name -> VideoRankDTO
+	0,  // 7: VideoRank.GetVideoRank:input_type -> VideoRankQueryRequest
+	2,  // 8: VideoRank.ListVideoRank:input_type -> ListVideoRankRequest
+	4,  // 9: VideoRank.GetRankHistory:input_type -> GetRankHistoryRequest
+	7,  // 10: VideoRank.ListRankMovers:input_type -> ListRankMoversRequest
+	1,  // 11: VideoRank.GetVideoRank:output_type -> VideoRankQueryResponse
+	3,  // 12: VideoRank.ListVideoRank:output_type -> ListVideoRankResponse
+	5,  // 13: VideoRank.GetRankHistory:output_type -> GetRankHistoryResponse
+	8,  // 14: VideoRank.ListRankMovers:output_type -> ListRankMoversResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_v1_video_rank_proto_init() }
@@ -773,7 +1389,7 @@ func file_v1_video_rank_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_video_rank_proto_rawDesc), len(file_v1_video_rank_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
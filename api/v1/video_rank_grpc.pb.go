@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VideoRank_GetVideoRank_FullMethodName  = "/VideoRank/GetVideoRank"
-	VideoRank_ListVideoRank_FullMethodName = "/VideoRank/ListVideoRank"
+	VideoRank_GetVideoRank_FullMethodName   = "/VideoRank/GetVideoRank"
+	VideoRank_ListVideoRank_FullMethodName  = "/VideoRank/ListVideoRank"
+	VideoRank_GetRankHistory_FullMethodName = "/VideoRank/GetRankHistory"
+	VideoRank_ListRankMovers_FullMethodName = "/VideoRank/ListRankMovers"
 )
 
 // VideoRankClient is the client API for VideoRank service.
@@ -33,6 +35,10 @@ type VideoRankClient interface {
 	GetVideoRank(ctx context.Context, in *VideoRankQueryRequest, opts ...grpc.CallOption) (*VideoRankQueryResponse, error)
 	// 分页查询视频榜单信息
 	ListVideoRank(ctx context.Context, in *ListVideoRankRequest, opts ...grpc.CallOption) (*ListVideoRankResponse, error)
+	// 查询单个视频的上榜历史，包括名次变化、连续上榜期数和首次上榜日期
+	GetRankHistory(ctx context.Context, in *GetRankHistoryRequest, opts ...grpc.CallOption) (*GetRankHistoryResponse, error)
+	// 查询某一期榜单中名次变化最大的视频（飙升、下降、新上榜）
+	ListRankMovers(ctx context.Context, in *ListRankMoversRequest, opts ...grpc.CallOption) (*ListRankMoversResponse, error)
 }
 
 type videoRankClient struct {
@@ -63,6 +69,26 @@ func (c *videoRankClient) ListVideoRank(ctx context.Context, in *ListVideoRankRe
 	return out, nil
 }
 
+func (c *videoRankClient) GetRankHistory(ctx context.Context, in *GetRankHistoryRequest, opts ...grpc.CallOption) (*GetRankHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRankHistoryResponse)
+	err := c.cc.Invoke(ctx, VideoRank_GetRankHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoRankClient) ListRankMovers(ctx context.Context, in *ListRankMoversRequest, opts ...grpc.CallOption) (*ListRankMoversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRankMoversResponse)
+	err := c.cc.Invoke(ctx, VideoRank_ListRankMovers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoRankServer is the server API for VideoRank service.
 // All implementations must embed UnimplementedVideoRankServer
 // for forward compatibility.
@@ -73,6 +99,10 @@ type VideoRankServer interface {
 	GetVideoRank(context.Context, *VideoRankQueryRequest) (*VideoRankQueryResponse, error)
 	// 分页查询视频榜单信息
 	ListVideoRank(context.Context, *ListVideoRankRequest) (*ListVideoRankResponse, error)
+	// 查询单个视频的上榜历史，包括名次变化、连续上榜期数和首次上榜日期
+	GetRankHistory(context.Context, *GetRankHistoryRequest) (*GetRankHistoryResponse, error)
+	// 查询某一期榜单中名次变化最大的视频（飙升、下降、新上榜）
+	ListRankMovers(context.Context, *ListRankMoversRequest) (*ListRankMoversResponse, error)
 	mustEmbedUnimplementedVideoRankServer()
 }
 
@@ -89,6 +119,12 @@ func (UnimplementedVideoRankServer) GetVideoRank(context.Context, *VideoRankQuer
 func (UnimplementedVideoRankServer) ListVideoRank(context.Context, *ListVideoRankRequest) (*ListVideoRankResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVideoRank not implemented")
 }
+func (UnimplementedVideoRankServer) GetRankHistory(context.Context, *GetRankHistoryRequest) (*GetRankHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRankHistory not implemented")
+}
+func (UnimplementedVideoRankServer) ListRankMovers(context.Context, *ListRankMoversRequest) (*ListRankMoversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRankMovers not implemented")
+}
 func (UnimplementedVideoRankServer) mustEmbedUnimplementedVideoRankServer() {}
 func (UnimplementedVideoRankServer) testEmbeddedByValue()                   {}
 
@@ -146,6 +182,42 @@ func _VideoRank_ListVideoRank_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoRank_GetRankHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRankServer).GetRankHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRank_GetRankHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRankServer).GetRankHistory(ctx, req.(*GetRankHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoRank_ListRankMovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRankMoversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoRankServer).ListRankMovers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoRank_ListRankMovers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoRankServer).ListRankMovers(ctx, req.(*ListRankMoversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VideoRank_ServiceDesc is the grpc.ServiceDesc for VideoRank service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +233,14 @@ var VideoRank_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListVideoRank",
 			Handler:    _VideoRank_ListVideoRank_Handler,
 		},
+		{
+			MethodName: "GetRankHistory",
+			Handler:    _VideoRank_GetRankHistory_Handler,
+		},
+		{
+			MethodName: "ListRankMovers",
+			Handler:    _VideoRank_ListRankMovers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/video_rank.proto",
@@ -19,12 +19,18 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationVideoRankGetRankHistory = "/VideoRank/GetRankHistory"
 const OperationVideoRankGetVideoRank = "/VideoRank/GetVideoRank"
+const OperationVideoRankListRankMovers = "/VideoRank/ListRankMovers"
 const OperationVideoRankListVideoRank = "/VideoRank/ListVideoRank"
 
 type VideoRankHTTPServer interface {
+	// GetRankHistory 查询单个视频的上榜历史，包括名次变化、连续上榜期数和首次上榜日期
+	GetRankHistory(context.Context, *GetRankHistoryRequest) (*GetRankHistoryResponse, error)
 	// GetVideoRank 查询单个视频榜单信息
 	GetVideoRank(context.Context, *VideoRankQueryRequest) (*VideoRankQueryResponse, error)
+	// ListRankMovers 查询某一期榜单中名次变化最大的视频（飙升、下降、新上榜）
+	ListRankMovers(context.Context, *ListRankMoversRequest) (*ListRankMoversResponse, error)
 	// ListVideoRank 分页查询视频榜单信息
 	ListVideoRank(context.Context, *ListVideoRankRequest) (*ListVideoRankResponse, error)
 }
@@ -33,6 +39,8 @@ func RegisterVideoRankHTTPServer(s *http.Server, srv VideoRankHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/video_rank", _VideoRank_GetVideoRank0_HTTP_Handler(srv))
 	r.POST("/v1/video_rank/list", _VideoRank_ListVideoRank0_HTTP_Handler(srv))
+	r.POST("/v1/video_rank/history", _VideoRank_GetRankHistory0_HTTP_Handler(srv))
+	r.POST("/v1/video_rank/movers", _VideoRank_ListRankMovers0_HTTP_Handler(srv))
 }
 
 func _VideoRank_GetVideoRank0_HTTP_Handler(srv VideoRankHTTPServer) func(ctx http.Context) error {
@@ -79,8 +87,54 @@ func _VideoRank_ListVideoRank0_HTTP_Handler(srv VideoRankHTTPServer) func(ctx ht
 	}
 }
 
+func _VideoRank_GetRankHistory0_HTTP_Handler(srv VideoRankHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRankHistoryRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoRankGetRankHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRankHistory(ctx, req.(*GetRankHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRankHistoryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoRank_ListRankMovers0_HTTP_Handler(srv VideoRankHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRankMoversRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoRankListRankMovers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRankMovers(ctx, req.(*ListRankMoversRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRankMoversResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type VideoRankHTTPClient interface {
+	GetRankHistory(ctx context.Context, req *GetRankHistoryRequest, opts ...http.CallOption) (rsp *GetRankHistoryResponse, err error)
 	GetVideoRank(ctx context.Context, req *VideoRankQueryRequest, opts ...http.CallOption) (rsp *VideoRankQueryResponse, err error)
+	ListRankMovers(ctx context.Context, req *ListRankMoversRequest, opts ...http.CallOption) (rsp *ListRankMoversResponse, err error)
 	ListVideoRank(ctx context.Context, req *ListVideoRankRequest, opts ...http.CallOption) (rsp *ListVideoRankResponse, err error)
 }
 
@@ -92,6 +146,19 @@ func NewVideoRankHTTPClient(client *http.Client) VideoRankHTTPClient {
 	return &VideoRankHTTPClientImpl{client}
 }
 
+func (c *VideoRankHTTPClientImpl) GetRankHistory(ctx context.Context, in *GetRankHistoryRequest, opts ...http.CallOption) (*GetRankHistoryResponse, error) {
+	var out GetRankHistoryResponse
+	pattern := "/v1/video_rank/history"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoRankGetRankHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoRankHTTPClientImpl) GetVideoRank(ctx context.Context, in *VideoRankQueryRequest, opts ...http.CallOption) (*VideoRankQueryResponse, error) {
 	var out VideoRankQueryResponse
 	pattern := "/v1/video_rank"
@@ -105,6 +172,19 @@ func (c *VideoRankHTTPClientImpl) GetVideoRank(ctx context.Context, in *VideoRan
 	return &out, nil
 }
 
+func (c *VideoRankHTTPClientImpl) ListRankMovers(ctx context.Context, in *ListRankMoversRequest, opts ...http.CallOption) (*ListRankMoversResponse, error) {
+	var out ListRankMoversResponse
+	pattern := "/v1/video_rank/movers"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoRankListRankMovers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoRankHTTPClientImpl) ListVideoRank(ctx context.Context, in *ListVideoRankRequest, opts ...http.CallOption) (*ListVideoRankResponse, error) {
 	var out ListVideoRankResponse
 	pattern := "/v1/video_rank/list"
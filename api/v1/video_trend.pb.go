@@ -22,6 +22,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 视频生命周期阶段
+type VideoLifecycle int32
+
+const (
+	VideoLifecycle_VIDEO_LIFECYCLE_UNKNOWN VideoLifecycle = 0
+	// 上升期：最近一天是峰值且仍在增长
+	VideoLifecycle_VIDEO_LIFECYCLE_RISING VideoLifecycle = 1
+	// 高峰期：最近的增量接近峰值
+	VideoLifecycle_VIDEO_LIFECYCLE_PEAKING VideoLifecycle = 2
+	// 衰退期：已明显低于峰值
+	VideoLifecycle_VIDEO_LIFECYCLE_DECAYING VideoLifecycle = 3
+	// 沉寂：最近几天几乎没有增量
+	VideoLifecycle_VIDEO_LIFECYCLE_DEAD VideoLifecycle = 4
+)
+
+// Enum value maps for VideoLifecycle.
+var (
+	VideoLifecycle_name = map[int32]string{
+		0: "VIDEO_LIFECYCLE_UNKNOWN",
+		1: "VIDEO_LIFECYCLE_RISING",
+		2: "VIDEO_LIFECYCLE_PEAKING",
+		3: "VIDEO_LIFECYCLE_DECAYING",
+		4: "VIDEO_LIFECYCLE_DEAD",
+	}
+	VideoLifecycle_value = map[string]int32{
+		"VIDEO_LIFECYCLE_UNKNOWN":  0,
+		"VIDEO_LIFECYCLE_RISING":   1,
+		"VIDEO_LIFECYCLE_PEAKING":  2,
+		"VIDEO_LIFECYCLE_DECAYING": 3,
+		"VIDEO_LIFECYCLE_DEAD":     4,
+	}
+)
+
+func (x VideoLifecycle) Enum() *VideoLifecycle {
+	p := new(VideoLifecycle)
+	*p = x
+	return p
+}
+
+func (x VideoLifecycle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VideoLifecycle) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_video_trend_proto_enumTypes[0].Descriptor()
+}
+
+func (VideoLifecycle) Type() protoreflect.EnumType {
+	return &file_v1_video_trend_proto_enumTypes[0]
+}
+
+func (x VideoLifecycle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VideoLifecycle.Descriptor instead.
+func (VideoLifecycle) EnumDescriptor() ([]byte, []int) {
+	return file_v1_video_trend_proto_rawDescGZIP(), []int{0}
+}
+
 // 视频每日趋势数据 DTO
 type VideoTrendDTO struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
@@ -498,6 +558,562 @@ func (x *ListVideoTrendsResponse) GetTrends() []*VideoTrendDTO {
 	return nil
 }
 
+// 单个视频趋势分析请求
+type VideoTrendAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 视频ID
+	AwemeId string `protobuf:"bytes,1,opt,name=aweme_id,json=awemeId,proto3" json:"aweme_id,omitempty"`
+	// 起始日期，格式 "20060102"，为空时不限制
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// 结束日期，格式 "20060102"，为空时不限制
+	EndDate string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 分析指标：sales_gmv（默认）, sales_count, like_count, comment_count, share_count, collect_count
+	Metric        string `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoTrendAnalyticsRequest) Reset() {
+	*x = VideoTrendAnalyticsRequest{}
+	mi := &file_v1_video_trend_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoTrendAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoTrendAnalyticsRequest) ProtoMessage() {}
+
+func (x *VideoTrendAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_trend_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoTrendAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*VideoTrendAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_video_trend_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VideoTrendAnalyticsRequest) GetAwemeId() string {
+	if x != nil {
+		return x.AwemeId
+	}
+	return ""
+}
+
+func (x *VideoTrendAnalyticsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *VideoTrendAnalyticsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *VideoTrendAnalyticsRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+// 趋势分析中的一天
+type VideoTrendPoint struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DateCode int32                  `protobuf:"varint,1,opt,name=date_code,json=dateCode,proto3" json:"date_code,omitempty"`
+	// 当天的指标增量
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// 截至当天的累计增量
+	Cumulative float64 `protobuf:"fixed64,3,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
+	// 相对前一天的增长率，前一天为 0 时为 0
+	GrowthRate    float64 `protobuf:"fixed64,4,opt,name=growth_rate,json=growthRate,proto3" json:"growth_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoTrendPoint) Reset() {
+	*x = VideoTrendPoint{}
+	mi := &file_v1_video_trend_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoTrendPoint) ProtoMessage() {}
+
+func (x *VideoTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_trend_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoTrendPoint.ProtoReflect.Descriptor instead.
+func (*VideoTrendPoint) Descriptor() ([]byte, []int) {
+	return file_v1_video_trend_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VideoTrendPoint) GetDateCode() int32 {
+	if x != nil {
+		return x.DateCode
+	}
+	return 0
+}
+
+func (x *VideoTrendPoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *VideoTrendPoint) GetCumulative() float64 {
+	if x != nil {
+		return x.Cumulative
+	}
+	return 0
+}
+
+func (x *VideoTrendPoint) GetGrowthRate() float64 {
+	if x != nil {
+		return x.GrowthRate
+	}
+	return 0
+}
+
+// 视频趋势分析结果
+type VideoTrendAnalytics struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AwemeId string                 `protobuf:"bytes,1,opt,name=aweme_id,json=awemeId,proto3" json:"aweme_id,omitempty"`
+	Metric  string                 `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	// 按日期升序的每日数据，榜单接口中不返回
+	Points []*VideoTrendPoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	// 峰值日期和峰值当天的增量
+	PeakDateCode int32   `protobuf:"varint,4,opt,name=peak_date_code,json=peakDateCode,proto3" json:"peak_date_code,omitempty"`
+	PeakValue    float64 `protobuf:"fixed64,5,opt,name=peak_value,json=peakValue,proto3" json:"peak_value,omitempty"`
+	// 区间内指标增量之和
+	TotalValue float64 `protobuf:"fixed64,6,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	// 累计 GMV（元），取区间内最后一天的 sales_gmv
+	CumulativeGmv float64 `protobuf:"fixed64,7,opt,name=cumulative_gmv,json=cumulativeGmv,proto3" json:"cumulative_gmv,omitempty"`
+	// 峰值之后的平均日衰减率，0.2 表示每天下降 20%；峰值在最后一天时为 0
+	DecayRate float64 `protobuf:"fixed64,8,opt,name=decay_rate,json=decayRate,proto3" json:"decay_rate,omitempty"`
+	// 距峰值的天数
+	DaysSincePeak int32 `protobuf:"varint,9,opt,name=days_since_peak,json=daysSincePeak,proto3" json:"days_since_peak,omitempty"`
+	// 生命周期阶段
+	Lifecycle     VideoLifecycle `protobuf:"varint,10,opt,name=lifecycle,proto3,enum=v1.VideoLifecycle" json:"lifecycle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoTrendAnalytics) Reset() {
+	*x = VideoTrendAnalytics{}
+	mi := &file_v1_video_trend_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoTrendAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoTrendAnalytics) ProtoMessage() {}
+
+func (x *VideoTrendAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_trend_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoTrendAnalytics.ProtoReflect.Descriptor instead.
+func (*VideoTrendAnalytics) Descriptor() ([]byte, []int) {
+	return file_v1_video_trend_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VideoTrendAnalytics) GetAwemeId() string {
+	if x != nil {
+		return x.AwemeId
+	}
+	return ""
+}
+
+func (x *VideoTrendAnalytics) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *VideoTrendAnalytics) GetPoints() []*VideoTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *VideoTrendAnalytics) GetPeakDateCode() int32 {
+	if x != nil {
+		return x.PeakDateCode
+	}
+	return 0
+}
+
+func (x *VideoTrendAnalytics) GetPeakValue() float64 {
+	if x != nil {
+		return x.PeakValue
+	}
+	return 0
+}
+
+func (x *VideoTrendAnalytics) GetTotalValue() float64 {
+	if x != nil {
+		return x.TotalValue
+	}
+	return 0
+}
+
+func (x *VideoTrendAnalytics) GetCumulativeGmv() float64 {
+	if x != nil {
+		return x.CumulativeGmv
+	}
+	return 0
+}
+
+func (x *VideoTrendAnalytics) GetDecayRate() float64 {
+	if x != nil {
+		return x.DecayRate
+	}
+	return 0
+}
+
+func (x *VideoTrendAnalytics) GetDaysSincePeak() int32 {
+	if x != nil {
+		return x.DaysSincePeak
+	}
+	return 0
+}
+
+func (x *VideoTrendAnalytics) GetLifecycle() VideoLifecycle {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return VideoLifecycle_VIDEO_LIFECYCLE_UNKNOWN
+}
+
+// 单个视频趋势分析响应
+type VideoTrendAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analytics     *VideoTrendAnalytics   `protobuf:"bytes,1,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoTrendAnalyticsResponse) Reset() {
+	*x = VideoTrendAnalyticsResponse{}
+	mi := &file_v1_video_trend_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoTrendAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoTrendAnalyticsResponse) ProtoMessage() {}
+
+func (x *VideoTrendAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_trend_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoTrendAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*VideoTrendAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_video_trend_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VideoTrendAnalyticsResponse) GetAnalytics() *VideoTrendAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+// 增长最快视频查询请求
+type ListTrendingVideosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 当前窗口的起止日期，格式 "20060102"；都为空时取截至昨天的最近 7 天
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 排序指标，同 VideoTrendAnalyticsRequest.metric
+	Metric string `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric,omitempty"`
+	// 排序方式：growth（按增量差值，默认）或 growth_rate（按增长率）
+	SortBy string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// 只返回指定生命周期阶段的视频，UNKNOWN 表示不过滤
+	Lifecycle VideoLifecycle `protobuf:"varint,5,opt,name=lifecycle,proto3,enum=v1.VideoLifecycle" json:"lifecycle,omitempty"`
+	// 返回条数，默认 20，最大 100
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingVideosRequest) Reset() {
+	*x = ListTrendingVideosRequest{}
+	mi := &file_v1_video_trend_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingVideosRequest) ProtoMessage() {}
+
+func (x *ListTrendingVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_trend_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingVideosRequest) Descriptor() ([]byte, []int) {
+	return file_v1_video_trend_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTrendingVideosRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListTrendingVideosRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListTrendingVideosRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *ListTrendingVideosRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListTrendingVideosRequest) GetLifecycle() VideoLifecycle {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return VideoLifecycle_VIDEO_LIFECYCLE_UNKNOWN
+}
+
+func (x *ListTrendingVideosRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 增长最快的视频
+type TrendingVideo struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AwemeId string                 `protobuf:"bytes,1,opt,name=aweme_id,json=awemeId,proto3" json:"aweme_id,omitempty"`
+	// 当前窗口内的指标增量之和
+	CurrentValue float64 `protobuf:"fixed64,2,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	// 上一个等长窗口内的指标增量之和
+	PreviousValue float64 `protobuf:"fixed64,3,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	// current_value - previous_value
+	Growth float64 `protobuf:"fixed64,4,opt,name=growth,proto3" json:"growth,omitempty"`
+	// growth / previous_value，上一窗口为 0 时为 0
+	GrowthRate float64 `protobuf:"fixed64,5,opt,name=growth_rate,json=growthRate,proto3" json:"growth_rate,omitempty"`
+	// 基于当前窗口数据的分析结果（不含每日数据）
+	Analytics     *VideoTrendAnalytics `protobuf:"bytes,6,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingVideo) Reset() {
+	*x = TrendingVideo{}
+	mi := &file_v1_video_trend_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingVideo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingVideo) ProtoMessage() {}
+
+func (x *TrendingVideo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_trend_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingVideo.ProtoReflect.Descriptor instead.
+func (*TrendingVideo) Descriptor() ([]byte, []int) {
+	return file_v1_video_trend_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TrendingVideo) GetAwemeId() string {
+	if x != nil {
+		return x.AwemeId
+	}
+	return ""
+}
+
+func (x *TrendingVideo) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *TrendingVideo) GetPreviousValue() float64 {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return 0
+}
+
+func (x *TrendingVideo) GetGrowth() float64 {
+	if x != nil {
+		return x.Growth
+	}
+	return 0
+}
+
+func (x *TrendingVideo) GetGrowthRate() float64 {
+	if x != nil {
+		return x.GrowthRate
+	}
+	return 0
+}
+
+func (x *TrendingVideo) GetAnalytics() *VideoTrendAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+// 增长最快视频查询响应
+type ListTrendingVideosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 实际使用的窗口
+	StartDate     string           `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string           `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Videos        []*TrendingVideo `protobuf:"bytes,3,rep,name=videos,proto3" json:"videos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingVideosResponse) Reset() {
+	*x = ListTrendingVideosResponse{}
+	mi := &file_v1_video_trend_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingVideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingVideosResponse) ProtoMessage() {}
+
+func (x *ListTrendingVideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_video_trend_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingVideosResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingVideosResponse) Descriptor() ([]byte, []int) {
+	return file_v1_video_trend_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTrendingVideosResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListTrendingVideosResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListTrendingVideosResponse) GetVideos() []*TrendingVideo {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
 var File_v1_video_trend_proto protoreflect.FileDescriptor
 
 const file_v1_video_trend_proto_rawDesc = "" +
@@ -559,9 +1175,69 @@ const file_v1_video_trend_proto_rawDesc = "" +
 	"\bend_date\x18\x04 \x01(\tR\aendDate\"g\n" +
 	"\x17ListVideoTrendsResponse\x12!\n" +
 	"\x04page\x18\x01 \x01(\v2\r.PageResponseR\x04page\x12)\n" +
-	"\x06trends\x18\x02 \x03(\v2\x11.v1.VideoTrendDTOR\x06trends2\x81\x01\n" +
+	"\x06trends\x18\x02 \x03(\v2\x11.v1.VideoTrendDTOR\x06trends\"\x89\x01\n" +
+	"\x1aVideoTrendAnalyticsRequest\x12\x19\n" +
+	"\baweme_id\x18\x01 \x01(\tR\aawemeId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06metric\x18\x04 \x01(\tR\x06metric\"\x85\x01\n" +
+	"\x0fVideoTrendPoint\x12\x1b\n" +
+	"\tdate_code\x18\x01 \x01(\x05R\bdateCode\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x1e\n" +
+	"\n" +
+	"cumulative\x18\x03 \x01(\x01R\n" +
+	"cumulative\x12\x1f\n" +
+	"\vgrowth_rate\x18\x04 \x01(\x01R\n" +
+	"growthRate\"\xfb\x02\n" +
+	"\x13VideoTrendAnalytics\x12\x19\n" +
+	"\baweme_id\x18\x01 \x01(\tR\aawemeId\x12\x16\n" +
+	"\x06metric\x18\x02 \x01(\tR\x06metric\x12+\n" +
+	"\x06points\x18\x03 \x03(\v2\x13.v1.VideoTrendPointR\x06points\x12$\n" +
+	"\x0epeak_date_code\x18\x04 \x01(\x05R\fpeakDateCode\x12\x1d\n" +
+	"\n" +
+	"peak_value\x18\x05 \x01(\x01R\tpeakValue\x12\x1f\n" +
+	"\vtotal_value\x18\x06 \x01(\x01R\n" +
+	"totalValue\x12%\n" +
+	"\x0ecumulative_gmv\x18\a \x01(\x01R\rcumulativeGmv\x12\x1d\n" +
+	"\n" +
+	"decay_rate\x18\b \x01(\x01R\tdecayRate\x12&\n" +
+	"\x0fdays_since_peak\x18\t \x01(\x05R\rdaysSincePeak\x120\n" +
+	"\tlifecycle\x18\n" +
+	" \x01(\x0e2\x12.v1.VideoLifecycleR\tlifecycle\"T\n" +
+	"\x1bVideoTrendAnalyticsResponse\x125\n" +
+	"\tanalytics\x18\x01 \x01(\v2\x17.v1.VideoTrendAnalyticsR\tanalytics\"\xce\x01\n" +
+	"\x19ListTrendingVideosRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06metric\x18\x03 \x01(\tR\x06metric\x12\x17\n" +
+	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x120\n" +
+	"\tlifecycle\x18\x05 \x01(\x0e2\x12.v1.VideoLifecycleR\tlifecycle\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\xe6\x01\n" +
+	"\rTrendingVideo\x12\x19\n" +
+	"\baweme_id\x18\x01 \x01(\tR\aawemeId\x12#\n" +
+	"\rcurrent_value\x18\x02 \x01(\x01R\fcurrentValue\x12%\n" +
+	"\x0eprevious_value\x18\x03 \x01(\x01R\rpreviousValue\x12\x16\n" +
+	"\x06growth\x18\x04 \x01(\x01R\x06growth\x12\x1f\n" +
+	"\vgrowth_rate\x18\x05 \x01(\x01R\n" +
+	"growthRate\x125\n" +
+	"\tanalytics\x18\x06 \x01(\v2\x17.v1.VideoTrendAnalyticsR\tanalytics\"\x81\x01\n" +
+	"\x1aListTrendingVideosResponse\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12)\n" +
+	"\x06videos\x18\x03 \x03(\v2\x11.v1.TrendingVideoR\x06videos*\x9e\x01\n" +
+	"\x0eVideoLifecycle\x12\x1b\n" +
+	"\x17VIDEO_LIFECYCLE_UNKNOWN\x10\x00\x12\x1a\n" +
+	"\x16VIDEO_LIFECYCLE_RISING\x10\x01\x12\x1b\n" +
+	"\x17VIDEO_LIFECYCLE_PEAKING\x10\x02\x12\x1c\n" +
+	"\x18VIDEO_LIFECYCLE_DECAYING\x10\x03\x12\x18\n" +
+	"\x14VIDEO_LIFECYCLE_DEAD\x10\x042\xff\x02\n" +
 	"\x11VideoTrendService\x12l\n" +
-	"\x0fListVideoTrends\x12\x1a.v1.ListVideoTrendsRequest\x1a\x1b.v1.ListVideoTrendsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/video_trends/listB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
+	"\x0fListVideoTrends\x12\x1a.v1.ListVideoTrendsRequest\x1a\x1b.v1.ListVideoTrendsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/video_trends/list\x12\x80\x01\n" +
+	"\x16GetVideoTrendAnalytics\x12\x1e.v1.VideoTrendAnalyticsRequest\x1a\x1f.v1.VideoTrendAnalyticsResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/video_trends/analytics\x12y\n" +
+	"\x12ListTrendingVideos\x12\x1d.v1.ListTrendingVideosRequest\x1a\x1e.v1.ListTrendingVideosResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/video_trends/trendingB\x14Z\x12aresdata/api/v1;v1b\x06proto3"
 
 var (
 	file_v1_video_trend_proto_rawDescOnce sync.Once
@@ -575,25 +1251,44 @@ func file_v1_video_trend_proto_rawDescGZIP() []byte {
 	return file_v1_video_trend_proto_rawDescData
 }
 
-var file_v1_video_trend_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_v1_video_trend_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_video_trend_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_v1_video_trend_proto_goTypes = []any{
-	(*VideoTrendDTO)(nil),           // 0: v1.VideoTrendDTO
-	(*ListVideoTrendsRequest)(nil),  // 1: v1.ListVideoTrendsRequest
-	(*ListVideoTrendsResponse)(nil), // 2: v1.ListVideoTrendsResponse
-	(*PageRequest)(nil),             // 3: PageRequest
-	(*PageResponse)(nil),            // 4: PageResponse
+	(VideoLifecycle)(0),                 // 0: v1.VideoLifecycle
+	(*VideoTrendDTO)(nil),               // 1: v1.VideoTrendDTO
+	(*ListVideoTrendsRequest)(nil),      // 2: v1.ListVideoTrendsRequest
+	(*ListVideoTrendsResponse)(nil),     // 3: v1.ListVideoTrendsResponse
+	(*VideoTrendAnalyticsRequest)(nil),  // 4: v1.VideoTrendAnalyticsRequest
+	(*VideoTrendPoint)(nil),             // 5: v1.VideoTrendPoint
+	(*VideoTrendAnalytics)(nil),         // 6: v1.VideoTrendAnalytics
+	(*VideoTrendAnalyticsResponse)(nil), // 7: v1.VideoTrendAnalyticsResponse
+	(*ListTrendingVideosRequest)(nil),   // 8: v1.ListTrendingVideosRequest
+	(*TrendingVideo)(nil),               // 9: v1.TrendingVideo
+	(*ListTrendingVideosResponse)(nil),  // 10: v1.ListTrendingVideosResponse
+	(*PageRequest)(nil),                 // 11: PageRequest
+	(*PageResponse)(nil),                // 12: PageResponse
 }
 var file_v1_video_trend_proto_depIdxs = []int32{
-	3, // 0: v1.ListVideoTrendsRequest.page:type_name -> PageRequest
-	4, // 1: v1.ListVideoTrendsResponse.page:type_name -> PageResponse
-	0, // 2: v1.ListVideoTrendsResponse.trends:type_name -> v1.VideoTrendDTO
-	1, // 3: v1.VideoTrendService.ListVideoTrends:input_type -> v1.ListVideoTrendsRequest
-	2, // 4: v1.VideoTrendService.ListVideoTrends:output_type -> v1.ListVideoTrendsResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: v1.ListVideoTrendsRequest.page:type_name -> PageRequest
+	12, // 1: v1.ListVideoTrendsResponse.page:type_name -> PageResponse
+	1,  // 2: v1.ListVideoTrendsResponse.trends:type_name -> v1.VideoTrendDTO
+	5,  // 3: v1.VideoTrendAnalytics.points:type_name -> v1.VideoTrendPoint
+	0,  // 4: v1.VideoTrendAnalytics.lifecycle:type_name -> v1.VideoLifecycle
+	6,  // 5: v1.VideoTrendAnalyticsResponse.analytics:type_name -> v1.VideoTrendAnalytics
+	0,  // 6: v1.ListTrendingVideosRequest.lifecycle:type_name -> v1.VideoLifecycle
+	6,  // 7: v1.TrendingVideo.analytics:type_name -> v1.VideoTrendAnalytics
+	9,  // 8: v1.ListTrendingVideosResponse.videos:type_name -> v1.TrendingVideo
+	2,  // 9: v1.VideoTrendService.ListVideoTrends:input_type -> v1.ListVideoTrendsRequest
+	4,  // 10: v1.VideoTrendService.GetVideoTrendAnalytics:input_type -> v1.VideoTrendAnalyticsRequest
+	8,  // 11: v1.VideoTrendService.ListTrendingVideos:input_type -> v1.ListTrendingVideosRequest
+	3,  // 12: v1.VideoTrendService.ListVideoTrends:output_type -> v1.ListVideoTrendsResponse
+	7,  // 13: v1.VideoTrendService.GetVideoTrendAnalytics:output_type -> v1.VideoTrendAnalyticsResponse
+	10, // 14: v1.VideoTrendService.ListTrendingVideos:output_type -> v1.ListTrendingVideosResponse
+	12, // [12:15] is the sub-list for method output_type
+	9,  // [9:12] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_v1_video_trend_proto_init() }
@@ -607,13 +1302,14 @@ func file_v1_video_trend_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_video_trend_proto_rawDesc), len(file_v1_video_trend_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1_video_trend_proto_goTypes,
 		DependencyIndexes: file_v1_video_trend_proto_depIdxs,
+		EnumInfos:         file_v1_video_trend_proto_enumTypes,
 		MessageInfos:      file_v1_video_trend_proto_msgTypes,
 	}.Build()
 	File_v1_video_trend_proto = out.File
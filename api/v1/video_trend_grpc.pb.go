@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VideoTrendService_ListVideoTrends_FullMethodName = "/v1.VideoTrendService/ListVideoTrends"
+	VideoTrendService_ListVideoTrends_FullMethodName        = "/v1.VideoTrendService/ListVideoTrends"
+	VideoTrendService_GetVideoTrendAnalytics_FullMethodName = "/v1.VideoTrendService/GetVideoTrendAnalytics"
+	VideoTrendService_ListTrendingVideos_FullMethodName     = "/v1.VideoTrendService/ListTrendingVideos"
 )
 
 // VideoTrendServiceClient is the client API for VideoTrendService service.
@@ -30,6 +32,10 @@ const (
 type VideoTrendServiceClient interface {
 	// 分页查询视频趋势信息
 	ListVideoTrends(ctx context.Context, in *ListVideoTrendsRequest, opts ...grpc.CallOption) (*ListVideoTrendsResponse, error)
+	// 查询单个视频的趋势分析：每日增长、峰值、累计 GMV、峰值后衰减和生命周期阶段
+	GetVideoTrendAnalytics(ctx context.Context, in *VideoTrendAnalyticsRequest, opts ...grpc.CallOption) (*VideoTrendAnalyticsResponse, error)
+	// 查询一段时间内增长最快的视频
+	ListTrendingVideos(ctx context.Context, in *ListTrendingVideosRequest, opts ...grpc.CallOption) (*ListTrendingVideosResponse, error)
 }
 
 type videoTrendServiceClient struct {
@@ -50,6 +56,26 @@ func (c *videoTrendServiceClient) ListVideoTrends(ctx context.Context, in *ListV
 	return out, nil
 }
 
+func (c *videoTrendServiceClient) GetVideoTrendAnalytics(ctx context.Context, in *VideoTrendAnalyticsRequest, opts ...grpc.CallOption) (*VideoTrendAnalyticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoTrendAnalyticsResponse)
+	err := c.cc.Invoke(ctx, VideoTrendService_GetVideoTrendAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoTrendServiceClient) ListTrendingVideos(ctx context.Context, in *ListTrendingVideosRequest, opts ...grpc.CallOption) (*ListTrendingVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrendingVideosResponse)
+	err := c.cc.Invoke(ctx, VideoTrendService_ListTrendingVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoTrendServiceServer is the server API for VideoTrendService service.
 // All implementations must embed UnimplementedVideoTrendServiceServer
 // for forward compatibility.
@@ -58,6 +84,10 @@ func (c *videoTrendServiceClient) ListVideoTrends(ctx context.Context, in *ListV
 type VideoTrendServiceServer interface {
 	// 分页查询视频趋势信息
 	ListVideoTrends(context.Context, *ListVideoTrendsRequest) (*ListVideoTrendsResponse, error)
+	// 查询单个视频的趋势分析：每日增长、峰值、累计 GMV、峰值后衰减和生命周期阶段
+	GetVideoTrendAnalytics(context.Context, *VideoTrendAnalyticsRequest) (*VideoTrendAnalyticsResponse, error)
+	// 查询一段时间内增长最快的视频
+	ListTrendingVideos(context.Context, *ListTrendingVideosRequest) (*ListTrendingVideosResponse, error)
 	mustEmbedUnimplementedVideoTrendServiceServer()
 }
 
@@ -71,6 +101,12 @@ type UnimplementedVideoTrendServiceServer struct{}
 func (UnimplementedVideoTrendServiceServer) ListVideoTrends(context.Context, *ListVideoTrendsRequest) (*ListVideoTrendsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVideoTrends not implemented")
 }
+func (UnimplementedVideoTrendServiceServer) GetVideoTrendAnalytics(context.Context, *VideoTrendAnalyticsRequest) (*VideoTrendAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideoTrendAnalytics not implemented")
+}
+func (UnimplementedVideoTrendServiceServer) ListTrendingVideos(context.Context, *ListTrendingVideosRequest) (*ListTrendingVideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingVideos not implemented")
+}
 func (UnimplementedVideoTrendServiceServer) mustEmbedUnimplementedVideoTrendServiceServer() {}
 func (UnimplementedVideoTrendServiceServer) testEmbeddedByValue()                           {}
 
@@ -110,6 +146,42 @@ func _VideoTrendService_ListVideoTrends_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoTrendService_GetVideoTrendAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoTrendAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoTrendServiceServer).GetVideoTrendAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoTrendService_GetVideoTrendAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoTrendServiceServer).GetVideoTrendAnalytics(ctx, req.(*VideoTrendAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoTrendService_ListTrendingVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoTrendServiceServer).ListTrendingVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoTrendService_ListTrendingVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoTrendServiceServer).ListTrendingVideos(ctx, req.(*ListTrendingVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VideoTrendService_ServiceDesc is the grpc.ServiceDesc for VideoTrendService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +193,14 @@ var VideoTrendService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListVideoTrends",
 			Handler:    _VideoTrendService_ListVideoTrends_Handler,
 		},
+		{
+			MethodName: "GetVideoTrendAnalytics",
+			Handler:    _VideoTrendService_GetVideoTrendAnalytics_Handler,
+		},
+		{
+			MethodName: "ListTrendingVideos",
+			Handler:    _VideoTrendService_ListTrendingVideos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/video_trend.proto",
@@ -19,9 +19,15 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationVideoTrendServiceGetVideoTrendAnalytics = "/v1.VideoTrendService/GetVideoTrendAnalytics"
+const OperationVideoTrendServiceListTrendingVideos = "/v1.VideoTrendService/ListTrendingVideos"
 const OperationVideoTrendServiceListVideoTrends = "/v1.VideoTrendService/ListVideoTrends"
 
 type VideoTrendServiceHTTPServer interface {
+	// GetVideoTrendAnalytics 查询单个视频的趋势分析：每日增长、峰值、累计 GMV、峰值后衰减和生命周期阶段
+	GetVideoTrendAnalytics(context.Context, *VideoTrendAnalyticsRequest) (*VideoTrendAnalyticsResponse, error)
+	// ListTrendingVideos 查询一段时间内增长最快的视频
+	ListTrendingVideos(context.Context, *ListTrendingVideosRequest) (*ListTrendingVideosResponse, error)
 	// ListVideoTrends 分页查询视频趋势信息
 	ListVideoTrends(context.Context, *ListVideoTrendsRequest) (*ListVideoTrendsResponse, error)
 }
@@ -29,6 +35,8 @@ type VideoTrendServiceHTTPServer interface {
 func RegisterVideoTrendServiceHTTPServer(s *http.Server, srv VideoTrendServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/video_trends/list", _VideoTrendService_ListVideoTrends0_HTTP_Handler(srv))
+	r.POST("/v1/video_trends/analytics", _VideoTrendService_GetVideoTrendAnalytics0_HTTP_Handler(srv))
+	r.POST("/v1/video_trends/trending", _VideoTrendService_ListTrendingVideos0_HTTP_Handler(srv))
 }
 
 func _VideoTrendService_ListVideoTrends0_HTTP_Handler(srv VideoTrendServiceHTTPServer) func(ctx http.Context) error {
@@ -53,7 +61,53 @@ func _VideoTrendService_ListVideoTrends0_HTTP_Handler(srv VideoTrendServiceHTTPS
 	}
 }
 
+func _VideoTrendService_GetVideoTrendAnalytics0_HTTP_Handler(srv VideoTrendServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VideoTrendAnalyticsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoTrendServiceGetVideoTrendAnalytics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetVideoTrendAnalytics(ctx, req.(*VideoTrendAnalyticsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoTrendAnalyticsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoTrendService_ListTrendingVideos0_HTTP_Handler(srv VideoTrendServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTrendingVideosRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoTrendServiceListTrendingVideos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTrendingVideos(ctx, req.(*ListTrendingVideosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTrendingVideosResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type VideoTrendServiceHTTPClient interface {
+	GetVideoTrendAnalytics(ctx context.Context, req *VideoTrendAnalyticsRequest, opts ...http.CallOption) (rsp *VideoTrendAnalyticsResponse, err error)
+	ListTrendingVideos(ctx context.Context, req *ListTrendingVideosRequest, opts ...http.CallOption) (rsp *ListTrendingVideosResponse, err error)
 	ListVideoTrends(ctx context.Context, req *ListVideoTrendsRequest, opts ...http.CallOption) (rsp *ListVideoTrendsResponse, err error)
 }
 
@@ -65,6 +119,32 @@ func NewVideoTrendServiceHTTPClient(client *http.Client) VideoTrendServiceHTTPCl
 	return &VideoTrendServiceHTTPClientImpl{client}
 }
 
+func (c *VideoTrendServiceHTTPClientImpl) GetVideoTrendAnalytics(ctx context.Context, in *VideoTrendAnalyticsRequest, opts ...http.CallOption) (*VideoTrendAnalyticsResponse, error) {
+	var out VideoTrendAnalyticsResponse
+	pattern := "/v1/video_trends/analytics"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoTrendServiceGetVideoTrendAnalytics))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoTrendServiceHTTPClientImpl) ListTrendingVideos(ctx context.Context, in *ListTrendingVideosRequest, opts ...http.CallOption) (*ListTrendingVideosResponse, error) {
+	var out ListTrendingVideosResponse
+	pattern := "/v1/video_trends/trending"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoTrendServiceListTrendingVideos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoTrendServiceHTTPClientImpl) ListVideoTrends(ctx context.Context, in *ListVideoTrendsRequest, opts ...http.CallOption) (*ListVideoTrendsResponse, error) {
 	var out ListVideoTrendsResponse
 	pattern := "/v1/video_trends/list"
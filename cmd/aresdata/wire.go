@@ -9,6 +9,7 @@ import (
 	"github.com/Jayleonc/aresdata/internal/biz"
 	"github.com/Jayleonc/aresdata/internal/conf"
 	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/internal/etl"
 	"github.com/Jayleonc/aresdata/internal/server"
 	"github.com/Jayleonc/aresdata/internal/service"
 
@@ -19,5 +20,5 @@ import (
 
 // wireApp init kratos application.
 func wireApp(*conf.Server, *conf.Data, log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
+	panic(wire.Build(server.ProviderSet, data.ProviderSet, etl.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/Jayleonc/aresdata/internal/biz"
 	"github.com/Jayleonc/aresdata/internal/conf"
 	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/internal/etl"
 	"github.com/Jayleonc/aresdata/internal/server"
 	"github.com/Jayleonc/aresdata/internal/service"
 	"github.com/go-kratos/kratos/v2"
@@ -45,10 +46,48 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	videoTrendRepo := data.NewVideoTrendRepo(dataData)
 	videoTrendUsecase := biz.NewVideoTrendUsecase(videoTrendRepo)
 	videoTrendServiceService := service.NewVideoTrendServiceService(videoTrendUsecase)
-	grpcServer := server.NewGRPCServer(confServer, videoRankService, videoServiceService, productServiceService, bloggerServiceService, videoTrendServiceService, logger)
-	httpServer := server.NewHTTPServer(confServer, videoRankService, videoServiceService, productServiceService, bloggerServiceService, videoTrendServiceService, logger)
+	rollupRepo := data.NewRollupRepo(dataData)
+	categoryRepo := data.NewCategoryRepo(dataData)
+	brandRepo := data.NewBrandRepo(dataData)
+	shopRepo := data.NewShopRepo(dataData)
+	videoRankProcessor := etl.NewVideoRankProcessor(videoRankRepo, sourceDataRepo, videoRepo, productRepo, bloggerRepo, rollupRepo, categoryRepo, brandRepo, shopRepo, logger)
+	videoDetailProcessor := etl.NewVideoDetailProcessor(logger, sourceDataRepo, videoRepo, bloggerRepo, videoTrendRepo, rollupRepo)
+	videoCommentRepo := data.NewVideoCommentRepo(dataData)
+	videoCommentProcessor := etl.NewVideoCommentProcessor(logger, sourceDataRepo, videoCommentRepo)
+	audienceRepo := data.NewAudienceRepo(dataData)
+	audienceProcessor := etl.NewAudienceProcessor(logger, sourceDataRepo, audienceRepo)
+	etlUsecase := etl.NewETLUsecase(logger, sourceDataRepo, videoRankProcessor, videoDetailProcessor, videoCommentProcessor, audienceProcessor)
+	sourceDataUsecase := biz.NewSourceDataUsecase(sourceDataRepo, etlUsecase, logger)
+	sourceDataServiceService := service.NewSourceDataServiceService(sourceDataUsecase)
+	searchRepo := data.NewSearchRepo(dataData)
+	searchUsecase := biz.NewSearchUsecase(searchRepo)
+	searchServiceService := service.NewSearchServiceService(searchUsecase)
+	statsUsecase := biz.NewStatsUsecase(rollupRepo)
+	statsServiceService := service.NewStatsServiceService(statsUsecase)
+	categoryUsecase := biz.NewCategoryUsecase(categoryRepo)
+	categoryServiceService := service.NewCategoryServiceService(categoryUsecase)
+	brandUsecase := biz.NewBrandUsecase(brandRepo)
+	brandServiceService := service.NewBrandServiceService(brandUsecase)
+	shopUsecase := biz.NewShopUsecase(shopRepo)
+	shopServiceService := service.NewShopServiceService(shopUsecase)
+	exportJobRepo := data.NewExportJobRepo(dataData)
+	exportUsecase, cleanup2 := biz.NewExportUsecase(confData, videoRankRepo, videoRepo, videoTrendRepo, exportJobRepo, logger)
+	exportServiceService := service.NewExportServiceService(exportUsecase)
+	alertRepo := data.NewAlertRepo(dataData)
+	alertUsecase := biz.NewAlertUsecase(alertRepo)
+	alertServiceService := service.NewAlertServiceService(alertUsecase)
+	watchlistRepo := data.NewWatchlistRepo(dataData)
+	watchlistUsecase := biz.NewWatchlistUsecase(watchlistRepo)
+	watchlistServiceService := service.NewWatchlistServiceService(watchlistUsecase)
+	videoCommentUsecase := biz.NewVideoCommentUsecase(videoCommentRepo)
+	videoCommentServiceService := service.NewVideoCommentServiceService(videoCommentUsecase)
+	audienceUsecase := biz.NewAudienceUsecase(audienceRepo)
+	audienceServiceService := service.NewAudienceServiceService(audienceUsecase)
+	grpcServer := server.NewGRPCServer(confServer, videoRankService, videoServiceService, productServiceService, bloggerServiceService, videoTrendServiceService, sourceDataServiceService, searchServiceService, statsServiceService, categoryServiceService, brandServiceService, shopServiceService, exportServiceService, alertServiceService, watchlistServiceService, videoCommentServiceService, audienceServiceService, logger)
+	httpServer := server.NewHTTPServer(confServer, videoRankService, videoServiceService, productServiceService, bloggerServiceService, videoTrendServiceService, sourceDataServiceService, searchServiceService, statsServiceService, categoryServiceService, brandServiceService, shopServiceService, exportServiceService, alertServiceService, watchlistServiceService, videoCommentServiceService, audienceServiceService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -63,7 +63,7 @@ func main() {
 	if taskName != "" {
 		log.NewHelper(logger).Infof("Running single task manually: %s", taskName)
 		if t, ok := app.tasks[taskName]; ok {
-			if err := t.Run(context.Background()); err != nil {
+			if err := t.Run(context.Background(), flag.Args()...); err != nil {
 				log.NewHelper(logger).Errorf("Task %s failed: %v", taskName, err)
 			}
 		} else {
@@ -77,13 +77,24 @@ func main() {
 			if err := fetchTask.Run(context.Background()); err == nil {
 				log.NewHelper(logger).Info("Fetch task succeeded, triggering ETL task: etl:video_rank")
 				etlTask := app.tasks[task.ProcessVideoRank]
-				if err_etl := etlTask.Run(context.Background()); err_etl != nil {
+				if err_etl := etlTask.Run(context.Background(), "period=day"); err_etl != nil {
 					log.NewHelper(logger).Errorf("ETL task %s failed: %v", etlTask.Name(), err_etl)
+				} else {
+					runReports(app, logger, "day")
+					runAlerts(app, logger)
 				}
+				runDailyRollups(app, logger)
 			} else {
 				log.NewHelper(logger).Errorf("Fetch task %s failed: %v", fetchTask.Name(), err)
 			}
 		})
+		// 每周一2点半采集上一周的周榜，每月2号2点半采集上个月的月榜
+		app.cron.AddFunc("0 30 2 * * 1", func() {
+			runVideoRankPipeline(app, logger, "week")
+		})
+		app.cron.AddFunc("0 30 2 2 * *", func() {
+			runVideoRankPipeline(app, logger, "month")
+		})
 		app.cron.AddFunc("0 0 3 * * *", func() {
 			log.NewHelper(logger).Info("Cron triggered for task: fetch:video_trend")
 			fetchTask := app.tasks[task.FetchVideoTrend]
@@ -112,7 +123,74 @@ func main() {
 				log.NewHelper(logger).Errorf("Fetch task %s failed: %v", fetchTask.Name(), err)
 			}
 		})
+		// 每天5点采集和处理视频评论
+		app.cron.AddFunc("0 0 5 * * *", func() {
+			log.NewHelper(logger).Info("Cron triggered for task: fetch:video_comments")
+			fetchTask := app.tasks[task.FetchVideoComments]
+			if err := fetchTask.Run(context.Background()); err != nil {
+				// 部分视频采集失败时已采集的数据仍然需要处理
+				log.NewHelper(logger).Errorf("Fetch task %s failed: %v", fetchTask.Name(), err)
+			}
+			etlTask := app.tasks[task.ProcessVideoComments]
+			if err := etlTask.Run(context.Background()); err != nil {
+				log.NewHelper(logger).Errorf("ETL task %s failed: %v", etlTask.Name(), err)
+			}
+		})
+		// 每天5点半采集和处理视频观众画像、博主粉丝画像
+		app.cron.AddFunc("0 30 5 * * *", func() {
+			log.NewHelper(logger).Info("Cron triggered for task: fetch:audience_profiles")
+			fetchTask := app.tasks[task.FetchAudienceProfiles]
+			if err := fetchTask.Run(context.Background()); err != nil {
+				log.NewHelper(logger).Errorf("Fetch task %s failed: %v", fetchTask.Name(), err)
+			}
+			etlTask := app.tasks[task.ProcessAudienceProfiles]
+			if err := etlTask.Run(context.Background()); err != nil {
+				log.NewHelper(logger).Errorf("ETL task %s failed: %v", etlTask.Name(), err)
+			}
+		})
 		app.cron.Start()
 		select {}
 	}
 }
+
+// runVideoRankPipeline 采集指定周期的榜单，成功后处理该周期的原始数据
+func runVideoRankPipeline(app *App, logger log.Logger, period string) {
+	log.NewHelper(logger).Infof("Cron triggered for task: fetch:video_rank (period=%s)", period)
+	fetchTask := app.tasks[task.FetchVideoRank]
+	if err := fetchTask.Run(context.Background(), "period="+period); err != nil {
+		log.NewHelper(logger).Errorf("Fetch task %s failed: %v", fetchTask.Name(), err)
+		return
+	}
+	etlTask := app.tasks[task.ProcessVideoRank]
+	if err := etlTask.Run(context.Background(), "period="+period); err != nil {
+		log.NewHelper(logger).Errorf("ETL task %s failed: %v", etlTask.Name(), err)
+	} else {
+		runReports(app, logger, period)
+		runAlerts(app, logger)
+	}
+	runDailyRollups(app, logger)
+}
+
+// runReports 在榜单处理成功后生成并推送该周期的报告
+func runReports(app *App, logger log.Logger, period string) {
+	reportTask := app.tasks[task.SendReports]
+	if err := reportTask.Run(context.Background(), "period="+period); err != nil {
+		log.NewHelper(logger).Errorf("Report task %s failed: %v", reportTask.Name(), err)
+	}
+}
+
+// runDailyRollups 在 ETL 之后增量重建受影响日期的汇总
+func runDailyRollups(app *App, logger log.Logger) {
+	rollupTask := app.tasks[task.BuildDailyRollups]
+	if err := rollupTask.Run(context.Background()); err != nil {
+		log.NewHelper(logger).Errorf("Rollup task %s failed: %v", rollupTask.Name(), err)
+	}
+}
+
+// runAlerts 在 ETL 成功后评估告警规则
+func runAlerts(app *App, logger log.Logger) {
+	alertTask := app.tasks[task.EvaluateAlerts]
+	if err := alertTask.Run(context.Background()); err != nil {
+		log.NewHelper(logger).Errorf("Alert task %s failed: %v", alertTask.Name(), err)
+	}
+}
@@ -4,10 +4,12 @@
 package main
 
 import (
+	"github.com/Jayleonc/aresdata/internal/alert"
 	"github.com/Jayleonc/aresdata/internal/conf"
 	"github.com/Jayleonc/aresdata/internal/data"
 	"github.com/Jayleonc/aresdata/internal/etl"
 	"github.com/Jayleonc/aresdata/internal/fetcher"
+	"github.com/Jayleonc/aresdata/internal/report"
 	"github.com/Jayleonc/aresdata/internal/task"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -19,6 +21,8 @@ func wireApp(*conf.Bootstrap, *conf.Data, log.Logger) (*App, func(), error) {
 		data.ProviderSet,
 		fetcher.ProviderSet,
 		etl.ProviderSet,
+		report.ProviderSet,
+		alert.ProviderSet,
 		task.ProviderSet,
 		newApp,
 	))
@@ -1,16 +1,18 @@
 // Code generated by Wire. DO NOT EDIT.
 
-//go:generate go run github.com/google/wire/cmd/wire
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
 //go:build !wireinject
 // +build !wireinject
 
 package main
 
 import (
+	"github.com/Jayleonc/aresdata/internal/alert"
 	"github.com/Jayleonc/aresdata/internal/conf"
 	"github.com/Jayleonc/aresdata/internal/data"
 	"github.com/Jayleonc/aresdata/internal/etl"
 	"github.com/Jayleonc/aresdata/internal/fetcher"
+	"github.com/Jayleonc/aresdata/internal/report"
 	"github.com/Jayleonc/aresdata/internal/task"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -25,29 +27,62 @@ func wireApp(bootstrap *conf.Bootstrap, confData *conf.Data, logger log.Logger)
 		return nil, nil, err
 	}
 	sourceDataRepo := data.NewSourceDataRepo(dataData, logger)
-	v := fetcher.provideDataSources(confData)
+	v := fetcher.ProvideDataSources(confData)
 	fetcherManager, err := fetcher.NewFetcherManager(v, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
 	httpUsecase := fetcher.NewHttpUsecase(sourceDataRepo, fetcherManager, logger)
-	fetchVideoRankTask := task.NewFetchVideoRankTask(httpUsecase, logger)
+	httpTaskProvider := task.NewHttpTaskProvider(httpUsecase)
+	fetchVideoRankTask := task.NewFetchVideoRankTask(logger, httpTaskProvider)
 	videoRepo := data.NewVideoRepo(dataData)
 	fetchVideoTrendTask := task.NewFetchVideoTrendTask(httpUsecase, videoRepo, logger)
-	headlessUsecase := fetcher.NewHeadlessUsecase(fetcherManager, videoRepo, sourceDataRepo, logger)
-	fetchVideoDetailsHeadlessTask := task.NewFetchVideoDetailsHeadlessTask(logger, fetcherManager, headlessUsecase)
+	watchlistRepo := data.NewWatchlistRepo(dataData)
+	trendRefreshPolicy := fetcher.ProvideTrendRefreshPolicy(bootstrap)
+	headlessUsecase := fetcher.NewHeadlessUsecase(fetcherManager, videoRepo, sourceDataRepo, watchlistRepo, trendRefreshPolicy, logger)
+	headlessTaskProvider := task.NewHeadlessTaskProvider(fetcherManager, headlessUsecase)
+	fetchVideoDetailsHeadlessTask := task.NewFetchVideoDetailsHeadlessTask(logger, headlessTaskProvider)
 	videoRankRepo := data.NewVideoRankRepo(dataData)
 	productRepo := data.NewProductRepo(dataData)
 	bloggerRepo := data.NewBloggerRepo(dataData)
-	videoRankProcessor := etl.NewVideoRankProcessor(videoRankRepo, sourceDataRepo, videoRepo, productRepo, bloggerRepo, logger)
+	rollupRepo := data.NewRollupRepo(dataData)
+	categoryRepo := data.NewCategoryRepo(dataData)
+	brandRepo := data.NewBrandRepo(dataData)
+	shopRepo := data.NewShopRepo(dataData)
+	videoRankProcessor := etl.NewVideoRankProcessor(videoRankRepo, sourceDataRepo, videoRepo, productRepo, bloggerRepo, rollupRepo, categoryRepo, brandRepo, shopRepo, logger)
 	videoTrendRepo := data.NewVideoTrendRepo(dataData)
-	videoDetailProcessor := etl.NewVideoDetailProcessor(logger, sourceDataRepo, videoRepo, bloggerRepo, videoTrendRepo)
-	etlUsecase := etl.NewETLUsecase(logger, sourceDataRepo, videoRankProcessor, videoDetailProcessor)
+	videoDetailProcessor := etl.NewVideoDetailProcessor(logger, sourceDataRepo, videoRepo, bloggerRepo, videoTrendRepo, rollupRepo)
+	videoCommentRepo := data.NewVideoCommentRepo(dataData)
+	videoCommentProcessor := etl.NewVideoCommentProcessor(logger, sourceDataRepo, videoCommentRepo)
+	audienceRepo := data.NewAudienceRepo(dataData)
+	audienceProcessor := etl.NewAudienceProcessor(logger, sourceDataRepo, audienceRepo)
+	etlUsecase := etl.NewETLUsecase(logger, sourceDataRepo, videoRankProcessor, videoDetailProcessor, videoCommentProcessor, audienceProcessor)
 	processVideoRankTask := task.NewProcessVideoRankTask(etlUsecase)
-	processVideoDetailHeadlessTask := task.NewProcessVideoDetailHeadlessTask(etlUsecase, logger)
-	remedyVideoDetailsHeadlessTask := task.NewRemedyVideoDetailsHeadlessTask(logger, videoRepo, fetcherManager, headlessUsecase)
-	v2 := task.NewTaskSet(fetchVideoRankTask, fetchVideoTrendTask, fetchVideoDetailsHeadlessTask, processVideoRankTask, processVideoDetailHeadlessTask, remedyVideoDetailsHeadlessTask)
+	alertRepo := data.NewAlertRepo(dataData)
+	notifiers, err := alert.NewNotifiers(bootstrap, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	engine := alert.NewEngine(alertRepo, notifiers, logger)
+	processVideoDetailHeadlessTask := task.NewProcessVideoDetailHeadlessTask(etlUsecase, engine, logger)
+	remedyVideoDetailsHeadlessTask := task.NewRemedyVideoDetailsHeadlessTask(logger, videoRepo, headlessTaskProvider)
+	reprocessSourceDataTask := task.NewReprocessSourceDataTask(etlUsecase, logger)
+	backfillVideoMetricsTask := task.NewBackfillVideoMetricsTask(logger, videoRepo)
+	buildDailyRollupsTask := task.NewBuildDailyRollupsTask(logger, rollupRepo)
+	generator, err := report.NewGenerator(bootstrap, videoRankRepo, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	sendReportsTask := task.NewSendReportsTask(logger, generator)
+	evaluateAlertsTask := task.NewEvaluateAlertsTask(logger, engine)
+	fetchVideoCommentsTask := task.NewFetchVideoCommentsTask(logger, httpTaskProvider, videoCommentRepo)
+	processVideoCommentsTask := task.NewProcessVideoCommentsTask(etlUsecase, logger)
+	fetchAudienceProfilesTask := task.NewFetchAudienceProfilesTask(logger, httpTaskProvider, audienceRepo)
+	processAudienceProfilesTask := task.NewProcessAudienceProfilesTask(etlUsecase, logger)
+	v2 := task.NewTaskSet(fetchVideoRankTask, fetchVideoTrendTask, fetchVideoDetailsHeadlessTask, processVideoRankTask, processVideoDetailHeadlessTask, remedyVideoDetailsHeadlessTask, reprocessSourceDataTask, backfillVideoMetricsTask, buildDailyRollupsTask, sendReportsTask, evaluateAlertsTask, fetchVideoCommentsTask, processVideoCommentsTask, fetchAudienceProfilesTask, processAudienceProfilesTask)
 	app := newApp(logger, v2)
 	return app, func() {
 		cleanup()
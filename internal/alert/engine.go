@@ -0,0 +1,162 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// ProviderSet is alert providers.
+var ProviderSet = wire.NewSet(NewNotifiers, NewEngine)
+
+// retryBatchSize 是每次评估前最多重试通知的告警数
+const retryBatchSize = 500
+
+// Engine 评估已启用的告警规则，写入告警记录并发送通知
+type Engine struct {
+	repo      data.AlertRepo
+	notifiers Notifiers
+	log       *log.Helper
+}
+
+func NewEngine(repo data.AlertRepo, notifiers Notifiers, logger log.Logger) *Engine {
+	return &Engine{
+		repo:      repo,
+		notifiers: notifiers,
+		log:       log.NewHelper(log.With(logger, "module", "alert.engine")),
+	}
+}
+
+// Run 先重试此前未发送成功的通知，再评估全部已启用的规则，返回新产生的告警数。
+// dateCode 为空时每条规则使用其数据来源的最新日期；单条规则失败不影响其他规则，错误会合并返回。
+func (e *Engine) Run(ctx context.Context, dateCode string) (int, error) {
+	rules, err := e.repo.ListEnabledRules(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var total int
+	var errs []error
+	if err := e.retryUnsent(ctx, rules); err != nil {
+		e.log.Errorf("重试告警通知失败: %v", err)
+		errs = append(errs, err)
+	}
+	for _, rule := range rules {
+		n, err := e.evaluate(ctx, rule, dateCode)
+		total += n
+		if err != nil {
+			e.log.Errorf("评估告警规则 %d(%s) 失败: %v", rule.ID, rule.Name, err)
+			errs = append(errs, fmt.Errorf("规则 %d: %w", rule.ID, err))
+		}
+	}
+	return total, errors.Join(errs...)
+}
+
+func (e *Engine) evaluate(ctx context.Context, rule *data.AlertRule, dateCode string) (int, error) {
+	if dateCode == "" {
+		latest, err := e.repo.LatestDataDate(ctx, rule.Kind)
+		if err != nil {
+			return 0, err
+		}
+		if latest == "" {
+			return 0, nil
+		}
+		dateCode = latest
+	}
+	candidates, err := e.repo.Evaluate(ctx, rule, dateCode)
+	if err != nil {
+		return 0, err
+	}
+
+	var created int
+	for _, c := range candidates {
+		event := &data.AlertEvent{
+			RuleId:       rule.ID,
+			RuleName:     rule.Name,
+			Kind:         rule.Kind,
+			SubjectId:    c.SubjectId,
+			SubjectName:  c.SubjectName,
+			DateCode:     dateCode,
+			Value:        c.Value,
+			Threshold:    rule.Threshold,
+			Message:      alertMessage(rule, c, dateCode),
+			NotifyStatus: data.AlertNotifyStatusPending,
+		}
+		ok, err := e.repo.CreateEvent(ctx, event)
+		if err != nil {
+			return created, err
+		}
+		// 已经告警过的对象不再重复通知
+		if !ok {
+			continue
+		}
+		created++
+		e.notify(ctx, rule, event)
+	}
+	return created, nil
+}
+
+// retryUnsent 重新发送未发送或发送失败的告警，规则已停用或删除的告警不再发送
+func (e *Engine) retryUnsent(ctx context.Context, rules []*data.AlertRule) error {
+	events, err := e.repo.ListUnsentEvents(ctx, retryBatchSize)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uint]*data.AlertRule, len(rules))
+	for _, rule := range rules {
+		byID[rule.ID] = rule
+	}
+	for _, event := range events {
+		rule, ok := byID[event.RuleId]
+		if !ok {
+			continue
+		}
+		e.notify(ctx, rule, event)
+	}
+	return nil
+}
+
+// notify 把告警发送到规则的全部通知渠道，并记录发送结果
+func (e *Engine) notify(ctx context.Context, rule *data.AlertRule, event *data.AlertEvent) {
+	names := rule.Notifiers
+	if len(names) == 0 {
+		names = []string{DefaultNotifier}
+	}
+	var errs []error
+	for _, name := range names {
+		n, ok := e.notifiers[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: 未定义的通知渠道", name))
+			continue
+		}
+		if err := n.Notify(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	status, notifyError := data.AlertNotifyStatusSent, ""
+	if err := errors.Join(errs...); err != nil {
+		status, notifyError = data.AlertNotifyStatusFailed, err.Error()
+		e.log.Errorf("告警 %d 通知失败: %v", event.ID, err)
+	}
+	if err := e.repo.UpdateEventNotify(ctx, event.ID, status, notifyError); err != nil {
+		e.log.Errorf("更新告警 %d 的通知状态失败: %v", event.ID, err)
+	}
+}
+
+func alertMessage(rule *data.AlertRule, c *data.AlertCandidate, dateCode string) string {
+	threshold := strconv.FormatFloat(rule.Threshold, 'f', -1, 64)
+	switch rule.Kind {
+	case data.AlertRuleKindProductRankVideos:
+		return fmt.Sprintf("商品「%s」(%s) 在 %s 日榜中关联了 %.0f 个视频，阈值 %s", c.SubjectName, c.SubjectId, dateCode, c.Value, threshold)
+	case data.AlertRuleKindBloggerFansGrowth:
+		return fmt.Sprintf("达人「%s」(%s) %s 粉丝数较前一天增长 %.2f%%，阈值 %s%%", c.SubjectName, c.SubjectId, dateCode, c.Value, threshold)
+	case data.AlertRuleKindVideoIncSalesGmv:
+		return fmt.Sprintf("视频「%s」(%s) %s 新增销售额 %.2f，阈值 %s", c.SubjectName, c.SubjectId, dateCode, c.Value, threshold)
+	default:
+		return fmt.Sprintf("%s (%s) %s 的值为 %v，阈值 %s", c.SubjectName, c.SubjectId, dateCode, c.Value, threshold)
+	}
+}
@@ -0,0 +1,200 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeAlertRepo 在内存中模拟 AlertRepo 中引擎用到的方法，按 uk_alert_event 的键去重
+type fakeAlertRepo struct {
+	data.AlertRepo
+
+	rules      []*data.AlertRule
+	latest     map[string]string
+	candidates map[uint][]*data.AlertCandidate
+	events     []*data.AlertEvent
+	unsent     []*data.AlertEvent
+	notified   map[uint]string
+}
+
+func (r *fakeAlertRepo) ListEnabledRules(context.Context) ([]*data.AlertRule, error) {
+	return r.rules, nil
+}
+
+func (r *fakeAlertRepo) LatestDataDate(_ context.Context, kind string) (string, error) {
+	return r.latest[kind], nil
+}
+
+func (r *fakeAlertRepo) Evaluate(_ context.Context, rule *data.AlertRule, _ string) ([]*data.AlertCandidate, error) {
+	return r.candidates[rule.ID], nil
+}
+
+func (r *fakeAlertRepo) CreateEvent(_ context.Context, event *data.AlertEvent) (bool, error) {
+	for _, e := range r.events {
+		if e.RuleId == event.RuleId && e.SubjectId == event.SubjectId && e.DateCode == event.DateCode {
+			return false, nil
+		}
+	}
+	event.ID = uint(len(r.events) + 1)
+	r.events = append(r.events, event)
+	return true, nil
+}
+
+func (r *fakeAlertRepo) UpdateEventNotify(_ context.Context, id uint, status, _ string) error {
+	if r.notified == nil {
+		r.notified = make(map[uint]string)
+	}
+	r.notified[id] = status
+	return nil
+}
+
+func (r *fakeAlertRepo) ListUnsentEvents(_ context.Context, limit int) ([]*data.AlertEvent, error) {
+	if len(r.unsent) > limit {
+		return r.unsent[:limit], nil
+	}
+	return r.unsent, nil
+}
+
+// fakeNotifier 记录收到的告警 ID，err 不为空时发送失败
+type fakeNotifier struct {
+	err  error
+	sent []uint
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, event *data.AlertEvent) error {
+	n.sent = append(n.sent, event.ID)
+	return n.err
+}
+
+func TestEngineRun(t *testing.T) {
+	rule := &data.AlertRule{ID: 1, Name: "视频销售额", Kind: data.AlertRuleKindVideoIncSalesGmv, Threshold: 100, Notifiers: []string{"hook"}}
+	candidates := map[uint][]*data.AlertCandidate{
+		1: {{SubjectId: "v1", Value: 200}, {SubjectId: "v2", Value: 300}},
+	}
+
+	tests := []struct {
+		name         string
+		repo         *fakeAlertRepo
+		notifyErr    error
+		dateCode     string
+		wantCreated  int
+		wantSent     []uint
+		wantNotified map[uint]string
+	}{
+		{
+			name:         "new candidates notified",
+			repo:         &fakeAlertRepo{rules: []*data.AlertRule{rule}, candidates: candidates},
+			dateCode:     "20250710",
+			wantCreated:  2,
+			wantSent:     []uint{1, 2},
+			wantNotified: map[uint]string{1: data.AlertNotifyStatusSent, 2: data.AlertNotifyStatusSent},
+		},
+		{
+			name: "already alerted subjects skipped",
+			repo: &fakeAlertRepo{
+				rules:      []*data.AlertRule{rule},
+				candidates: candidates,
+				events:     []*data.AlertEvent{{ID: 1, RuleId: 1, SubjectId: "v1", DateCode: "20250710"}},
+			},
+			dateCode:     "20250710",
+			wantCreated:  1,
+			wantSent:     []uint{2},
+			wantNotified: map[uint]string{2: data.AlertNotifyStatusSent},
+		},
+		{
+			name:         "empty date uses latest data date",
+			repo:         &fakeAlertRepo{rules: []*data.AlertRule{rule}, candidates: candidates, latest: map[string]string{rule.Kind: "20250709"}},
+			wantCreated:  2,
+			wantSent:     []uint{1, 2},
+			wantNotified: map[uint]string{1: data.AlertNotifyStatusSent, 2: data.AlertNotifyStatusSent},
+		},
+		{
+			name:        "no data yet",
+			repo:        &fakeAlertRepo{rules: []*data.AlertRule{rule}, candidates: candidates},
+			wantCreated: 0,
+		},
+		{
+			name:         "failed notifications recorded",
+			repo:         &fakeAlertRepo{rules: []*data.AlertRule{rule}, candidates: candidates},
+			notifyErr:    errors.New("timeout"),
+			dateCode:     "20250710",
+			wantCreated:  2,
+			wantSent:     []uint{1, 2},
+			wantNotified: map[uint]string{1: data.AlertNotifyStatusFailed, 2: data.AlertNotifyStatusFailed},
+		},
+		{
+			name: "unsent events retried before evaluating",
+			repo: &fakeAlertRepo{
+				rules:      []*data.AlertRule{rule},
+				candidates: candidates,
+				events:     []*data.AlertEvent{{ID: 1, RuleId: 1, SubjectId: "v1", DateCode: "20250710"}},
+				unsent:     []*data.AlertEvent{{ID: 1, RuleId: 1, SubjectId: "v1", DateCode: "20250710"}},
+			},
+			dateCode:     "20250710",
+			wantCreated:  1,
+			wantSent:     []uint{1, 2},
+			wantNotified: map[uint]string{1: data.AlertNotifyStatusSent, 2: data.AlertNotifyStatusSent},
+		},
+		{
+			name: "unsent events of disabled rules not retried",
+			repo: &fakeAlertRepo{
+				rules:  []*data.AlertRule{rule},
+				unsent: []*data.AlertEvent{{ID: 7, RuleId: 2, SubjectId: "v1", DateCode: "20250710"}},
+			},
+			dateCode:    "20250710",
+			wantCreated: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := &fakeNotifier{err: tt.notifyErr}
+			e := NewEngine(tt.repo, Notifiers{"hook": hook}, log.DefaultLogger)
+			created, err := e.Run(context.Background(), tt.dateCode)
+			if err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if created != tt.wantCreated {
+				t.Errorf("Run() = %d, want %d", created, tt.wantCreated)
+			}
+			if !reflect.DeepEqual(hook.sent, tt.wantSent) {
+				t.Errorf("sent = %v, want %v", hook.sent, tt.wantSent)
+			}
+			if !reflect.DeepEqual(tt.repo.notified, tt.wantNotified) {
+				t.Errorf("notified = %v, want %v", tt.repo.notified, tt.wantNotified)
+			}
+		})
+	}
+}
+
+func TestEngineNotifyChannels(t *testing.T) {
+	tests := []struct {
+		name       string
+		notifiers  []string
+		wantStatus string
+		wantSent   int
+	}{
+		{name: "default channel", notifiers: nil, wantStatus: data.AlertNotifyStatusSent, wantSent: 1},
+		{name: "named channel", notifiers: []string{"hook"}, wantStatus: data.AlertNotifyStatusSent, wantSent: 1},
+		{name: "undefined channel fails", notifiers: []string{"hook", "missing"}, wantStatus: data.AlertNotifyStatusFailed, wantSent: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := &fakeNotifier{}
+			notifiers := Notifiers{"hook": hook, DefaultNotifier: hook}
+			repo := &fakeAlertRepo{}
+			e := NewEngine(repo, notifiers, log.DefaultLogger)
+			e.notify(context.Background(), &data.AlertRule{ID: 1, Notifiers: tt.notifiers}, &data.AlertEvent{ID: 3})
+			if got := repo.notified[3]; got != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got, tt.wantStatus)
+			}
+			if len(hook.sent) != tt.wantSent {
+				t.Errorf("sent %d times, want %d", len(hook.sent), tt.wantSent)
+			}
+		})
+	}
+}
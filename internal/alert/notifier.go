@@ -0,0 +1,123 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"github.com/Jayleonc/aresdata/internal/conf"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/pkg/webhook"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 通知渠道类型
+const (
+	NotifierTypeLog     = "log"
+	NotifierTypeWebhook = "webhook"
+	NotifierTypeEmail   = "email"
+)
+
+// DefaultNotifier 是内置的日志渠道名称，规则未指定通知渠道时使用
+const DefaultNotifier = "log"
+
+// Notifier 把一条告警发送到某个渠道
+type Notifier interface {
+	Notify(ctx context.Context, event *data.AlertEvent) error
+}
+
+// Notifiers 是按名称索引的通知渠道
+type Notifiers map[string]Notifier
+
+// NewNotifiers 按配置创建通知渠道，内置的 log 渠道始终可用，可被同名配置覆盖
+func NewNotifiers(bc *conf.Bootstrap, logger log.Logger) (Notifiers, error) {
+	helper := log.NewHelper(log.With(logger, "module", "alert.notifier"))
+	notifiers := Notifiers{DefaultNotifier: &logNotifier{log: helper}}
+	sender := webhook.NewSender(nil)
+	for _, n := range bc.GetAlerting().GetNotifiers() {
+		if n.Name == "" {
+			return nil, errors.New("告警通知渠道必须配置 name")
+		}
+		switch n.Type {
+		case NotifierTypeLog:
+			notifiers[n.Name] = &logNotifier{log: helper}
+		case NotifierTypeWebhook:
+			h := n.GetWebhook()
+			if h.GetUrl() == "" {
+				return nil, fmt.Errorf("告警通知渠道 %s: 必须配置 webhook.url", n.Name)
+			}
+			notifiers[n.Name] = &webhookNotifier{sender: sender, target: webhook.Target{
+				URL:     h.Url,
+				Type:    h.Type,
+				Secret:  h.Secret,
+				Headers: h.Headers,
+				Timeout: h.GetTimeout().AsDuration(),
+			}}
+		case NotifierTypeEmail:
+			e := n.GetEmail()
+			if e.GetFrom() == "" || len(e.GetTo()) == 0 {
+				return nil, fmt.Errorf("告警通知渠道 %s: 必须配置 email.from 和 email.to", n.Name)
+			}
+			notifiers[n.Name] = &emailNotifier{cfg: e, log: helper}
+		default:
+			return nil, fmt.Errorf("告警通知渠道 %s: 不支持的类型 %s", n.Name, n.Type)
+		}
+	}
+	return notifiers, nil
+}
+
+func alertTitle(event *data.AlertEvent) string {
+	return "告警：" + event.RuleName
+}
+
+type logNotifier struct {
+	log *log.Helper
+}
+
+func (n *logNotifier) Notify(_ context.Context, event *data.AlertEvent) error {
+	n.log.Warnf("[%s] %s", event.RuleName, event.Message)
+	return nil
+}
+
+type webhookNotifier struct {
+	sender *webhook.Sender
+	target webhook.Target
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, event *data.AlertEvent) error {
+	return n.sender.Send(ctx, n.target, webhook.Message{
+		Title:   alertTitle(event),
+		Format:  webhook.FormatMarkdown,
+		Content: fmt.Sprintf("### %s\n\n%s\n", alertTitle(event), event.Message),
+	})
+}
+
+// emailNotifier 通过 SMTP 发送纯文本邮件；未配置 smtp_addr 时只把邮件内容写入日志，便于本地调试
+type emailNotifier struct {
+	cfg *conf.Alerting_Email
+	log *log.Helper
+}
+
+func (n *emailNotifier) Notify(_ context.Context, event *data.AlertEvent) error {
+	msg := strings.Join([]string{
+		"From: " + n.cfg.From,
+		"To: " + strings.Join(n.cfg.To, ", "),
+		"Subject: " + alertTitle(event),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		event.Message,
+	}, "\r\n")
+	if n.cfg.SmtpAddr == "" {
+		n.log.Infof("未配置 SMTP 服务器，跳过发送邮件:\n%s", msg)
+		return nil
+	}
+	var auth smtp.Auth
+	if n.cfg.Username != "" {
+		host, _, _ := strings.Cut(n.cfg.SmtpAddr, ":")
+		auth = smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, host)
+	}
+	return smtp.SendMail(n.cfg.SmtpAddr, auth, n.cfg.From, n.cfg.To, []byte(msg))
+}
@@ -0,0 +1,181 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+// AlertUsecase 管理告警规则并查询告警记录，规则的评估由 worker 在 ETL 完成后执行
+type AlertUsecase struct {
+	repo data.AlertRepo
+}
+
+// NewAlertUsecase 构造 AlertUsecase
+func NewAlertUsecase(repo data.AlertRepo) *AlertUsecase {
+	return &AlertUsecase{repo: repo}
+}
+
+var alertRuleKinds = map[v1.AlertRuleKind]string{
+	v1.AlertRuleKind_ALERT_RULE_KIND_PRODUCT_RANK_VIDEOS: data.AlertRuleKindProductRankVideos,
+	v1.AlertRuleKind_ALERT_RULE_KIND_BLOGGER_FANS_GROWTH: data.AlertRuleKindBloggerFansGrowth,
+	v1.AlertRuleKind_ALERT_RULE_KIND_VIDEO_INC_SALES_GMV: data.AlertRuleKindVideoIncSalesGmv,
+}
+
+var alertNotifyStatuses = map[string]v1.AlertNotifyStatus{
+	data.AlertNotifyStatusPending: v1.AlertNotifyStatus_ALERT_NOTIFY_STATUS_PENDING,
+	data.AlertNotifyStatusSent:    v1.AlertNotifyStatus_ALERT_NOTIFY_STATUS_SENT,
+	data.AlertNotifyStatusFailed:  v1.AlertNotifyStatus_ALERT_NOTIFY_STATUS_FAILED,
+}
+
+// CreateRule 创建告警规则
+func (uc *AlertUsecase) CreateRule(ctx context.Context, req *v1.AlertRule) (*v1.AlertRule, error) {
+	rule, err := copyAlertRuleToDO(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return copyAlertRuleToDTO(rule), nil
+}
+
+// UpdateRule 按 id 覆盖告警规则
+func (uc *AlertUsecase) UpdateRule(ctx context.Context, req *v1.AlertRule) (*v1.AlertRule, error) {
+	if req.Id == 0 {
+		return nil, errors.New("缺少规则 id")
+	}
+	rule, err := copyAlertRuleToDO(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateRule(ctx, rule); err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return nil, fmt.Errorf("告警规则不存在: %d", req.Id)
+		}
+		return nil, err
+	}
+	updated, err := uc.repo.GetRule(ctx, rule.ID)
+	if err != nil {
+		return nil, err
+	}
+	return copyAlertRuleToDTO(updated), nil
+}
+
+// DeleteRule 删除告警规则
+func (uc *AlertUsecase) DeleteRule(ctx context.Context, id uint64) error {
+	if err := uc.repo.DeleteRule(ctx, uint(id)); err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return fmt.Errorf("告警规则不存在: %d", id)
+		}
+		return err
+	}
+	return nil
+}
+
+// ListRules 分页查询告警规则
+func (uc *AlertUsecase) ListRules(ctx context.Context, page *v1.PageRequest) (*v1.ListAlertRulesResponse, error) {
+	rules, pageResp, err := uc.repo.ListRulesPage(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListAlertRulesResponse{Page: pageResp, Rules: make([]*v1.AlertRule, 0, len(rules))}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, copyAlertRuleToDTO(rule))
+	}
+	return resp, nil
+}
+
+// ListEvents 分页查询告警记录
+func (uc *AlertUsecase) ListEvents(ctx context.Context, page *v1.PageRequest, ruleID uint64) (*v1.ListAlertEventsResponse, error) {
+	events, pageResp, err := uc.repo.ListEventsPage(ctx, page, uint(ruleID))
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListAlertEventsResponse{Page: pageResp, Events: make([]*v1.AlertEvent, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, &v1.AlertEvent{
+			Id:           uint64(e.ID),
+			RuleId:       uint64(e.RuleId),
+			RuleName:     e.RuleName,
+			Kind:         alertRuleKindToDTO(e.Kind),
+			SubjectId:    e.SubjectId,
+			SubjectName:  e.SubjectName,
+			DateCode:     e.DateCode,
+			Value:        e.Value,
+			Threshold:    e.Threshold,
+			Message:      e.Message,
+			NotifyStatus: alertNotifyStatuses[e.NotifyStatus],
+			NotifyError:  e.NotifyError,
+			CreatedAt:    e.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+// copyAlertRuleToDO 校验并转换告警规则，去掉 subject_ids、notifiers 中的空白项
+func copyAlertRuleToDO(req *v1.AlertRule) (*data.AlertRule, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("规则名称不能为空")
+	}
+	kind, ok := alertRuleKinds[req.Kind]
+	if !ok {
+		return nil, fmt.Errorf("不支持的告警规则类型: %v", req.Kind)
+	}
+	if req.Threshold < 0 {
+		return nil, errors.New("阈值不能为负数")
+	}
+	return &data.AlertRule{
+		ID:         uint(req.Id),
+		Name:       name,
+		Kind:       kind,
+		Threshold:  req.Threshold,
+		SubjectIds: compactStrings(req.SubjectIds),
+		Notifiers:  compactStrings(req.Notifiers),
+		Enabled:    req.Enabled,
+	}, nil
+}
+
+func copyAlertRuleToDTO(rule *data.AlertRule) *v1.AlertRule {
+	return &v1.AlertRule{
+		Id:         uint64(rule.ID),
+		Name:       rule.Name,
+		Kind:       alertRuleKindToDTO(rule.Kind),
+		Threshold:  rule.Threshold,
+		SubjectIds: rule.SubjectIds,
+		Notifiers:  rule.Notifiers,
+		Enabled:    rule.Enabled,
+		CreatedAt:  rule.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  rule.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func alertRuleKindToDTO(kind string) v1.AlertRuleKind {
+	for k, v := range alertRuleKinds {
+		if v == kind {
+			return k
+		}
+	}
+	return v1.AlertRuleKind_ALERT_RULE_KIND_UNSPECIFIED
+}
+
+// compactStrings 去掉首尾空白、空串和重复项，保持原有顺序
+func compactStrings(values []string) []string {
+	result := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+	return result
+}
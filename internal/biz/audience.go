@@ -0,0 +1,78 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+// audienceDimensions 按返回顺序列出画像维度
+var audienceDimensions = []struct {
+	dto  v1.AudienceDimension
+	name string
+}{
+	{v1.AudienceDimension_AUDIENCE_DIMENSION_GENDER, data.AudienceDimensionGender},
+	{v1.AudienceDimension_AUDIENCE_DIMENSION_AGE, data.AudienceDimensionAge},
+	{v1.AudienceDimension_AUDIENCE_DIMENSION_PROVINCE, data.AudienceDimensionProvince},
+	{v1.AudienceDimension_AUDIENCE_DIMENSION_CITY, data.AudienceDimensionCity},
+}
+
+// AudienceUsecase 查询视频观众画像和博主粉丝画像，数据由 worker 采集
+type AudienceUsecase struct {
+	repo data.AudienceRepo
+}
+
+// NewAudienceUsecase 构造 AudienceUsecase
+func NewAudienceUsecase(repo data.AudienceRepo) *AudienceUsecase {
+	return &AudienceUsecase{repo: repo}
+}
+
+// GetVideoAudience 查询视频的观众画像
+func (uc *AudienceUsecase) GetVideoAudience(ctx context.Context, awemeId string) (*v1.AudienceProfile, error) {
+	if awemeId == "" {
+		return nil, errors.New("aweme_id 不能为空")
+	}
+	profile, err := uc.getProfile(ctx, data.AudienceSubjectVideo, awemeId)
+	if errors.Is(err, data.ErrNotFound) {
+		return nil, fmt.Errorf("视频观众画像不存在: %s", awemeId)
+	}
+	return profile, err
+}
+
+// GetBloggerAudience 查询博主的粉丝画像
+func (uc *AudienceUsecase) GetBloggerAudience(ctx context.Context, bloggerId int64) (*v1.AudienceProfile, error) {
+	if bloggerId == 0 {
+		return nil, errors.New("blogger_id 不能为空")
+	}
+	profile, err := uc.getProfile(ctx, data.AudienceSubjectBlogger, strconv.FormatInt(bloggerId, 10))
+	if errors.Is(err, data.ErrNotFound) {
+		return nil, fmt.Errorf("博主粉丝画像不存在: %d", bloggerId)
+	}
+	return profile, err
+}
+
+func (uc *AudienceUsecase) getProfile(ctx context.Context, subjectType, subjectId string) (*v1.AudienceProfile, error) {
+	profile, shares, err := uc.repo.GetProfile(ctx, subjectType, subjectId)
+	if err != nil {
+		return nil, err
+	}
+	buckets := make(map[string][]*v1.AudienceBucket)
+	for _, s := range shares {
+		buckets[s.Dimension] = append(buckets[s.Dimension], &v1.AudienceBucket{Bucket: s.Bucket, Share: s.Share})
+	}
+	dto := &v1.AudienceProfile{
+		SubjectId: profile.SubjectId,
+		UpdatedAt: profile.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, d := range audienceDimensions {
+		if len(buckets[d.name]) > 0 {
+			dto.Distributions = append(dto.Distributions, &v1.AudienceDistribution{Dimension: d.dto, Buckets: buckets[d.name]})
+		}
+	}
+	return dto, nil
+}
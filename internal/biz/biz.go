@@ -11,4 +11,15 @@ var ProviderSet = wire.NewSet(
 	NewProductUsecase,
 	NewBloggerUsecase,
 	NewVideoTrendUsecase, // 新增此行
+	NewSourceDataUsecase,
+	NewSearchUsecase,
+	NewStatsUsecase,
+	NewCategoryUsecase,
+	NewBrandUsecase,
+	NewShopUsecase,
+	NewExportUsecase,
+	NewAlertUsecase,
+	NewWatchlistUsecase,
+	NewVideoCommentUsecase,
+	NewAudienceUsecase,
 )
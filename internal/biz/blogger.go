@@ -26,14 +26,14 @@ func NewBloggerUsecase(repo data.BloggerRepo) *BloggerUsecase {
 }
 
 // ListBloggers 分页查询视频博主
-func (uc *BloggerUsecase) ListBloggers(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*v1.BloggerDTO, int64, error) {
-	bloggers, total, err := uc.repo.ListPage(ctx, page, size, query, sortBy, sortOrder)
+func (uc *BloggerUsecase) ListBloggers(ctx context.Context, page *v1.PageRequest, query string, filter *v1.BloggerFilter, sortBy string, sortOrder v1.SortOrder) ([]*v1.BloggerDTO, *v1.PageResponse, error) {
+	bloggers, pageResp, err := uc.repo.ListPage(ctx, page, query, filter, sortBy, sortOrder)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 	dtos := make([]*v1.BloggerDTO, len(bloggers))
 	for i, b := range bloggers {
 		dtos[i] = data.CopyBloggerToDTO(b)
 	}
-	return dtos, total, nil
+	return dtos, pageResp, nil
 }
@@ -0,0 +1,123 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	defaultProfileVideoLimit = 20
+	maxProfileVideoLimit     = 100
+
+	fansSourceTrend = "trend"
+	fansSourceRank  = "rank"
+)
+
+// GetBloggerProfile 汇总博主画像：维度信息、最近作品、带货商品、累计销量、粉丝变化和上榜情况
+func (uc *BloggerUsecase) GetBloggerProfile(ctx context.Context, bloggerID int64, rankType, startDate, endDate string, videoLimit int) (*v1.BloggerProfileResponse, error) {
+	if bloggerID == 0 {
+		return nil, fmt.Errorf("blogger_id 不能为空")
+	}
+	if rankType == "" {
+		rankType = data.RankPeriodDay
+	}
+	if !data.IsValidRankPeriod(rankType) {
+		return nil, fmt.Errorf("不支持的榜单周期: %s", rankType)
+	}
+	if videoLimit <= 0 {
+		videoLimit = defaultProfileVideoLimit
+	}
+	if videoLimit > maxProfileVideoLimit {
+		videoLimit = maxProfileVideoLimit
+	}
+
+	resp := &v1.BloggerProfileResponse{RankType: rankType}
+	blogger, err := uc.repo.Get(ctx, bloggerID)
+	if err != nil && !errors.Is(err, data.ErrNotFound) {
+		return nil, err
+	}
+	resp.Blogger = data.CopyBloggerToDTO(blogger)
+
+	videos, total, err := uc.repo.ListVideos(ctx, bloggerID, videoLimit)
+	if err != nil {
+		return nil, fmt.Errorf("查询博主作品失败: %w", err)
+	}
+	resp.VideoCount = total
+	resp.Videos = make([]*v1.VideoDTO, 0, len(videos))
+	for _, v := range videos {
+		resp.Videos = append(resp.Videos, data.CopyVideoToDTO(v))
+	}
+
+	products, err := uc.repo.ListPromotedProducts(ctx, bloggerID, rankType, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("查询博主带货商品失败: %w", err)
+	}
+	resp.Products = make([]*v1.BloggerProduct, 0, len(products))
+	for _, p := range products {
+		resp.Products = append(resp.Products, &v1.BloggerProduct{
+			GoodsId:         p.GoodsId,
+			GoodsTitle:      p.GoodsTitle,
+			GoodsCoverUrl:   p.GoodsCoverUrl,
+			BrandName:       p.BrandName,
+			VideoCount:      p.VideoCount,
+			RankAppearances: p.RankAppearances,
+			SalesCountLow:   p.SalesCountLow,
+			SalesCountHigh:  p.SalesCountHigh,
+			TotalSalesLow:   p.TotalSalesLow,
+			TotalSalesHigh:  p.TotalSalesHigh,
+		})
+	}
+
+	stats, err := uc.repo.GetRankStats(ctx, bloggerID, rankType, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("汇总博主上榜数据失败: %w", err)
+	}
+	resp.SalesCountLow = stats.SalesCountLow
+	resp.SalesCountHigh = stats.SalesCountHigh
+	resp.TotalSalesLow = stats.TotalSalesLow
+	resp.TotalSalesHigh = stats.TotalSalesHigh
+	resp.RankStats = &v1.BloggerRankStats{
+		Appearances:   stats.Appearances,
+		VideoCount:    stats.VideoCount,
+		RankDates:     stats.RankDates,
+		BestPosition:  int32(stats.BestPosition),
+		FirstSeenDate: stats.FirstSeenDate,
+		LastSeenDate:  stats.LastSeenDate,
+	}
+
+	trendFans, err := uc.repo.ListFansFromTrends(ctx, bloggerID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("查询博主粉丝趋势失败: %w", err)
+	}
+	rankFans, err := uc.repo.ListFansFromRanks(ctx, bloggerID, rankType, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("查询博主榜单粉丝快照失败: %w", err)
+	}
+	resp.FansHistory = mergeFansHistory(trendFans, rankFans)
+	return resp, nil
+}
+
+// mergeFansHistory 合并两种来源的粉丝数观测点，同一天两者都有时以视频趋势为准，结果按日期升序
+func mergeFansHistory(trend, rank []*data.BloggerFansPoint) []*v1.BloggerFansPoint {
+	byDate := make(map[string]*v1.BloggerFansPoint, len(trend)+len(rank))
+	for _, p := range rank {
+		byDate[p.Date] = &v1.BloggerFansPoint{Date: p.Date, Fans: p.Fans, Source: fansSourceRank}
+	}
+	for _, p := range trend {
+		byDate[p.Date] = &v1.BloggerFansPoint{Date: p.Date, Fans: p.Fans, Source: fansSourceTrend}
+	}
+
+	points := make([]*v1.BloggerFansPoint, 0, len(byDate))
+	for _, p := range byDate {
+		points = append(points, p)
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Date < points[j].Date
+	})
+	return points
+}
@@ -0,0 +1,58 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+)
+
+// GetBloggerFansHistory 查询博主的粉丝快照，并计算相邻快照之间的增量和增长率
+func (uc *BloggerUsecase) GetBloggerFansHistory(ctx context.Context, bloggerID int64, startDate, endDate string) (*v1.BloggerFansHistoryResponse, error) {
+	if bloggerID == 0 {
+		return nil, fmt.Errorf("blogger_id 不能为空")
+	}
+	snapshots, err := uc.repo.ListSnapshots(ctx, bloggerID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.BloggerFansHistoryResponse{
+		BloggerId: bloggerID,
+		Points:    make([]*v1.BloggerFansSnapshot, 0, len(snapshots)),
+	}
+	for i, s := range snapshots {
+		point := &v1.BloggerFansSnapshot{
+			Date:       s.SnapshotDate,
+			Fans:       s.FansNum,
+			BloggerTag: s.BloggerTag,
+		}
+		if i > 0 {
+			prev := snapshots[i-1]
+			point.Delta = s.FansNum - prev.FansNum
+			point.DaysSincePrevious = int32(daysBetween(prev.SnapshotDate, s.SnapshotDate))
+			if prev.FansNum != 0 {
+				point.GrowthRate = float64(point.Delta) / float64(prev.FansNum)
+			}
+		}
+		resp.Points = append(resp.Points, point)
+	}
+	if len(snapshots) > 1 {
+		resp.TotalDelta = snapshots[len(snapshots)-1].FansNum - snapshots[0].FansNum
+	}
+	return resp, nil
+}
+
+// daysBetween 计算两个 "20060102" 格式日期之间相差的天数，无法解析时返回 0
+func daysBetween(from, to string) int {
+	f, err := time.Parse("20060102", from)
+	if err != nil {
+		return 0
+	}
+	t, err := time.Parse("20060102", to)
+	if err != nil {
+		return 0
+	}
+	return int(t.Sub(f).Hours() / 24)
+}
@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"context"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+// BrandUsecase 封装品牌维度的查询和分析
+type BrandUsecase struct {
+	dim *dimensionUsecase
+}
+
+// NewBrandUsecase 构造 BrandUsecase
+func NewBrandUsecase(repo data.BrandRepo) *BrandUsecase {
+	get := func(ctx context.Context, id uint) (*data.NamedDimension, error) {
+		brand, err := repo.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &brand.NamedDimension, nil
+	}
+	return &BrandUsecase{dim: &dimensionUsecase{name: "品牌", repo: repo, get: get}}
+}
+
+// ListBrands 分页查询品牌
+func (uc *BrandUsecase) ListBrands(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) (*v1.ListDimensionsResponse, error) {
+	return uc.dim.list(ctx, page, query, sortBy, sortOrder)
+}
+
+// GetBrand 查询单个品牌及其名称变体
+func (uc *BrandUsecase) GetBrand(ctx context.Context, id uint64) (*v1.GetDimensionResponse, error) {
+	item, err := uc.dim.detail(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetDimensionResponse{Item: item}, nil
+}
+
+// GetBrandAnalytics 汇总品牌下全部商品的带货表现
+func (uc *BrandUsecase) GetBrandAnalytics(ctx context.Context, id uint64, rankType, startDate, endDate string, limit int) (*v1.DimensionAnalyticsResponse, error) {
+	return uc.dim.analytics(ctx, id, rankType, startDate, endDate, limit)
+}
@@ -0,0 +1,49 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+// CategoryUsecase 封装类目树查询
+type CategoryUsecase struct {
+	repo data.CategoryRepo
+}
+
+// NewCategoryUsecase 构造 CategoryUsecase
+func NewCategoryUsecase(repo data.CategoryRepo) *CategoryUsecase {
+	return &CategoryUsecase{repo: repo}
+}
+
+// ListCategories 查询某个父类目下的类目，parentID 为 0 时从一级类目开始
+func (uc *CategoryUsecase) ListCategories(ctx context.Context, parentID uint64, recursive bool) (*v1.ListCategoriesResponse, error) {
+	if parentID != 0 {
+		if _, err := uc.repo.Get(ctx, uint(parentID)); err != nil {
+			if errors.Is(err, data.ErrNotFound) {
+				return nil, fmt.Errorf("类目不存在: %d", parentID)
+			}
+			return nil, err
+		}
+	}
+	categories, err := uc.repo.List(ctx, uint(parentID), recursive)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListCategoriesResponse{Categories: make([]*v1.CategoryDTO, 0, len(categories))}
+	for _, c := range categories {
+		resp.Categories = append(resp.Categories, &v1.CategoryDTO{
+			Id:           uint64(c.ID),
+			Name:         c.Name,
+			ParentId:     uint64(c.ParentId),
+			Level:        int32(c.Level),
+			Path:         c.Path,
+			ChildCount:   c.ChildCount,
+			ProductCount: c.ProductCount,
+		})
+	}
+	return resp, nil
+}
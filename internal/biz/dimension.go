@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	defaultDimensionTopLimit = 20
+	maxDimensionTopLimit     = 100
+)
+
+// dimensionRepo 是 BrandRepo 和 ShopRepo 共有的查询方法
+type dimensionRepo interface {
+	ListPage(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) ([]*data.DimensionWithCount, *v1.PageResponse, error)
+	ListNameVariants(ctx context.Context, id uint) ([]string, error)
+	CountProducts(ctx context.Context, id uint) (int64, error)
+	GetSalesSummary(ctx context.Context, id uint, periodType, startDate, endDate string) (*data.DimensionSalesSummary, error)
+	ListDailySales(ctx context.Context, id uint, periodType, startDate, endDate string) ([]*data.DimensionDailySales, error)
+	ListTopProducts(ctx context.Context, id uint, periodType, startDate, endDate string, limit int) ([]*data.DimensionTopProduct, error)
+}
+
+// dimensionUsecase 实现品牌、店铺共用的查询和分析逻辑，name 用于错误信息
+type dimensionUsecase struct {
+	name string
+	repo dimensionRepo
+	get  func(ctx context.Context, id uint) (*data.NamedDimension, error)
+}
+
+func (uc *dimensionUsecase) list(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) (*v1.ListDimensionsResponse, error) {
+	rows, pageResp, err := uc.repo.ListPage(ctx, page, query, sortBy, sortOrder)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.NamedDimensionDTO, 0, len(rows))
+	for _, row := range rows {
+		item := copyNamedDimensionToDTO(&row.NamedDimension)
+		item.ProductCount = row.ProductCount
+		items = append(items, item)
+	}
+	return &v1.ListDimensionsResponse{Page: pageResp, Items: items}, nil
+}
+
+// detail 查询单个实体及其名称变体
+func (uc *dimensionUsecase) detail(ctx context.Context, id uint64) (*v1.NamedDimensionDTO, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("%s id 不能为空", uc.name)
+	}
+	row, err := uc.get(ctx, uint(id))
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return nil, fmt.Errorf("%s不存在: %d", uc.name, id)
+		}
+		return nil, err
+	}
+	item := copyNamedDimensionToDTO(row)
+	if item.NameVariants, err = uc.repo.ListNameVariants(ctx, row.ID); err != nil {
+		return nil, fmt.Errorf("查询%s名称变体失败: %w", uc.name, err)
+	}
+	if item.ProductCount, err = uc.repo.CountProducts(ctx, row.ID); err != nil {
+		return nil, fmt.Errorf("统计%s商品数失败: %w", uc.name, err)
+	}
+	return item, nil
+}
+
+func (uc *dimensionUsecase) analytics(ctx context.Context, id uint64, rankType, startDate, endDate string, limit int) (*v1.DimensionAnalyticsResponse, error) {
+	if rankType == "" {
+		rankType = data.RankPeriodDay
+	}
+	if !data.IsValidRankPeriod(rankType) {
+		return nil, fmt.Errorf("不支持的榜单周期: %s", rankType)
+	}
+	if limit <= 0 {
+		limit = defaultDimensionTopLimit
+	}
+	if limit > maxDimensionTopLimit {
+		limit = maxDimensionTopLimit
+	}
+	item, err := uc.detail(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := uc.repo.GetSalesSummary(ctx, uint(id), rankType, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("汇总%s榜单数据失败: %w", uc.name, err)
+	}
+	daily, err := uc.repo.ListDailySales(ctx, uint(id), rankType, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("查询%s每期销量失败: %w", uc.name, err)
+	}
+	top, err := uc.repo.ListTopProducts(ctx, uint(id), rankType, startDate, endDate, limit)
+	if err != nil {
+		return nil, fmt.Errorf("查询%s商品排行失败: %w", uc.name, err)
+	}
+
+	resp := &v1.DimensionAnalyticsResponse{
+		Item:     item,
+		RankType: rankType,
+		Summary: &v1.DimensionSalesSummary{
+			ProductCount:   summary.ProductCount,
+			VideoCount:     summary.VideoCount,
+			BloggerCount:   summary.BloggerCount,
+			RankEntries:    summary.RankEntries,
+			SalesCountLow:  summary.SalesCountLow,
+			SalesCountHigh: summary.SalesCountHigh,
+			TotalSalesLow:  summary.TotalSalesLow,
+			TotalSalesHigh: summary.TotalSalesHigh,
+		},
+		Daily:       make([]*v1.DimensionDailySales, 0, len(daily)),
+		TopProducts: make([]*v1.DimensionTopProduct, 0, len(top)),
+	}
+	for _, d := range daily {
+		resp.Daily = append(resp.Daily, &v1.DimensionDailySales{
+			RankDate:       d.RankDate,
+			VideoCount:     d.VideoCount,
+			GoodsCount:     d.GoodsCount,
+			SalesCountLow:  d.SalesCountLow,
+			SalesCountHigh: d.SalesCountHigh,
+			TotalSalesLow:  d.TotalSalesLow,
+			TotalSalesHigh: d.TotalSalesHigh,
+		})
+	}
+	for _, p := range top {
+		resp.TopProducts = append(resp.TopProducts, &v1.DimensionTopProduct{
+			GoodsId:        p.GoodsId,
+			GoodsTitle:     p.GoodsTitle,
+			GoodsCoverUrl:  p.GoodsCoverUrl,
+			VideoCount:     p.VideoCount,
+			SalesCountLow:  p.SalesCountLow,
+			SalesCountHigh: p.SalesCountHigh,
+			TotalSalesLow:  p.TotalSalesLow,
+			TotalSalesHigh: p.TotalSalesHigh,
+		})
+	}
+	return resp, nil
+}
+
+func copyNamedDimensionToDTO(d *data.NamedDimension) *v1.NamedDimensionDTO {
+	return &v1.NamedDimensionDTO{
+		Id:             uint64(d.ID),
+		Name:           d.Name,
+		NormalizedName: d.NormalizedName,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      d.UpdatedAt.Format(time.RFC3339),
+	}
+}
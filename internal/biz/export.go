@@ -0,0 +1,393 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/conf"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultExportDir            = "./exports"
+	defaultExportStreamTimeout  = 30 * time.Minute
+	defaultExportMaxRunningJobs = 2
+)
+
+var (
+	// ErrInvalidExportRequest 表示导出请求的类型、格式、查询条件或排序字段无效
+	ErrInvalidExportRequest = errors.New("无效的导出请求")
+	// ErrExportJobNotFound 表示导出任务不存在
+	ErrExportJobNotFound = errors.New("导出任务不存在")
+	// ErrExportJobNotReady 表示导出任务尚未成功完成，没有可下载的文件
+	ErrExportJobNotReady = errors.New("导出任务尚未完成")
+)
+
+// ExportUsecase 把榜单、视频、趋势的查询结果导出为 CSV、XLSX 或 Parquet，
+// 支持直接写入 HTTP 响应的流式导出和写入本地目录的异步导出任务。
+type ExportUsecase struct {
+	rankRepo  data.VideoRankRepo
+	videoRepo data.VideoRepo
+	trendRepo data.VideoTrendRepo
+	jobRepo   data.ExportJobRepo
+	log       *log.Helper
+
+	dir           string
+	streamTimeout time.Duration
+	// slots 限制同时执行的异步导出任务数
+	slots chan struct{}
+	// jobCtx 在服务关闭时取消，jobs 跟踪正在执行的异步导出任务
+	jobCtx    context.Context
+	cancelJob context.CancelFunc
+	jobs      sync.WaitGroup
+}
+
+// NewExportUsecase 构造 ExportUsecase。
+// 启动时会把上次进程退出时未完成的任务标记为失败，因为导出文件只存在于执行任务的实例本地；
+// 返回的 cleanup 会中断正在执行的异步任务，并等待它们记录失败结果后再返回。
+func NewExportUsecase(c *conf.Data, rankRepo data.VideoRankRepo, videoRepo data.VideoRepo, trendRepo data.VideoTrendRepo, jobRepo data.ExportJobRepo, logger log.Logger) (*ExportUsecase, func()) {
+	uc := &ExportUsecase{
+		rankRepo:      rankRepo,
+		videoRepo:     videoRepo,
+		trendRepo:     trendRepo,
+		jobRepo:       jobRepo,
+		log:           log.NewHelper(log.With(logger, "module", "biz.export")),
+		dir:           defaultExportDir,
+		streamTimeout: defaultExportStreamTimeout,
+	}
+	maxRunning := defaultExportMaxRunningJobs
+	if e := c.GetExport(); e != nil {
+		if e.Dir != "" {
+			uc.dir = e.Dir
+		}
+		if e.StreamTimeout != nil && e.StreamTimeout.AsDuration() > 0 {
+			uc.streamTimeout = e.StreamTimeout.AsDuration()
+		}
+		if e.MaxRunningJobs > 0 {
+			maxRunning = int(e.MaxRunningJobs)
+		}
+	}
+	uc.slots = make(chan struct{}, maxRunning)
+	uc.jobCtx, uc.cancelJob = context.WithCancel(context.Background())
+
+	if n, err := jobRepo.FailUnfinished(context.Background(), "服务重启，任务已中断"); err != nil {
+		uc.log.Errorf("重置未完成的导出任务失败: %v", err)
+	} else if n > 0 {
+		uc.log.Infof("已将 %d 个未完成的导出任务标记为失败", n)
+	}
+	cleanup := func() {
+		uc.cancelJob()
+		uc.jobs.Wait()
+	}
+	return uc, cleanup
+}
+
+// StreamTimeout 是同步流式导出的最长耗时
+func (uc *ExportUsecase) StreamTimeout() time.Duration {
+	return uc.streamTimeout
+}
+
+// Export 把查询结果按请求的格式写入 w，返回导出的行数。
+// 查询条件或排序字段无效时返回 ErrInvalidExportRequest，错误会在写入任何内容之前返回。
+func (uc *ExportUsecase) Export(ctx context.Context, req *v1.ExportRequest, w io.Writer) (int64, error) {
+	if err := validateExportRequest(req); err != nil {
+		return 0, err
+	}
+	var rows int64
+	var err error
+	switch req.Entity {
+	case v1.ExportEntity_EXPORT_ENTITY_VIDEO_RANK:
+		r := req.GetVideoRank()
+		rankType, rankDate := r.GetRankType(), r.GetRankDate()
+		if rankType != "" && rankDate != "" {
+			_, _, rankDate = data.VideoRankPeriodDates(rankType, rankDate)
+		}
+		rows, err = writeExport(req.Format, w, videoRankExportColumns, func(fn func(*data.VideoRank) error) error {
+			return uc.rankRepo.Iterate(ctx, rankType, rankDate, r.GetCategoryId(), r.GetSortBy(), r.GetSortOrder(), fn)
+		})
+	case v1.ExportEntity_EXPORT_ENTITY_VIDEO:
+		r := req.GetVideo()
+		rows, err = writeExport(req.Format, w, videoExportColumns, func(fn func(*data.Video) error) error {
+			return uc.videoRepo.Iterate(ctx, r.GetQuery(), r.GetFilter(), r.GetSortBy(), r.GetSortOrder(), fn)
+		})
+	default:
+		r := req.GetVideoTrend()
+		rows, err = writeExport(req.Format, w, videoTrendExportColumns, func(fn func(*data.VideoTrend) error) error {
+			return uc.trendRepo.Iterate(ctx, r.GetAwemeId(), r.GetStartDate(), r.GetEndDate(), fn)
+		})
+	}
+	if errors.Is(err, data.ErrInvalidSortField) {
+		err = fmt.Errorf("%w: %w", ErrInvalidExportRequest, err)
+	}
+	return rows, err
+}
+
+// CreateJob 创建异步导出任务并在后台执行
+func (uc *ExportUsecase) CreateJob(ctx context.Context, req *v1.ExportRequest) (*v1.ExportJob, error) {
+	if err := validateExportRequest(req); err != nil {
+		return nil, err
+	}
+	raw, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("序列化导出请求失败: %w", err)
+	}
+	job := &data.ExportJob{
+		Entity:  req.Entity.String(),
+		Format:  exportFormatOrDefault(req.Format).String(),
+		Request: string(raw),
+		Status:  data.ExportJobStatusPending,
+	}
+	if err := uc.jobRepo.Create(ctx, job); err != nil {
+		return nil, fmt.Errorf("创建导出任务失败: %w", err)
+	}
+	uc.jobs.Add(1)
+	go func() {
+		defer uc.jobs.Done()
+		uc.runJob(job.ID, req)
+	}()
+	return copyExportJobToDTO(job), nil
+}
+
+// GetJob 查询单个导出任务
+func (uc *ExportUsecase) GetJob(ctx context.Context, id uint64) (*v1.ExportJob, error) {
+	job, err := uc.getJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return copyExportJobToDTO(job), nil
+}
+
+// ListJobs 分页查询导出任务
+func (uc *ExportUsecase) ListJobs(ctx context.Context, page *v1.PageRequest) (*v1.ListExportJobsResponse, error) {
+	jobs, pageResp, err := uc.jobRepo.ListPage(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListExportJobsResponse{Page: pageResp, Jobs: make([]*v1.ExportJob, 0, len(jobs))}
+	for _, job := range jobs {
+		resp.Jobs = append(resp.Jobs, copyExportJobToDTO(job))
+	}
+	return resp, nil
+}
+
+// OpenJobFile 打开已完成任务的导出文件，返回文件、下载文件名和格式
+func (uc *ExportUsecase) OpenJobFile(ctx context.Context, id uint64) (*os.File, string, v1.ExportFormat, error) {
+	job, err := uc.getJob(ctx, id)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if job.Status != data.ExportJobStatusSucceeded {
+		return nil, "", 0, fmt.Errorf("%w: 任务 %d 当前状态: %s", ErrExportJobNotReady, id, job.Status)
+	}
+	f, err := os.Open(filepath.Join(uc.dir, job.FileName))
+	if err != nil {
+		return nil, "", 0, fmt.Errorf("打开导出文件失败: %w", err)
+	}
+	return f, job.FileName, v1.ExportFormat(v1.ExportFormat_value[job.Format]), nil
+}
+
+func (uc *ExportUsecase) getJob(ctx context.Context, id uint64) (*data.ExportJob, error) {
+	job, err := uc.jobRepo.Get(ctx, uint(id))
+	if err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrExportJobNotFound, id)
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+// runJob 在后台执行导出任务：先写入临时文件，成功后再重命名，下载时不会读到不完整的文件。
+// 服务关闭时导出被取消，任务记录为失败；任务状态的更新不受取消影响。
+func (uc *ExportUsecase) runJob(id uint, req *v1.ExportRequest) {
+	ctx := context.Background()
+	select {
+	case uc.slots <- struct{}{}:
+		defer func() { <-uc.slots }()
+	case <-uc.jobCtx.Done():
+		if err := uc.jobRepo.Finish(ctx, id, "", 0, 0, "服务关闭，任务已中断"); err != nil {
+			uc.log.Errorf("记录导出任务 %d 结果失败: %v", id, err)
+		}
+		return
+	}
+
+	if err := uc.jobRepo.MarkRunning(ctx, id); err != nil {
+		uc.log.Errorf("更新导出任务 %d 状态失败: %v", id, err)
+	}
+	fileName := ExportFileName(req, strconv.FormatUint(uint64(id), 10))
+	rows, size, err := uc.exportToFile(uc.jobCtx, req, fileName)
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+		if uc.jobCtx.Err() != nil {
+			errMsg = "服务关闭，任务已中断"
+		}
+		fileName = ""
+		uc.log.Errorf("导出任务 %d 失败: %v", id, err)
+	}
+	if err := uc.jobRepo.Finish(ctx, id, fileName, size, rows, errMsg); err != nil {
+		uc.log.Errorf("记录导出任务 %d 结果失败: %v", id, err)
+	}
+}
+
+func (uc *ExportUsecase) exportToFile(ctx context.Context, req *v1.ExportRequest, fileName string) (rows, size int64, err error) {
+	if err := os.MkdirAll(uc.dir, 0o755); err != nil {
+		return 0, 0, fmt.Errorf("创建导出目录失败: %w", err)
+	}
+	path := filepath.Join(uc.dir, fileName)
+	tmp, err := os.CreateTemp(uc.dir, fileName+".*.part")
+	if err != nil {
+		return 0, 0, fmt.Errorf("创建导出文件失败: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	if rows, err = uc.Export(ctx, req, tmp); err != nil {
+		return 0, 0, err
+	}
+	info, err := tmp.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	if err = tmp.Close(); err != nil {
+		return 0, 0, err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return 0, 0, err
+	}
+	return rows, info.Size(), nil
+}
+
+// writeExport 逐行读取数据并写入文件。写入器在读到第一行（或确认结果为空）时才创建，
+// 保证查询本身出错时 w 中没有任何内容。
+func writeExport[T any](format v1.ExportFormat, w io.Writer, columns []exportColumn[T], iterate func(func(*T) error) error) (int64, error) {
+	names := make([]string, len(columns))
+	kinds := make([]exportKind, len(columns))
+	var zero T
+	for i, c := range columns {
+		names[i] = c.name
+		kinds[i] = exportKindOf(normalizeExportValue(c.value(&zero)))
+	}
+
+	var tw tableWriter
+	var rows int64
+	values := make([]any, len(columns))
+	err := iterate(func(row *T) error {
+		if tw == nil {
+			var err error
+			if tw, err = newTableWriter(format, w, names, kinds); err != nil {
+				return err
+			}
+		}
+		for i, c := range columns {
+			values[i] = normalizeExportValue(c.value(row))
+		}
+		rows++
+		return tw.WriteRow(values)
+	})
+	if err != nil {
+		return rows, err
+	}
+	if tw == nil {
+		if tw, err = newTableWriter(format, w, names, kinds); err != nil {
+			return 0, err
+		}
+	}
+	return rows, tw.Close()
+}
+
+func validateExportRequest(req *v1.ExportRequest) error {
+	if req == nil {
+		return fmt.Errorf("%w: 导出请求不能为空", ErrInvalidExportRequest)
+	}
+	switch req.Entity {
+	case v1.ExportEntity_EXPORT_ENTITY_VIDEO_RANK, v1.ExportEntity_EXPORT_ENTITY_VIDEO, v1.ExportEntity_EXPORT_ENTITY_VIDEO_TREND:
+	default:
+		return fmt.Errorf("%w: 不支持的导出类型: %v", ErrInvalidExportRequest, req.Entity)
+	}
+	if _, ok := exportFormatExt[exportFormatOrDefault(req.Format)]; !ok {
+		return fmt.Errorf("%w: 不支持的导出格式: %v", ErrInvalidExportRequest, req.Format)
+	}
+	return nil
+}
+
+var exportFormatExt = map[v1.ExportFormat]string{
+	v1.ExportFormat_EXPORT_FORMAT_CSV:     "csv",
+	v1.ExportFormat_EXPORT_FORMAT_XLSX:    "xlsx",
+	v1.ExportFormat_EXPORT_FORMAT_PARQUET: "parquet",
+}
+
+var exportContentTypes = map[v1.ExportFormat]string{
+	v1.ExportFormat_EXPORT_FORMAT_CSV:     "text/csv; charset=utf-8",
+	v1.ExportFormat_EXPORT_FORMAT_XLSX:    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	v1.ExportFormat_EXPORT_FORMAT_PARQUET: "application/vnd.apache.parquet",
+}
+
+var exportEntityNames = map[v1.ExportEntity]string{
+	v1.ExportEntity_EXPORT_ENTITY_VIDEO_RANK:  "video_rank",
+	v1.ExportEntity_EXPORT_ENTITY_VIDEO:       "video",
+	v1.ExportEntity_EXPORT_ENTITY_VIDEO_TREND: "video_trend",
+}
+
+func exportFormatOrDefault(format v1.ExportFormat) v1.ExportFormat {
+	if format == v1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return v1.ExportFormat_EXPORT_FORMAT_CSV
+	}
+	return format
+}
+
+// ExportContentType 返回导出格式对应的 Content-Type
+func ExportContentType(format v1.ExportFormat) string {
+	return exportContentTypes[exportFormatOrDefault(format)]
+}
+
+// ExportFileName 生成导出文件名，例如 video_rank-20250716.csv
+func ExportFileName(req *v1.ExportRequest, suffix string) string {
+	return exportEntityNames[req.Entity] + "-" + suffix + "." + exportFormatExt[exportFormatOrDefault(req.Format)]
+}
+
+var exportJobStatuses = map[string]v1.ExportJobStatus{
+	data.ExportJobStatusPending:   v1.ExportJobStatus_EXPORT_JOB_STATUS_PENDING,
+	data.ExportJobStatusRunning:   v1.ExportJobStatus_EXPORT_JOB_STATUS_RUNNING,
+	data.ExportJobStatusSucceeded: v1.ExportJobStatus_EXPORT_JOB_STATUS_SUCCEEDED,
+	data.ExportJobStatusFailed:    v1.ExportJobStatus_EXPORT_JOB_STATUS_FAILED,
+}
+
+func copyExportJobToDTO(job *data.ExportJob) *v1.ExportJob {
+	dto := &v1.ExportJob{
+		Id:        uint64(job.ID),
+		Entity:    v1.ExportEntity(v1.ExportEntity_value[job.Entity]),
+		Format:    v1.ExportFormat(v1.ExportFormat_value[job.Format]),
+		Status:    exportJobStatuses[job.Status],
+		RowCount:  job.RowCount,
+		FileSize:  job.FileSize,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt.Format(time.RFC3339),
+		Request:   &v1.ExportRequest{},
+	}
+	if job.StartedAt != nil {
+		dto.StartedAt = job.StartedAt.Format(time.RFC3339)
+	}
+	if job.FinishedAt != nil {
+		dto.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+	if job.Status == data.ExportJobStatusSucceeded {
+		dto.DownloadUrl = "/v1/exports/download?id=" + strconv.FormatUint(uint64(job.ID), 10)
+	}
+	_ = protojson.Unmarshal([]byte(job.Request), dto.Request)
+	return dto
+}
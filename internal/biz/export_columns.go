@@ -0,0 +1,92 @@
+package biz
+
+import "github.com/Jayleonc/aresdata/internal/data"
+
+// 各导出类型的列定义，列名与 API 中的字段名保持一致。
+// 值类型由列值推断：整数列导出为 int64，浮点列为 double，其余（含时间）为字符串。
+
+var videoRankExportColumns = []exportColumn[data.VideoRank]{
+	{"period_type", func(r *data.VideoRank) any { return r.PeriodType }},
+	{"rank_date", func(r *data.VideoRank) any { return r.RankDate }},
+	{"start_date", func(r *data.VideoRank) any { return r.StartDate }},
+	{"end_date", func(r *data.VideoRank) any { return r.EndDate }},
+	{"rank_position", func(r *data.VideoRank) any { return r.RankPosition }},
+	{"aweme_id", func(r *data.VideoRank) any { return r.AwemeId }},
+	{"aweme_desc", func(r *data.VideoRank) any { return r.AwemeDesc }},
+	{"aweme_pub_time", func(r *data.VideoRank) any { return r.AwemePubTime }},
+	{"aweme_share_url", func(r *data.VideoRank) any { return r.AwemeShareUrl }},
+	{"duration_str", func(r *data.VideoRank) any { return r.DurationStr }},
+	{"goods_id", func(r *data.VideoRank) any { return r.GoodsId }},
+	{"goods_title", func(r *data.VideoRank) any { return r.GoodsTitle }},
+	{"goods_price", func(r *data.VideoRank) any { return r.GoodsPrice }},
+	{"cos_ratio", func(r *data.VideoRank) any { return r.CosRatio }},
+	{"shop_name", func(r *data.VideoRank) any { return r.ShopName }},
+	{"brand_name", func(r *data.VideoRank) any { return r.BrandName }},
+	{"category_names", func(r *data.VideoRank) any { return r.CategoryNames }},
+	{"blogger_id", func(r *data.VideoRank) any { return r.BloggerId }},
+	{"blogger_name", func(r *data.VideoRank) any { return r.BloggerName }},
+	{"blogger_fans_num", func(r *data.VideoRank) any { return r.BloggerFansNum }},
+	{"blogger_tag", func(r *data.VideoRank) any { return r.BloggerTag }},
+	{"sales_count_str", func(r *data.VideoRank) any { return r.SalesCountStr }},
+	{"sales_count_low", func(r *data.VideoRank) any { return r.SalesCountLow }},
+	{"sales_count_high", func(r *data.VideoRank) any { return r.SalesCountHigh }},
+	{"total_sales_str", func(r *data.VideoRank) any { return r.TotalSalesStr }},
+	{"total_sales_low", func(r *data.VideoRank) any { return r.TotalSalesLow }},
+	{"total_sales_high", func(r *data.VideoRank) any { return r.TotalSalesHigh }},
+	{"like_count_inc_str", func(r *data.VideoRank) any { return r.LikeCountIncStr }},
+	{"play_count_inc_str", func(r *data.VideoRank) any { return r.PlayCountIncStr }},
+}
+
+var videoExportColumns = []exportColumn[data.Video]{
+	{"aweme_id", func(v *data.Video) any { return v.AwemeId }},
+	{"aweme_desc", func(v *data.Video) any { return v.AwemeDesc }},
+	{"aweme_pub_time", func(v *data.Video) any { return v.AwemePubTime }},
+	{"aweme_share_url", func(v *data.Video) any { return v.AwemeShareUrl }},
+	{"blogger_id", func(v *data.Video) any { return v.BloggerId }},
+	{"goods_id", func(v *data.Video) any { return v.GoodsId }},
+	{"play_count_low", func(v *data.Video) any { return v.PlayCountLow }},
+	{"play_count_high", func(v *data.Video) any { return v.PlayCountHigh }},
+	{"like_count_low", func(v *data.Video) any { return v.LikeCountLow }},
+	{"like_count_high", func(v *data.Video) any { return v.LikeCountHigh }},
+	{"comment_count_low", func(v *data.Video) any { return v.CommentCountLow }},
+	{"comment_count_high", func(v *data.Video) any { return v.CommentCountHigh }},
+	{"share_count_low", func(v *data.Video) any { return v.ShareCountLow }},
+	{"share_count_high", func(v *data.Video) any { return v.ShareCountHigh }},
+	{"collect_count_low", func(v *data.Video) any { return v.CollectCountLow }},
+	{"collect_count_high", func(v *data.Video) any { return v.CollectCountHigh }},
+	{"interaction_rate_low", func(v *data.Video) any { return v.InteractionRateLow }},
+	{"interaction_rate_high", func(v *data.Video) any { return v.InteractionRateHigh }},
+	{"score_low", func(v *data.Video) any { return v.ScoreLow }},
+	{"score_high", func(v *data.Video) any { return v.ScoreHigh }},
+	{"sales_gmv_low", func(v *data.Video) any { return v.SalesGmvLow }},
+	{"sales_gmv_high", func(v *data.Video) any { return v.SalesGmvHigh }},
+	{"sales_count_low", func(v *data.Video) any { return v.SalesCountLow }},
+	{"sales_count_high", func(v *data.Video) any { return v.SalesCountHigh }},
+	{"goods_count_low", func(v *data.Video) any { return v.GoodsCountLow }},
+	{"goods_count_high", func(v *data.Video) any { return v.GoodsCountHigh }},
+	{"gpm_low", func(v *data.Video) any { return v.GpmLow }},
+	{"gpm_high", func(v *data.Video) any { return v.GpmHigh }},
+	{"created_at", func(v *data.Video) any { return v.CreatedAt }},
+	{"updated_at", func(v *data.Video) any { return v.UpdatedAt }},
+}
+
+var videoTrendExportColumns = []exportColumn[data.VideoTrend]{
+	{"aweme_id", func(t *data.VideoTrend) any { return t.AwemeId }},
+	{"date_code", func(t *data.VideoTrend) any { return t.DateCode }},
+	{"like_count", func(t *data.VideoTrend) any { return t.LikeCount }},
+	{"share_count", func(t *data.VideoTrend) any { return t.ShareCount }},
+	{"comment_count", func(t *data.VideoTrend) any { return t.CommentCount }},
+	{"collect_count", func(t *data.VideoTrend) any { return t.CollectCount }},
+	{"interaction_rate", func(t *data.VideoTrend) any { return t.InteractionRate }},
+	{"inc_like_count", func(t *data.VideoTrend) any { return t.IncLikeCount }},
+	{"inc_share_count", func(t *data.VideoTrend) any { return t.IncShareCount }},
+	{"inc_comment_count", func(t *data.VideoTrend) any { return t.IncCommentCount }},
+	{"inc_collect_count", func(t *data.VideoTrend) any { return t.IncCollectCount }},
+	{"sales_count", func(t *data.VideoTrend) any { return t.SalesCount }},
+	{"sales_gmv", func(t *data.VideoTrend) any { return t.SalesGmv }},
+	{"inc_sales_count", func(t *data.VideoTrend) any { return t.IncSalesCount }},
+	{"inc_sales_gmv", func(t *data.VideoTrend) any { return t.IncSalesGmv }},
+	{"fans", func(t *data.VideoTrend) any { return t.Fans }},
+	{"inc_fans", func(t *data.VideoTrend) any { return t.IncFans }},
+	{"gpm", func(t *data.VideoTrend) any { return t.Gpm }},
+}
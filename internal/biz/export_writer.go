@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/parquet-go/parquet-go"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// xlsxMaxRows 是单个工作表的最大行数（含表头）
+	xlsxMaxRows = 1048576
+	// parquetBatchRows 是 Parquet 每次提交给写入器的行数
+	parquetBatchRows = 1024
+	// parquetRowGroupRows 是 Parquet 每个行组的行数，行组写满后才会输出到底层 writer
+	parquetRowGroupRows = 64 * 1024
+)
+
+// exportColumn 是导出表的一列，value 从一行数据中取出该列的值
+type exportColumn[T any] struct {
+	name  string
+	value func(*T) any
+}
+
+// exportKind 是导出列的值类型，决定 Parquet 中的物理类型
+type exportKind int
+
+const (
+	exportKindString exportKind = iota
+	exportKindInt
+	exportKindFloat
+)
+
+// tableWriter 逐行写入一种文件格式，Close 会输出剩余的缓冲内容
+type tableWriter interface {
+	WriteRow(values []any) error
+	Close() error
+}
+
+// normalizeExportValue 把列值统一为 string、int64、float64 三种类型
+func normalizeExportValue(v any) any {
+	switch x := v.(type) {
+	case int:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case uint:
+		return int64(x)
+	case time.Time:
+		if x.IsZero() {
+			return ""
+		}
+		return x.Format(time.RFC3339)
+	}
+	return v
+}
+
+func exportKindOf(v any) exportKind {
+	switch v.(type) {
+	case int64:
+		return exportKindInt
+	case float64:
+		return exportKindFloat
+	default:
+		return exportKindString
+	}
+}
+
+// newTableWriter 按格式创建写入器并写入表头
+func newTableWriter(format v1.ExportFormat, w io.Writer, names []string, kinds []exportKind) (tableWriter, error) {
+	switch format {
+	case v1.ExportFormat_EXPORT_FORMAT_CSV, v1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
+		return newCSVTableWriter(w, names)
+	case v1.ExportFormat_EXPORT_FORMAT_XLSX:
+		return newXLSXTableWriter(w, names)
+	case v1.ExportFormat_EXPORT_FORMAT_PARQUET:
+		return newParquetTableWriter(w, names, kinds), nil
+	default:
+		return nil, fmt.Errorf("不支持的导出格式: %v", format)
+	}
+}
+
+type csvTableWriter struct {
+	w      *csv.Writer
+	record []string
+}
+
+// newCSVTableWriter 写入 UTF-8 BOM 和表头，BOM 让 Excel 能正确识别中文
+func newCSVTableWriter(w io.Writer, names []string) (*csvTableWriter, error) {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return nil, err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(names); err != nil {
+		return nil, err
+	}
+	return &csvTableWriter{w: cw, record: make([]string, len(names))}, nil
+}
+
+func (c *csvTableWriter) WriteRow(values []any) error {
+	for i, v := range values {
+		switch x := v.(type) {
+		case string:
+			c.record[i] = x
+		case int64:
+			c.record[i] = strconv.FormatInt(x, 10)
+		case float64:
+			c.record[i] = strconv.FormatFloat(x, 'f', -1, 64)
+		default:
+			c.record[i] = fmt.Sprint(x)
+		}
+	}
+	return c.w.Write(c.record)
+}
+
+func (c *csvTableWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// xlsxTableWriter 使用 excelize 的流式写入器，行数据超过内存阈值后会暂存到临时文件，
+// XLSX 是 zip 格式，文件内容在 Close 时才整体输出。
+type xlsxTableWriter struct {
+	out  io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+func newXLSXTableWriter(w io.Writer, names []string) (*xlsxTableWriter, error) {
+	file := excelize.NewFile()
+	sw, err := file.NewStreamWriter("Sheet1")
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	x := &xlsxTableWriter{out: w, file: file, sw: sw}
+	header := make([]any, len(names))
+	for i, name := range names {
+		header[i] = name
+	}
+	if err := x.WriteRow(header); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return x, nil
+}
+
+func (x *xlsxTableWriter) WriteRow(values []any) error {
+	if x.row >= xlsxMaxRows {
+		return fmt.Errorf("XLSX 单个工作表最多 %d 行，请缩小导出范围或改用 CSV/Parquet", xlsxMaxRows-1)
+	}
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.sw.SetRow(cell, values)
+}
+
+func (x *xlsxTableWriter) Close() error {
+	defer x.file.Close()
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
+
+// parquetTableWriter 按列名和值类型动态构建 Parquet schema，所有列均为 required
+type parquetTableWriter struct {
+	w       *parquet.Writer
+	indexes []int // 第 i 个导出列在 Parquet schema 中的列序号
+	batch   []parquet.Row
+}
+
+func newParquetTableWriter(w io.Writer, names []string, kinds []exportKind) *parquetTableWriter {
+	group := make(parquet.Group, len(names))
+	for i, name := range names {
+		switch kinds[i] {
+		case exportKindInt:
+			group[name] = parquet.Int(64)
+		case exportKindFloat:
+			group[name] = parquet.Leaf(parquet.DoubleType)
+		default:
+			group[name] = parquet.String()
+		}
+	}
+	schema := parquet.NewSchema("export", group)
+	indexes := make([]int, len(names))
+	for i, name := range names {
+		leaf, _ := schema.Lookup(name)
+		indexes[i] = leaf.ColumnIndex
+	}
+	return &parquetTableWriter{
+		w:       parquet.NewWriter(w, schema, parquet.Compression(&parquet.Snappy), parquet.MaxRowsPerRowGroup(parquetRowGroupRows)),
+		indexes: indexes,
+		batch:   make([]parquet.Row, 0, parquetBatchRows),
+	}
+}
+
+func (p *parquetTableWriter) WriteRow(values []any) error {
+	// Row 中的值需按 schema 的列序号排列
+	row := make(parquet.Row, len(values))
+	for i, v := range values {
+		var value parquet.Value
+		switch x := v.(type) {
+		case int64:
+			value = parquet.Int64Value(x)
+		case float64:
+			value = parquet.DoubleValue(x)
+		case string:
+			value = parquet.ByteArrayValue([]byte(x))
+		default:
+			value = parquet.ByteArrayValue([]byte(fmt.Sprint(x)))
+		}
+		row[p.indexes[i]] = value.Level(0, 0, p.indexes[i])
+	}
+	p.batch = append(p.batch, row)
+	if len(p.batch) < parquetBatchRows {
+		return nil
+	}
+	return p.flushBatch()
+}
+
+func (p *parquetTableWriter) flushBatch() error {
+	if len(p.batch) == 0 {
+		return nil
+	}
+	_, err := p.w.WriteRows(p.batch)
+	p.batch = p.batch[:0]
+	return err
+}
+
+func (p *parquetTableWriter) Close() error {
+	if err := p.flushBatch(); err != nil {
+		return err
+	}
+	return p.w.Close()
+}
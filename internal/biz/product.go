@@ -26,14 +26,14 @@ func NewProductUsecase(repo data.ProductRepo) *ProductUsecase {
 }
 
 // ListProducts 分页查询商品
-func (uc *ProductUsecase) ListProducts(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*v1.ProductDTO, int64, error) {
-	products, total, err := uc.repo.ListPage(ctx, page, size, query, sortBy, sortOrder)
+func (uc *ProductUsecase) ListProducts(ctx context.Context, page *v1.PageRequest, query string, filter *v1.ProductFilter, sortBy string, sortOrder v1.SortOrder) ([]*v1.ProductDTO, *v1.PageResponse, error) {
+	products, pageResp, err := uc.repo.ListPage(ctx, page, query, filter, sortBy, sortOrder)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 	dtos := make([]*v1.ProductDTO, len(products))
 	for i, p := range products {
 		dtos[i] = data.CopyProductToDTO(p)
 	}
-	return dtos, total, nil
+	return dtos, pageResp, nil
 }
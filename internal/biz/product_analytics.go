@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	defaultTopVideosLimit = 20
+	maxTopVideosLimit     = 100
+)
+
+// GetProductPerformance 汇总商品在榜单中的带货表现。
+// 日榜的每期数据会同时合并带货视频在 video_trends 中的销量增量。
+func (uc *ProductUsecase) GetProductPerformance(ctx context.Context, goodsID, rankType, startDate, endDate string) (*v1.ProductPerformanceResponse, error) {
+	rankType, err := normalizeProductRankArgs(goodsID, rankType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ProductPerformanceResponse{GoodsId: goodsID, RankType: rankType}
+	product, err := uc.repo.Get(ctx, goodsID)
+	if err != nil && !errors.Is(err, data.ErrNotFound) {
+		return nil, err
+	}
+	resp.Product = data.CopyProductToDTO(product)
+
+	summary, err := uc.repo.GetRankSummary(ctx, goodsID, rankType, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("汇总商品榜单数据失败: %w", err)
+	}
+	resp.VideoCount = summary.VideoCount
+	resp.BloggerCount = summary.BloggerCount
+	resp.RankAppearances = summary.RankAppearances
+	resp.FirstSeenDate = summary.FirstSeenDate
+	resp.LastSeenDate = summary.LastSeenDate
+	resp.SalesCountLow = summary.SalesCountLow
+	resp.SalesCountHigh = summary.SalesCountHigh
+	resp.TotalSalesLow = summary.TotalSalesLow
+	resp.TotalSalesHigh = summary.TotalSalesHigh
+
+	daily, err := uc.repo.ListDailySales(ctx, goodsID, rankType, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("查询商品每期销量失败: %w", err)
+	}
+	trendByDate := make(map[string]*data.ProductTrendSales)
+	if rankType == data.RankPeriodDay {
+		trends, err := uc.repo.ListTrendSales(ctx, goodsID, startDate, endDate)
+		if err != nil {
+			return nil, fmt.Errorf("查询商品趋势销量失败: %w", err)
+		}
+		for _, t := range trends {
+			trendByDate[strconv.Itoa(t.DateCode)] = t
+		}
+	}
+
+	resp.Daily = make([]*v1.ProductDailySales, 0, len(daily))
+	for _, d := range daily {
+		point := &v1.ProductDailySales{
+			RankDate:       d.RankDate,
+			VideoCount:     d.VideoCount,
+			SalesCountLow:  d.SalesCountLow,
+			SalesCountHigh: d.SalesCountHigh,
+			TotalSalesLow:  d.TotalSalesLow,
+			TotalSalesHigh: d.TotalSalesHigh,
+		}
+		if t, ok := trendByDate[d.RankDate]; ok {
+			point.TrendIncSalesCount = t.IncSalesCount
+			point.TrendIncSalesGmv = t.IncSalesGmv
+		}
+		resp.Daily = append(resp.Daily, point)
+	}
+	return resp, nil
+}
+
+// ListProductTopVideos 查询商品累计销售额最高的带货视频
+func (uc *ProductUsecase) ListProductTopVideos(ctx context.Context, goodsID, rankType, startDate, endDate string, limit int) (*v1.ListProductTopVideosResponse, error) {
+	rankType, err := normalizeProductRankArgs(goodsID, rankType)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultTopVideosLimit
+	}
+	if limit > maxTopVideosLimit {
+		limit = maxTopVideosLimit
+	}
+
+	rows, err := uc.repo.ListTopVideos(ctx, goodsID, rankType, startDate, endDate, limit)
+	if err != nil {
+		return nil, err
+	}
+	videos := make([]*v1.ProductTopVideo, 0, len(rows))
+	for _, row := range rows {
+		videos = append(videos, &v1.ProductTopVideo{
+			AwemeId:         row.AwemeId,
+			AwemeDesc:       row.AwemeDesc,
+			AwemeCoverUrl:   row.AwemeCoverUrl,
+			BloggerId:       row.BloggerId,
+			BloggerName:     row.BloggerName,
+			RankAppearances: row.RankAppearances,
+			BestPosition:    int32(row.BestPosition),
+			SalesCountLow:   row.SalesCountLow,
+			SalesCountHigh:  row.SalesCountHigh,
+			TotalSalesLow:   row.TotalSalesLow,
+			TotalSalesHigh:  row.TotalSalesHigh,
+		})
+	}
+	return &v1.ListProductTopVideosResponse{Videos: videos}, nil
+}
+
+// normalizeProductRankArgs 校验商品分析的公共参数，rankType 为空时默认日榜
+func normalizeProductRankArgs(goodsID, rankType string) (string, error) {
+	if goodsID == "" {
+		return "", fmt.Errorf("goods_id 不能为空")
+	}
+	if rankType == "" {
+		rankType = data.RankPeriodDay
+	}
+	if !data.IsValidRankPeriod(rankType) {
+		return "", fmt.Errorf("不支持的榜单周期: %s", rankType)
+	}
+	return rankType, nil
+}
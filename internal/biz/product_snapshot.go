@@ -0,0 +1,81 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/pkg/utils"
+)
+
+// GetProductPriceHistory 查询商品的价格/佣金快照，并找出相邻快照之间的变动事件
+func (uc *ProductUsecase) GetProductPriceHistory(ctx context.Context, goodsID, startDate, endDate string) (*v1.ProductPriceHistoryResponse, error) {
+	if goodsID == "" {
+		return nil, fmt.Errorf("goods_id 不能为空")
+	}
+	snapshots, err := uc.repo.ListSnapshots(ctx, goodsID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ProductPriceHistoryResponse{
+		GoodsId: goodsID,
+		Points:  make([]*v1.ProductPriceSnapshot, 0, len(snapshots)),
+	}
+	for i, s := range snapshots {
+		resp.Points = append(resp.Points, &v1.ProductPriceSnapshot{
+			Date:            s.SnapshotDate,
+			GoodsPrice:      s.GoodsPrice,
+			GoodsPriceRange: s.GoodsPriceRange,
+			CosRatio:        s.CosRatio,
+			CommissionPrice: s.CommissionPrice,
+		})
+		if i > 0 {
+			resp.Events = append(resp.Events, productChangeEvents(snapshots[i-1], s)...)
+		}
+	}
+	return resp, nil
+}
+
+// productChangeEvents 比较相邻两个快照，返回发生变化的字段
+func productChangeEvents(prev, cur *data.ProductSnapshot) []*v1.ProductChangeEvent {
+	fields := []struct {
+		name     string
+		old, new string
+	}{
+		{"goods_price", strconv.FormatFloat(prev.GoodsPrice, 'f', -1, 64), strconv.FormatFloat(cur.GoodsPrice, 'f', -1, 64)},
+		{"goods_price_range", prev.GoodsPriceRange, cur.GoodsPriceRange},
+		{"cos_ratio", prev.CosRatio, cur.CosRatio},
+		{"commission_price", prev.CommissionPrice, cur.CommissionPrice},
+	}
+
+	var events []*v1.ProductChangeEvent
+	for _, f := range fields {
+		if f.old == f.new {
+			continue
+		}
+		events = append(events, &v1.ProductChangeEvent{
+			Date:       cur.SnapshotDate,
+			Field:      f.name,
+			OldValue:   f.old,
+			NewValue:   f.new,
+			ChangeRate: changeRate(f.old, f.new),
+		})
+	}
+	return events
+}
+
+// changeRate 计算 (new-old)/old，取值范围字符串按范围高值计算，无法解析或 old 为 0 时返回 0
+func changeRate(oldValue, newValue string) float64 {
+	_, oldHigh, ok := utils.ParseMetricRange(oldValue)
+	if !ok || oldHigh == 0 {
+		return 0
+	}
+	_, newHigh, ok := utils.ParseMetricRange(newValue)
+	if !ok {
+		return 0
+	}
+	return (newHigh - oldHigh) / oldHigh
+}
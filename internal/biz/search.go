@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	// highlightContext 是高亮片段中命中位置前后各保留的字符数
+	highlightContext = 30
+)
+
+// SearchUsecase 封装跨视频、商品、博主的统一搜索
+type SearchUsecase struct {
+	repo data.SearchRepo
+}
+
+// NewSearchUsecase 构造 SearchUsecase
+func NewSearchUsecase(repo data.SearchRepo) *SearchUsecase {
+	return &SearchUsecase{repo: repo}
+}
+
+// Search 按实体类型分别检索，合并后按相关度排序并截取前 limit 条
+func (uc *SearchUsecase) Search(ctx context.Context, query string, entityTypes []v1.SearchEntityType, filters *v1.SearchFilters, limit int) (*v1.SearchResponse, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("搜索关键字不能为空")
+	}
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	if filters == nil {
+		filters = &v1.SearchFilters{}
+	}
+
+	var hits []*v1.SearchHit
+	for _, t := range normalizeEntityTypes(entityTypes) {
+		switch t {
+		case v1.SearchEntityType_SEARCH_ENTITY_TYPE_VIDEO:
+			videos, err := uc.repo.SearchVideos(ctx, query, filters.Video, limit)
+			if err != nil {
+				return nil, fmt.Errorf("搜索视频失败: %w", err)
+			}
+			for _, v := range videos {
+				hits = append(hits, &v1.SearchHit{
+					EntityType: t,
+					Id:         v.AwemeId,
+					Title:      v.AwemeDesc,
+					Highlight:  highlight(v.AwemeDesc, query),
+					Score:      v.Score,
+					Video:      data.CopyVideoToDTO(&v.Video),
+				})
+			}
+		case v1.SearchEntityType_SEARCH_ENTITY_TYPE_PRODUCT:
+			products, err := uc.repo.SearchProducts(ctx, query, filters.Product, limit)
+			if err != nil {
+				return nil, fmt.Errorf("搜索商品失败: %w", err)
+			}
+			for _, p := range products {
+				hits = append(hits, &v1.SearchHit{
+					EntityType: t,
+					Id:         p.GoodsId,
+					Title:      p.GoodsTitle,
+					Highlight:  highlight(p.GoodsTitle, query),
+					Score:      p.Score,
+					Product:    data.CopyProductToDTO(&p.Product),
+				})
+			}
+		case v1.SearchEntityType_SEARCH_ENTITY_TYPE_BLOGGER:
+			bloggers, err := uc.repo.SearchBloggers(ctx, query, filters.Blogger, limit)
+			if err != nil {
+				return nil, fmt.Errorf("搜索博主失败: %w", err)
+			}
+			for _, b := range bloggers {
+				hits = append(hits, &v1.SearchHit{
+					EntityType: t,
+					Id:         strconv.FormatInt(b.BloggerId, 10),
+					Title:      b.BloggerName,
+					Highlight:  highlight(b.BloggerName, query),
+					Score:      b.Score,
+					Blogger:    data.CopyBloggerToDTO(&b.Blogger),
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Score > hits[j].Score
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return &v1.SearchResponse{Hits: hits}, nil
+}
+
+// normalizeEntityTypes 去重并去掉 UNSPECIFIED，为空时返回全部类型
+func normalizeEntityTypes(types []v1.SearchEntityType) []v1.SearchEntityType {
+	seen := make(map[v1.SearchEntityType]bool)
+	var result []v1.SearchEntityType
+	for _, t := range types {
+		if t == v1.SearchEntityType_SEARCH_ENTITY_TYPE_UNSPECIFIED || seen[t] {
+			continue
+		}
+		seen[t] = true
+		result = append(result, t)
+	}
+	if len(result) == 0 {
+		return []v1.SearchEntityType{
+			v1.SearchEntityType_SEARCH_ENTITY_TYPE_VIDEO,
+			v1.SearchEntityType_SEARCH_ENTITY_TYPE_PRODUCT,
+			v1.SearchEntityType_SEARCH_ENTITY_TYPE_BLOGGER,
+		}
+	}
+	return result
+}
+
+// highlight 截取 text 中第一个命中位置附近的片段，并把所有命中的关键字用 <em></em> 包裹（不区分大小写）。
+// 只靠相似度命中、不包含完整关键字时返回开头的片段且不做标记。
+// 返回值会直接作为 HTML 渲染，原文中的内容都经过转义，只有 <em> 标签是未转义的。
+func highlight(text, query string) string {
+	runes := []rune(text)
+	lowerText := []rune(strings.ToLower(text))
+	lowerQuery := []rune(strings.ToLower(query))
+	// ToLower 可能改变个别字符的长度，此时放弃高亮以免错位
+	if len(lowerText) != len(runes) || len(lowerQuery) == 0 {
+		return truncateRunes(runes, 0, 2*highlightContext)
+	}
+
+	first := indexRunes(lowerText, lowerQuery, 0)
+	if first < 0 {
+		return truncateRunes(runes, 0, 2*highlightContext)
+	}
+	start := first - highlightContext
+	if start < 0 {
+		start = 0
+	}
+	end := first + len(lowerQuery) + highlightContext
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("...")
+	}
+	for i := start; i < end; {
+		if i+len(lowerQuery) <= end && indexRunes(lowerText[:i+len(lowerQuery)], lowerQuery, i) == i {
+			sb.WriteString("<em>")
+			sb.WriteString(html.EscapeString(string(runes[i : i+len(lowerQuery)])))
+			sb.WriteString("</em>")
+			i += len(lowerQuery)
+			continue
+		}
+		sb.WriteString(html.EscapeString(string(runes[i])))
+		i++
+	}
+	if end < len(runes) {
+		sb.WriteString("...")
+	}
+	return sb.String()
+}
+
+// indexRunes 返回 sub 在 s[from:] 中第一次出现的位置，未找到返回 -1
+func indexRunes(s, sub []rune, from int) int {
+	for i := from; i+len(sub) <= len(s); i++ {
+		match := true
+		for j := range sub {
+			if s[i+j] != sub[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
+
+// truncateRunes 截取 runes[start:start+n] 并做 HTML 转义，被截断时追加省略号
+func truncateRunes(runes []rune, start, n int) string {
+	if start+n >= len(runes) {
+		return html.EscapeString(string(runes[start:]))
+	}
+	return html.EscapeString(string(runes[start:start+n])) + "..."
+}
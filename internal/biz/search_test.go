@@ -0,0 +1,55 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	long := strings.Repeat("啊", highlightContext+5)
+	tests := []struct {
+		name  string
+		text  string
+		query string
+		want  string
+	}{
+		{name: "single match", text: "夏季防晒霜推荐", query: "防晒", want: "夏季<em>防晒</em>霜推荐"},
+		{name: "case insensitive keeps original case", text: "SK-II 神仙水 sk-ii", query: "sk-ii", want: "<em>SK-II</em> 神仙水 <em>sk-ii</em>"},
+		{name: "escapes text", text: `<img src=x onerror=alert(1)> 防晒 & "霜"`, query: "防晒", want: "&lt;img src=x onerror=alert(1)&gt; <em>防晒</em> &amp; &#34;霜&#34;"},
+		{name: "escapes match", text: "买<b>一送一", query: "<b>", want: "买<em>&lt;b&gt;</em>一送一"},
+		{name: "no exact match", text: "<script>", query: "防晒", want: "&lt;script&gt;"},
+		{name: "empty query", text: "防晒霜", query: "", want: "防晒霜"},
+		{name: "leading ellipsis", text: long + "防晒", query: "防晒", want: "..." + strings.Repeat("啊", highlightContext) + "<em>防晒</em>"},
+		{name: "trailing ellipsis", text: "防晒" + long, query: "防晒", want: "<em>防晒</em>" + strings.Repeat("啊", highlightContext) + "..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := highlight(tt.text, tt.query); got != tt.want {
+				t.Errorf("highlight(%q, %q) = %q, want %q", tt.text, tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		start int
+		n     int
+		want  string
+	}{
+		{name: "fits", text: "防晒霜", n: 5, want: "防晒霜"},
+		{name: "exact length", text: "防晒霜", n: 3, want: "防晒霜"},
+		{name: "truncated", text: "防晒霜推荐", n: 2, want: "防晒..."},
+		{name: "escaped", text: "<a>&", n: 10, want: "&lt;a&gt;&amp;"},
+		{name: "offset", text: "防晒霜推荐", start: 2, n: 2, want: "霜推..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncateRunes([]rune(tt.text), tt.start, tt.n); got != tt.want {
+				t.Errorf("truncateRunes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package biz
+
+import (
+	"context"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+// ShopUsecase 封装店铺维度的查询和分析
+type ShopUsecase struct {
+	dim *dimensionUsecase
+}
+
+// NewShopUsecase 构造 ShopUsecase
+func NewShopUsecase(repo data.ShopRepo) *ShopUsecase {
+	get := func(ctx context.Context, id uint) (*data.NamedDimension, error) {
+		shop, err := repo.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &shop.NamedDimension, nil
+	}
+	return &ShopUsecase{dim: &dimensionUsecase{name: "店铺", repo: repo, get: get}}
+}
+
+// ListShops 分页查询店铺
+func (uc *ShopUsecase) ListShops(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) (*v1.ListDimensionsResponse, error) {
+	return uc.dim.list(ctx, page, query, sortBy, sortOrder)
+}
+
+// GetShop 查询单个店铺及其名称变体
+func (uc *ShopUsecase) GetShop(ctx context.Context, id uint64) (*v1.GetDimensionResponse, error) {
+	item, err := uc.dim.detail(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetDimensionResponse{Item: item}, nil
+}
+
+// GetShopAnalytics 汇总店铺下全部商品的带货表现
+func (uc *ShopUsecase) GetShopAnalytics(ctx context.Context, id uint64, rankType, startDate, endDate string, limit int) (*v1.DimensionAnalyticsResponse, error) {
+	return uc.dim.analytics(ctx, id, rankType, startDate, endDate, limit)
+}
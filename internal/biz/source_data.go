@@ -0,0 +1,76 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/internal/etl"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SourceDataUsecase 封装原始数据相关的运维业务逻辑
+type SourceDataUsecase struct {
+	repo data.SourceDataRepo
+	etl  *etl.ETLUsecase
+	log  *log.Helper
+}
+
+// NewSourceDataUsecase 构造 SourceDataUsecase
+func NewSourceDataUsecase(repo data.SourceDataRepo, etlUC *etl.ETLUsecase, logger log.Logger) *SourceDataUsecase {
+	return &SourceDataUsecase{
+		repo: repo,
+		etl:  etlUC,
+		log:  log.NewHelper(log.With(logger, "module", "usecase/source-data")),
+	}
+}
+
+// GetStats 按数据类型统计各处理状态的数量
+func (uc *SourceDataUsecase) GetStats(ctx context.Context, dataType string) ([]*v1.SourceDataStatusCount, error) {
+	counts, err := uc.repo.CountByStatus(ctx, dataType)
+	if err != nil {
+		return nil, err
+	}
+	return copyStatusCountsToDTO(counts), nil
+}
+
+// Reprocess 重放匹配条件的原始数据，日期或状态不合法时直接拒绝。
+// 非 dry_run 模式下在请求上下文中同步执行 ETL，单次重放的记录数受 limit 限制；
+// 请求取消后未处理的记录保持未处理状态，由定时 ETL 继续处理。
+func (uc *SourceDataUsecase) Reprocess(ctx context.Context, filter *data.SourceDataFilter, dryRun bool) (*v1.ReprocessSourceDataResponse, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("无效的重放条件: %w", err)
+	}
+	result, err := uc.etl.PrepareReprocess(ctx, filter, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun && len(result.IDs) > 0 {
+		if err := uc.etl.ProcessByIDs(ctx, result.IDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.ReprocessSourceDataResponse{
+		DryRun:     result.DryRun,
+		Matched:    result.Matched,
+		ResetCount: result.Reset,
+		Skipped:    result.Skipped,
+		Counts:     copyStatusCountsToDTO(result.Counts),
+		Ids:        result.IDs,
+	}, nil
+}
+
+func copyStatusCountsToDTO(counts []*data.SourceDataStatusCount) []*v1.SourceDataStatusCount {
+	dtos := make([]*v1.SourceDataStatusCount, len(counts))
+	for i, c := range counts {
+		dtos[i] = &v1.SourceDataStatusCount{
+			DataType: c.DataType,
+			Status:   c.Status.ToProto(),
+			Count:    c.Count,
+		}
+	}
+	return dtos
+}
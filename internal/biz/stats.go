@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	defaultTopDimensionsLimit = 20
+	maxTopDimensionsLimit     = 100
+	defaultTopDimensionsSort  = "total_sales"
+)
+
+// StatsUsecase 封装基于每日汇总表的看板统计
+type StatsUsecase struct {
+	repo data.RollupRepo
+}
+
+// NewStatsUsecase 构造 StatsUsecase
+func NewStatsUsecase(repo data.RollupRepo) *StatsUsecase {
+	return &StatsUsecase{repo: repo}
+}
+
+// ListDailyStats 查询某个维度的每日统计
+func (uc *StatsUsecase) ListDailyStats(ctx context.Context, dimension, dimensionValue, rankType, startDate, endDate string) (*v1.ListDailyStatsResponse, error) {
+	if dimension == "" {
+		dimension = data.RollupDimensionAll
+	}
+	rankType, err := normalizeStatsArgs(dimension, rankType)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := uc.repo.ListDaily(ctx, dimension, rankType, dimensionValue, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]*v1.DailyStat, 0, len(rows))
+	for _, r := range rows {
+		stats = append(stats, &v1.DailyStat{
+			Date:               r.RollupDate,
+			DimensionValue:     r.DimensionValue,
+			VideoCount:         r.VideoCount,
+			GoodsCount:         r.GoodsCount,
+			BloggerCount:       r.BloggerCount,
+			RankEntries:        r.RankEntries,
+			SalesCountLow:      r.SalesCountLow,
+			SalesCountHigh:     r.SalesCountHigh,
+			TotalSalesLow:      r.TotalSalesLow,
+			TotalSalesHigh:     r.TotalSalesHigh,
+			TrendIncSalesCount: r.TrendIncSalesCount,
+			TrendIncSalesGmv:   r.TrendIncSalesGmv,
+		})
+	}
+	return &v1.ListDailyStatsResponse{Stats: stats}, nil
+}
+
+// ListTopDimensions 查询某个维度在一段时间内排名靠前的维度值
+func (uc *StatsUsecase) ListTopDimensions(ctx context.Context, dimension, rankType, startDate, endDate, sortBy string, limit int) (*v1.ListTopDimensionsResponse, error) {
+	if dimension == "" || dimension == data.RollupDimensionAll {
+		return nil, fmt.Errorf("维度排行需要指定 category、brand、shop 或 blogger_tag")
+	}
+	rankType, err := normalizeStatsArgs(dimension, rankType)
+	if err != nil {
+		return nil, err
+	}
+	if sortBy == "" {
+		sortBy = defaultTopDimensionsSort
+	}
+	if limit <= 0 {
+		limit = defaultTopDimensionsLimit
+	}
+	if limit > maxTopDimensionsLimit {
+		limit = maxTopDimensionsLimit
+	}
+
+	rows, err := uc.repo.ListTop(ctx, dimension, rankType, startDate, endDate, sortBy, limit)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.DimensionTotal, 0, len(rows))
+	for _, r := range rows {
+		items = append(items, &v1.DimensionTotal{
+			DimensionValue:     r.DimensionValue,
+			Days:               r.Days,
+			VideoCount:         r.VideoCount,
+			RankEntries:        r.RankEntries,
+			SalesCountLow:      r.SalesCountLow,
+			SalesCountHigh:     r.SalesCountHigh,
+			TotalSalesLow:      r.TotalSalesLow,
+			TotalSalesHigh:     r.TotalSalesHigh,
+			TrendIncSalesCount: r.TrendIncSalesCount,
+			TrendIncSalesGmv:   r.TrendIncSalesGmv,
+		})
+	}
+	return &v1.ListTopDimensionsResponse{Items: items}, nil
+}
+
+// normalizeStatsArgs 校验维度和榜单周期，rankType 为空时默认日榜
+func normalizeStatsArgs(dimension, rankType string) (string, error) {
+	if _, ok := data.RollupDimensions[dimension]; !ok {
+		return "", fmt.Errorf("不支持的统计维度: %s", dimension)
+	}
+	if rankType == "" {
+		rankType = data.RankPeriodDay
+	}
+	if !data.IsValidRankPeriod(rankType) {
+		return "", fmt.Errorf("不支持的榜单周期: %s", rankType)
+	}
+	return rankType, nil
+}
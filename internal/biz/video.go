@@ -30,16 +30,16 @@ func NewVideoUsecase(repo data.VideoRepo, sourceDataRepo data.SourceDataRepo) *V
 }
 
 // ListVideos 分页查询视频
-func (uc *VideoUsecase) ListVideos(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*v1.VideoDTO, int64, error) {
-	videos, total, err := uc.repo.ListPage(ctx, page, size, query, sortBy, sortOrder)
+func (uc *VideoUsecase) ListVideos(ctx context.Context, page *v1.PageRequest, query string, filter *v1.VideoFilter, sortBy string, sortOrder v1.SortOrder) ([]*v1.VideoDTO, *v1.PageResponse, error) {
+	videos, pageResp, err := uc.repo.ListPage(ctx, page, query, filter, sortBy, sortOrder)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 	dtos := make([]*v1.VideoDTO, len(videos))
 	for i, v := range videos {
 		dtos[i] = data.CopyVideoToDTO(v)
 	}
-	return dtos, total, nil
+	return dtos, pageResp, nil
 }
 
 // GetVideosByTimeWindow 获取在24小时时间窗口内需要更新的视频 (旧逻辑)
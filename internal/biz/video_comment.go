@@ -0,0 +1,80 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	// defaultCommentKeywordLimit 是未指定数量时返回的评论关键词数
+	defaultCommentKeywordLimit = 50
+	// maxCommentKeywordLimit 是单次最多返回的评论关键词数
+	maxCommentKeywordLimit = 500
+)
+
+// VideoCommentUsecase 查询视频评论和评论关键词，数据由 worker 采集
+type VideoCommentUsecase struct {
+	repo data.VideoCommentRepo
+}
+
+// NewVideoCommentUsecase 构造 VideoCommentUsecase
+func NewVideoCommentUsecase(repo data.VideoCommentRepo) *VideoCommentUsecase {
+	return &VideoCommentUsecase{repo: repo}
+}
+
+// ListComments 分页查询视频评论
+func (uc *VideoCommentUsecase) ListComments(ctx context.Context, req *v1.ListVideoCommentsRequest) (*v1.ListVideoCommentsResponse, error) {
+	if req.AwemeId == "" {
+		return nil, errors.New("aweme_id 不能为空")
+	}
+	comments, pageResp, err := uc.repo.ListPage(ctx, req.Page, req.AwemeId, req.Query, req.SortBy, req.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListVideoCommentsResponse{Page: pageResp, Comments: make([]*v1.VideoComment, 0, len(comments))}
+	for _, c := range comments {
+		dto := &v1.VideoComment{
+			CommentId:  c.CommentId,
+			AwemeId:    c.AwemeId,
+			Content:    c.Content,
+			Nickname:   c.Nickname,
+			LikeCount:  c.LikeCount,
+			ReplyCount: c.ReplyCount,
+			IpLabel:    c.IpLabel,
+		}
+		if c.CommentTime != nil {
+			dto.CommentTime = c.CommentTime.Format(time.RFC3339)
+		}
+		resp.Comments = append(resp.Comments, dto)
+	}
+	return resp, nil
+}
+
+// GetKeywords 按出现次数倒序返回视频的评论关键词
+func (uc *VideoCommentUsecase) GetKeywords(ctx context.Context, awemeId string, limit int) (*v1.GetCommentKeywordsResponse, error) {
+	if awemeId == "" {
+		return nil, errors.New("aweme_id 不能为空")
+	}
+	if limit == 0 {
+		limit = defaultCommentKeywordLimit
+	}
+	if limit < 0 || limit > maxCommentKeywordLimit {
+		return nil, fmt.Errorf("limit 需在 1~%d 之间", maxCommentKeywordLimit)
+	}
+	keywords, err := uc.repo.ListKeywords(ctx, awemeId, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.GetCommentKeywordsResponse{Keywords: make([]*v1.CommentKeyword, 0, len(keywords))}
+	for _, k := range keywords {
+		resp.Keywords = append(resp.Keywords, &v1.CommentKeyword{Keyword: k.Keyword, Count: k.Count, Ratio: k.Ratio})
+		// 关键词整体替换写入，任意一条的创建时间即为最近一次更新时间
+		resp.UpdatedAt = k.CreatedAt.Format(time.RFC3339)
+	}
+	return resp, nil
+}
@@ -20,12 +20,17 @@ func NewVideoRankUsecase(repo data.VideoRankRepo) *VideoRankUsecase {
 
 // GetVideoRank 查询单个视频榜单
 func (uc *VideoRankUsecase) GetVideoRank(ctx context.Context, awemeID, rankType, rankDate string) (*v1.VideoRankDTO, error) {
+	_, _, rankDate = data.VideoRankPeriodDates(rankType, rankDate)
 	return uc.repo.GetByAwemeID(ctx, awemeID, rankType, rankDate)
 }
 
 // ListVideoRank 分页查询视频榜单
-func (uc *VideoRankUsecase) ListVideoRank(ctx context.Context, page, size int, rankType, rankDate, sortBy, sortOrder string) ([]*v1.VideoRankDTO, int64, error) {
-	return uc.repo.ListPage(ctx, page, size, rankType, rankDate, sortBy, sortOrder)
+// 周榜和月榜的 rankDate 可以是周期内任意一天，会先对齐到该周期的 rank_date
+func (uc *VideoRankUsecase) ListVideoRank(ctx context.Context, page *v1.PageRequest, rankType, rankDate string, categoryID uint64, sortBy, sortOrder string) ([]*v1.VideoRankDTO, *v1.PageResponse, error) {
+	if rankType != "" && rankDate != "" {
+		_, _, rankDate = data.VideoRankPeriodDates(rankType, rankDate)
+	}
+	return uc.repo.ListPage(ctx, page, rankType, rankDate, categoryID, sortBy, sortOrder)
 }
 
 // GetTrackedAwemeIDs 获取需要追踪的视频ID列表
@@ -0,0 +1,209 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	defaultMoversLimit = 50
+	maxMoversLimit     = 200
+)
+
+// GetRankHistory 查询单个视频的上榜历史，并计算名次变化、累计上榜期数和连续上榜期数
+func (uc *VideoRankUsecase) GetRankHistory(ctx context.Context, awemeID, rankType, startDate, endDate string) (*v1.GetRankHistoryResponse, error) {
+	if awemeID == "" {
+		return nil, fmt.Errorf("aweme_id 不能为空")
+	}
+	if rankType == "" {
+		rankType = data.RankPeriodDay
+	}
+	rows, err := uc.repo.ListHistory(ctx, awemeID, rankType, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.GetRankHistoryResponse{AwemeId: awemeID, RankType: rankType}
+	if len(rows) == 0 {
+		return resp, nil
+	}
+
+	dates := make([]string, 0, len(rows))
+	resp.Points = make([]*v1.RankHistoryPoint, 0, len(rows))
+	for _, row := range rows {
+		prev, delta, isNew := rankMovement(rankType, row)
+		resp.Points = append(resp.Points, &v1.RankHistoryPoint{
+			RankDate:         row.RankDate,
+			GoodsId:          row.GoodsId,
+			RankPosition:     int32(row.RankPosition),
+			PreviousPosition: int32(prev),
+			PositionDelta:    int32(delta),
+			IsNew:            isNew,
+			SalesCountHigh:   row.SalesCountHigh,
+			TotalSalesHigh:   row.TotalSalesHigh,
+		})
+		if len(dates) == 0 || dates[len(dates)-1] != row.RankDate {
+			dates = append(dates, row.RankDate)
+		}
+		if resp.FirstSeenDate == "" || row.FirstSeenDate < resp.FirstSeenDate {
+			resp.FirstSeenDate = row.FirstSeenDate
+		}
+	}
+
+	current, longest := rankStreaks(rankType, dates)
+	resp.LastSeenDate = dates[len(dates)-1]
+	resp.DaysOnList = int32(len(dates))
+	resp.CurrentStreak = int32(current)
+	resp.LongestStreak = int32(longest)
+	return resp, nil
+}
+
+// ListRankMovers 查询某一期榜单相对上一期的名次变动
+// direction 为 "up"、"down"、"new" 之一，为空时按变动幅度返回全部
+func (uc *VideoRankUsecase) ListRankMovers(ctx context.Context, rankDate, rankType, direction string, limit int) (*v1.ListRankMoversResponse, error) {
+	if rankDate == "" {
+		return nil, fmt.Errorf("rank_date 不能为空")
+	}
+	if rankType == "" {
+		rankType = data.RankPeriodDay
+	}
+	if limit <= 0 {
+		limit = defaultMoversLimit
+	}
+	if limit > maxMoversLimit {
+		limit = maxMoversLimit
+	}
+	_, _, rankDate = data.VideoRankPeriodDates(rankType, rankDate)
+	previousDate, ok := data.ShiftRankDate(rankType, rankDate, -1)
+	if !ok {
+		return nil, fmt.Errorf("无效的榜单日期或周期: rank_date=%s, rank_type=%s", rankDate, rankType)
+	}
+
+	rows, err := uc.repo.ListHistoryByDate(ctx, rankType, rankDate)
+	if err != nil {
+		return nil, err
+	}
+
+	movers := make([]*v1.RankMover, 0, len(rows))
+	for _, row := range rows {
+		prev, delta, isNew := rankMovement(rankType, row)
+		switch direction {
+		case "":
+		case "up":
+			if delta <= 0 {
+				continue
+			}
+		case "down":
+			if delta >= 0 {
+				continue
+			}
+		case "new":
+			if !isNew {
+				continue
+			}
+		default:
+			return nil, fmt.Errorf("无效的变动方向: %s", direction)
+		}
+		movers = append(movers, &v1.RankMover{
+			Rank:             data.CopyVideoRankToDTO(&row.VideoRank),
+			PreviousPosition: int32(prev),
+			PositionDelta:    int32(delta),
+			IsNew:            isNew,
+			FirstSeenDate:    row.FirstSeenDate,
+		})
+	}
+
+	sortRankMovers(movers, direction)
+	if len(movers) > limit {
+		movers = movers[:limit]
+	}
+
+	// 只为最终返回的视频计算连续上榜期数，避免查询整期榜单的历史
+	awemeIDs := make([]string, 0, len(movers))
+	for _, m := range movers {
+		awemeIDs = append(awemeIDs, m.Rank.AwemeId)
+	}
+	datesByAweme, err := uc.repo.ListRankDates(ctx, rankType, awemeIDs, rankDate)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range movers {
+		current, _ := rankStreaks(rankType, datesByAweme[m.Rank.AwemeId])
+		m.Streak = int32(current)
+	}
+
+	return &v1.ListRankMoversResponse{
+		RankDate:         rankDate,
+		RankType:         rankType,
+		PreviousRankDate: previousDate,
+		Movers:           movers,
+	}, nil
+}
+
+// rankMovement 计算一条上榜记录相对紧邻上一期的名次变化。
+// 上一次上榜不是紧邻的上一期时视为新上榜；任一期名次未知时不计算变化量。
+func rankMovement(period string, row *data.VideoRankHistory) (previous, delta int, isNew bool) {
+	expected, ok := data.ShiftRankDate(period, row.RankDate, -1)
+	if !ok || row.PreviousRankDate == "" || row.PreviousRankDate != expected {
+		return 0, 0, true
+	}
+	previous = row.PreviousPosition
+	if previous > 0 && row.RankPosition > 0 {
+		delta = previous - row.RankPosition
+	}
+	return previous, delta, false
+}
+
+// sortRankMovers 按变动方向排序：上升按升幅降序，下降按降幅降序，新上榜按名次升序，
+// 全部时按变动幅度降序，幅度相同的按名次升序
+func sortRankMovers(movers []*v1.RankMover, direction string) {
+	sort.SliceStable(movers, func(i, j int) bool {
+		a, b := movers[i], movers[j]
+		switch direction {
+		case "up":
+			if a.PositionDelta != b.PositionDelta {
+				return a.PositionDelta > b.PositionDelta
+			}
+		case "down":
+			if a.PositionDelta != b.PositionDelta {
+				return a.PositionDelta < b.PositionDelta
+			}
+		case "":
+			da, db := absInt32(a.PositionDelta), absInt32(b.PositionDelta)
+			if da != db {
+				return da > db
+			}
+		}
+		return a.Rank.RankPosition < b.Rank.RankPosition
+	})
+}
+
+// rankStreaks 根据升序的上榜日期计算截至最后一期的连续上榜期数和历史最长连续期数
+func rankStreaks(period string, dates []string) (current, longest int) {
+	for i, d := range dates {
+		if i > 0 {
+			if prev, ok := data.ShiftRankDate(period, d, -1); ok && prev == dates[i-1] {
+				current++
+			} else {
+				current = 1
+			}
+		} else {
+			current = 1
+		}
+		if current > longest {
+			longest = current
+		}
+	}
+	return current, longest
+}
+
+func absInt32(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
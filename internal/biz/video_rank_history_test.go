@@ -0,0 +1,100 @@
+package biz
+
+import (
+	"testing"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+func rankHistoryRow(rankDate string, position int, previousDate string, previousPosition int) *data.VideoRankHistory {
+	row := &data.VideoRankHistory{PreviousRankDate: previousDate, PreviousPosition: previousPosition}
+	row.RankDate = rankDate
+	row.RankPosition = position
+	return row
+}
+
+func TestRankMovement(t *testing.T) {
+	tests := []struct {
+		name         string
+		period       string
+		row          *data.VideoRankHistory
+		wantPrevious int
+		wantDelta    int
+		wantNew      bool
+	}{
+		{name: "first appearance", period: data.RankPeriodDay, row: rankHistoryRow("20250702", 5, "", 0), wantNew: true},
+		{name: "rose", period: data.RankPeriodDay, row: rankHistoryRow("20250702", 3, "20250701", 10), wantPrevious: 10, wantDelta: 7},
+		{name: "fell", period: data.RankPeriodDay, row: rankHistoryRow("20250702", 12, "20250701", 4), wantPrevious: 4, wantDelta: -8},
+		{name: "gap is new", period: data.RankPeriodDay, row: rankHistoryRow("20250705", 3, "20250701", 10), wantNew: true},
+		{name: "across month", period: data.RankPeriodDay, row: rankHistoryRow("20250801", 2, "20250731", 2), wantPrevious: 2},
+		{name: "unknown current position", period: data.RankPeriodDay, row: rankHistoryRow("20250702", 0, "20250701", 6), wantPrevious: 6},
+		{name: "unknown previous position", period: data.RankPeriodDay, row: rankHistoryRow("20250702", 6, "20250701", 0)},
+		{name: "consecutive weeks", period: data.RankPeriodWeek, row: rankHistoryRow("20250707", 1, "20250630", 4), wantPrevious: 4, wantDelta: 3},
+		{name: "skipped week", period: data.RankPeriodWeek, row: rankHistoryRow("20250714", 1, "20250630", 4), wantNew: true},
+		{name: "consecutive months", period: data.RankPeriodMonth, row: rankHistoryRow("20250301", 8, "20250201", 2), wantPrevious: 2, wantDelta: -6},
+		{name: "unknown period", period: "year", row: rankHistoryRow("20250702", 3, "20250701", 10), wantNew: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous, delta, isNew := rankMovement(tt.period, tt.row)
+			if previous != tt.wantPrevious || delta != tt.wantDelta || isNew != tt.wantNew {
+				t.Errorf("rankMovement() = (%d, %d, %v), want (%d, %d, %v)",
+					previous, delta, isNew, tt.wantPrevious, tt.wantDelta, tt.wantNew)
+			}
+		})
+	}
+}
+
+func TestRankStreaks(t *testing.T) {
+	tests := []struct {
+		name        string
+		period      string
+		dates       []string
+		wantCurrent int
+		wantLongest int
+	}{
+		{name: "empty", period: data.RankPeriodDay, dates: nil},
+		{name: "single", period: data.RankPeriodDay, dates: []string{"20250701"}, wantCurrent: 1, wantLongest: 1},
+		{name: "consecutive days", period: data.RankPeriodDay, dates: []string{"20250630", "20250701", "20250702"}, wantCurrent: 3, wantLongest: 3},
+		{name: "broken then resumed", period: data.RankPeriodDay, dates: []string{"20250701", "20250702", "20250703", "20250706", "20250707"}, wantCurrent: 2, wantLongest: 3},
+		{name: "latest alone", period: data.RankPeriodDay, dates: []string{"20250701", "20250702", "20250710"}, wantCurrent: 1, wantLongest: 2},
+		{name: "weeks", period: data.RankPeriodWeek, dates: []string{"20250616", "20250623", "20250630"}, wantCurrent: 3, wantLongest: 3},
+		{name: "months across year", period: data.RankPeriodMonth, dates: []string{"20241101", "20241201", "20250101", "20250301"}, wantCurrent: 1, wantLongest: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, longest := rankStreaks(tt.period, tt.dates)
+			if current != tt.wantCurrent || longest != tt.wantLongest {
+				t.Errorf("rankStreaks() = (%d, %d), want (%d, %d)", current, longest, tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
+
+func TestSortRankMovers(t *testing.T) {
+	mover := func(position, delta int32) *v1.RankMover {
+		return &v1.RankMover{Rank: &v1.VideoRankDTO{RankPosition: position}, PositionDelta: delta}
+	}
+	tests := []struct {
+		name      string
+		direction string
+		movers    []*v1.RankMover
+		want      []int32 // 排序后的名次
+	}{
+		{name: "up", direction: "up", movers: []*v1.RankMover{mover(5, 2), mover(1, 9), mover(3, 2)}, want: []int32{1, 3, 5}},
+		{name: "down", direction: "down", movers: []*v1.RankMover{mover(20, -3), mover(30, -10), mover(10, -3)}, want: []int32{30, 10, 20}},
+		{name: "new", direction: "new", movers: []*v1.RankMover{mover(9, 0), mover(2, 0)}, want: []int32{2, 9}},
+		{name: "all by magnitude", direction: "", movers: []*v1.RankMover{mover(4, 1), mover(8, -6), mover(2, 6)}, want: []int32{2, 8, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sortRankMovers(tt.movers, tt.direction)
+			for i, m := range tt.movers {
+				if m.Rank.RankPosition != tt.want[i] {
+					t.Fatalf("position[%d] = %d, want %v", i, m.Rank.RankPosition, tt.want)
+				}
+			}
+		})
+	}
+}
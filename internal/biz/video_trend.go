@@ -17,14 +17,14 @@ func NewVideoTrendUsecase(repo data.VideoTrendRepo) *VideoTrendUsecase {
 }
 
 // ListVideoTrends 分页查询视频趋势
-func (uc *VideoTrendUsecase) ListVideoTrends(ctx context.Context, page, size int, awemeId, startDate, endDate string) ([]*v1.VideoTrendDTO, int64, error) {
-	trends, total, err := uc.repo.ListPage(ctx, page, size, awemeId, startDate, endDate)
+func (uc *VideoTrendUsecase) ListVideoTrends(ctx context.Context, page *v1.PageRequest, awemeId, startDate, endDate string) ([]*v1.VideoTrendDTO, *v1.PageResponse, error) {
+	trends, pageResp, err := uc.repo.ListPage(ctx, page, awemeId, startDate, endDate)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 	dtos := make([]*v1.VideoTrendDTO, len(trends))
 	for i, t := range trends {
 		dtos[i] = data.CopyVideoTrendToDTO(t)
 	}
-	return dtos, total, nil
+	return dtos, pageResp, nil
 }
@@ -0,0 +1,269 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	defaultTrendMetric   = "sales_gmv"
+	defaultTrendingLimit = 20
+	maxTrendingLimit     = 100
+	// trendingWindowDays 是未指定窗口时"最近一段时间"的天数
+	trendingWindowDays = 7
+	// trendingCandidateFactor 在按生命周期过滤时多取的候选倍数，避免过滤后结果不足
+	trendingCandidateFactor = 5
+
+	// 生命周期判定阈值：最近几天的最大增量低于峰值的 deadRatio 视为沉寂，最近一天不低于峰值的 peakRatio 视为高峰
+	lifecycleRecentDays = 3
+	lifecycleDeadRatio  = 0.05
+	lifecyclePeakRatio  = 0.8
+)
+
+// GetVideoTrendAnalytics 计算单个视频在指定区间内的趋势分析
+func (uc *VideoTrendUsecase) GetVideoTrendAnalytics(ctx context.Context, awemeID, startDate, endDate, metric string) (*v1.VideoTrendAnalyticsResponse, error) {
+	if awemeID == "" {
+		return nil, fmt.Errorf("aweme_id 不能为空")
+	}
+	metric, err := normalizeTrendMetric(metric)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := parseDateCodes(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	trends, err := uc.repo.ListByAwemeIDs(ctx, []string{awemeID}, start, end)
+	if err != nil {
+		return nil, err
+	}
+	analytics := analyzeVideoTrend(awemeID, metric, trends)
+	return &v1.VideoTrendAnalyticsResponse{Analytics: analytics}, nil
+}
+
+// ListTrendingVideos 按当前窗口相对上一个等长窗口的增量返回增长最快的视频，并附带当前窗口内的生命周期分析
+func (uc *VideoTrendUsecase) ListTrendingVideos(ctx context.Context, startDate, endDate, metric, sortBy string, lifecycle v1.VideoLifecycle, limit int) (*v1.ListTrendingVideosResponse, error) {
+	metric, err := normalizeTrendMetric(metric)
+	if err != nil {
+		return nil, err
+	}
+	byRate := false
+	switch sortBy {
+	case "", "growth":
+	case "growth_rate":
+		byRate = true
+	default:
+		return nil, fmt.Errorf("不支持的排序方式: %s", sortBy)
+	}
+	if limit <= 0 {
+		limit = defaultTrendingLimit
+	}
+	if limit > maxTrendingLimit {
+		limit = maxTrendingLimit
+	}
+
+	startDay, endDay, err := trendingWindow(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	windowDays := int(endDay.Sub(startDay).Hours()/24) + 1
+	prevStart := dateCode(startDay.AddDate(0, 0, -windowDays))
+	curStart, curEnd := dateCode(startDay), dateCode(endDay)
+
+	candidates := limit
+	if lifecycle != v1.VideoLifecycle_VIDEO_LIFECYCLE_UNKNOWN {
+		candidates = limit * trendingCandidateFactor
+	}
+	growth, err := uc.repo.ListGrowth(ctx, metric, prevStart, curStart, curEnd, byRate, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	awemeIDs := make([]string, 0, len(growth))
+	for _, g := range growth {
+		awemeIDs = append(awemeIDs, g.AwemeId)
+	}
+	trends, err := uc.repo.ListByAwemeIDs(ctx, awemeIDs, curStart, curEnd)
+	if err != nil {
+		return nil, err
+	}
+	trendsByAweme := make(map[string][]*data.VideoTrend, len(awemeIDs))
+	for _, t := range trends {
+		trendsByAweme[t.AwemeId] = append(trendsByAweme[t.AwemeId], t)
+	}
+
+	resp := &v1.ListTrendingVideosResponse{
+		StartDate: strconv.Itoa(curStart),
+		EndDate:   strconv.Itoa(curEnd),
+	}
+	for _, g := range growth {
+		analytics := analyzeVideoTrend(g.AwemeId, metric, trendsByAweme[g.AwemeId])
+		if lifecycle != v1.VideoLifecycle_VIDEO_LIFECYCLE_UNKNOWN && analytics.Lifecycle != lifecycle {
+			continue
+		}
+		analytics.Points = nil
+		video := &v1.TrendingVideo{
+			AwemeId:       g.AwemeId,
+			CurrentValue:  g.Current,
+			PreviousValue: g.Previous,
+			Growth:        g.Current - g.Previous,
+			Analytics:     analytics,
+		}
+		if g.Previous != 0 {
+			video.GrowthRate = video.Growth / g.Previous
+		}
+		resp.Videos = append(resp.Videos, video)
+		if len(resp.Videos) >= limit {
+			break
+		}
+	}
+	return resp, nil
+}
+
+// analyzeVideoTrend 基于按日期升序的趋势数据计算每日增长、峰值、衰减率和生命周期
+func analyzeVideoTrend(awemeID, metric string, trends []*data.VideoTrend) *v1.VideoTrendAnalytics {
+	analytics := &v1.VideoTrendAnalytics{AwemeId: awemeID, Metric: metric}
+	if len(trends) == 0 {
+		return analytics
+	}
+
+	values := make([]float64, len(trends))
+	peak := 0
+	var cumulative float64
+	analytics.Points = make([]*v1.VideoTrendPoint, 0, len(trends))
+	for i, t := range trends {
+		values[i] = trendMetricValue(t, metric)
+		cumulative += values[i]
+		point := &v1.VideoTrendPoint{
+			DateCode:   int32(t.DateCode),
+			Value:      values[i],
+			Cumulative: cumulative,
+		}
+		if i > 0 && values[i-1] != 0 {
+			point.GrowthRate = (values[i] - values[i-1]) / values[i-1]
+		}
+		analytics.Points = append(analytics.Points, point)
+		if values[i] > values[peak] {
+			peak = i
+		}
+	}
+
+	last := len(trends) - 1
+	analytics.TotalValue = cumulative
+	analytics.CumulativeGmv = trends[last].SalesGmv
+	analytics.PeakDateCode = int32(trends[peak].DateCode)
+	analytics.PeakValue = values[peak]
+
+	peakDate, lastDate := strconv.Itoa(trends[peak].DateCode), strconv.Itoa(trends[last].DateCode)
+	days := daysBetween(peakDate, lastDate)
+	analytics.DaysSincePeak = int32(days)
+	if days > 0 && values[peak] > 0 {
+		if values[last] <= 0 {
+			analytics.DecayRate = 1
+		} else {
+			analytics.DecayRate = 1 - math.Pow(values[last]/values[peak], 1/float64(days))
+		}
+	}
+	analytics.Lifecycle = classifyLifecycle(values, peak)
+	return analytics
+}
+
+// classifyLifecycle 根据每日增量序列和峰值位置判定生命周期阶段
+func classifyLifecycle(values []float64, peak int) v1.VideoLifecycle {
+	if len(values) == 0 {
+		return v1.VideoLifecycle_VIDEO_LIFECYCLE_UNKNOWN
+	}
+	last := len(values) - 1
+	peakValue := values[peak]
+
+	recent := 0.0
+	for i := len(values) - 1; i >= 0 && i > last-lifecycleRecentDays; i-- {
+		recent = math.Max(recent, values[i])
+	}
+	if peakValue <= 0 || recent <= peakValue*lifecycleDeadRatio {
+		return v1.VideoLifecycle_VIDEO_LIFECYCLE_DEAD
+	}
+	if peak == last && (last == 0 || values[last] > values[last-1]) {
+		return v1.VideoLifecycle_VIDEO_LIFECYCLE_RISING
+	}
+	if values[last] >= peakValue*lifecyclePeakRatio {
+		return v1.VideoLifecycle_VIDEO_LIFECYCLE_PEAKING
+	}
+	return v1.VideoLifecycle_VIDEO_LIFECYCLE_DECAYING
+}
+
+// trendMetricValue 取趋势数据中某个指标的每日增量
+func trendMetricValue(t *data.VideoTrend, metric string) float64 {
+	switch metric {
+	case "sales_count":
+		return float64(t.IncSalesCount)
+	case "like_count":
+		return float64(t.IncLikeCount)
+	case "comment_count":
+		return float64(t.IncCommentCount)
+	case "share_count":
+		return float64(t.IncShareCount)
+	case "collect_count":
+		return float64(t.IncCollectCount)
+	default:
+		return t.IncSalesGmv
+	}
+}
+
+// normalizeTrendMetric 校验趋势指标，为空时默认 sales_gmv
+func normalizeTrendMetric(metric string) (string, error) {
+	if metric == "" {
+		return defaultTrendMetric, nil
+	}
+	if _, ok := data.VideoTrendMetrics[metric]; !ok {
+		return "", fmt.Errorf("不支持的趋势指标: %s", metric)
+	}
+	return metric, nil
+}
+
+// parseDateCodes 把 "20060102" 格式的起止日期转换为 date_code，为空时返回 0
+func parseDateCodes(startDate, endDate string) (start, end int, err error) {
+	if startDate != "" {
+		if start, err = strconv.Atoi(startDate); err != nil {
+			return 0, 0, fmt.Errorf("无效的开始日期: %s", startDate)
+		}
+	}
+	if endDate != "" {
+		if end, err = strconv.Atoi(endDate); err != nil {
+			return 0, 0, fmt.Errorf("无效的结束日期: %s", endDate)
+		}
+	}
+	return start, end, nil
+}
+
+// trendingWindow 解析增长榜的窗口，结束日期默认昨天，开始日期默认结束日期前推 trendingWindowDays-1 天
+func trendingWindow(startDate, endDate string) (start, end time.Time, err error) {
+	if endDate == "" {
+		yesterday := time.Now().AddDate(0, 0, -1)
+		end = time.Date(yesterday.Year(), yesterday.Month(), yesterday.Day(), 0, 0, 0, 0, time.UTC)
+	} else if end, err = time.Parse("20060102", endDate); err != nil {
+		return start, end, fmt.Errorf("无效的结束日期: %s", endDate)
+	}
+	if startDate == "" {
+		start = end.AddDate(0, 0, -(trendingWindowDays - 1))
+	} else if start, err = time.Parse("20060102", startDate); err != nil {
+		return start, end, fmt.Errorf("无效的开始日期: %s", startDate)
+	}
+	if start.After(end) {
+		return start, end, fmt.Errorf("开始日期不能晚于结束日期")
+	}
+	return start, end, nil
+}
+
+// dateCode 把日期转换为 video_trends.date_code 使用的 20060102 整数
+func dateCode(t time.Time) int {
+	code, _ := strconv.Atoi(t.Format("20060102"))
+	return code
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+const (
+	// defaultWatchRefreshMinutes 是未指定刷新间隔时关注对象的重新采集间隔
+	defaultWatchRefreshMinutes = 360
+	// minWatchRefreshMinutes 是允许的最短刷新间隔，避免对采集源造成过大压力
+	minWatchRefreshMinutes = 30
+	// maxWatchItemsPerRequest 是单次添加的最大对象数
+	maxWatchItemsPerRequest = 500
+)
+
+var watchEntityTypes = map[v1.WatchEntityType]string{
+	v1.WatchEntityType_WATCH_ENTITY_TYPE_VIDEO:   data.WatchEntityVideo,
+	v1.WatchEntityType_WATCH_ENTITY_TYPE_PRODUCT: data.WatchEntityProduct,
+	v1.WatchEntityType_WATCH_ENTITY_TYPE_BLOGGER: data.WatchEntityBlogger,
+}
+
+// WatchlistUsecase 管理关注对象，到期视频的优先采集由 worker 的详情采集任务完成
+type WatchlistUsecase struct {
+	repo data.WatchlistRepo
+}
+
+// NewWatchlistUsecase 构造 WatchlistUsecase
+func NewWatchlistUsecase(repo data.WatchlistRepo) *WatchlistUsecase {
+	return &WatchlistUsecase{repo: repo}
+}
+
+// AddItems 批量添加关注对象
+func (uc *WatchlistUsecase) AddItems(ctx context.Context, req *v1.AddWatchlistItemsRequest) (*v1.AddWatchlistItemsResponse, error) {
+	entityType, ok := watchEntityTypes[req.EntityType]
+	if !ok {
+		return nil, fmt.Errorf("不支持的关注对象类型: %v", req.EntityType)
+	}
+	ids := compactStrings(req.EntityIds)
+	if len(ids) == 0 {
+		return nil, errors.New("entity_ids 不能为空")
+	}
+	if len(ids) > maxWatchItemsPerRequest {
+		return nil, fmt.Errorf("单次最多添加 %d 个关注对象", maxWatchItemsPerRequest)
+	}
+	interval := int(req.RefreshIntervalMinutes)
+	if interval == 0 {
+		interval = defaultWatchRefreshMinutes
+	}
+	if interval < minWatchRefreshMinutes {
+		return nil, fmt.Errorf("刷新间隔不能小于 %d 分钟", minWatchRefreshMinutes)
+	}
+
+	items := make([]*data.WatchlistItem, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, &data.WatchlistItem{
+			EntityType:             entityType,
+			EntityId:               id,
+			RefreshIntervalMinutes: interval,
+			Note:                   strings.TrimSpace(req.Note),
+		})
+	}
+	if err := uc.repo.Upsert(ctx, items); err != nil {
+		return nil, err
+	}
+	resp := &v1.AddWatchlistItemsResponse{Items: make([]*v1.WatchlistItem, 0, len(items))}
+	for _, item := range items {
+		resp.Items = append(resp.Items, copyWatchlistItemToDTO(item))
+	}
+	return resp, nil
+}
+
+// RemoveItem 取消关注
+func (uc *WatchlistUsecase) RemoveItem(ctx context.Context, id uint64) error {
+	if err := uc.repo.Delete(ctx, uint(id)); err != nil {
+		if errors.Is(err, data.ErrNotFound) {
+			return fmt.Errorf("关注对象不存在: %d", id)
+		}
+		return err
+	}
+	return nil
+}
+
+// ListItems 分页查询关注对象
+func (uc *WatchlistUsecase) ListItems(ctx context.Context, page *v1.PageRequest, entityType v1.WatchEntityType) (*v1.ListWatchlistItemsResponse, error) {
+	var typ string
+	if entityType != v1.WatchEntityType_WATCH_ENTITY_TYPE_UNSPECIFIED {
+		var ok bool
+		if typ, ok = watchEntityTypes[entityType]; !ok {
+			return nil, fmt.Errorf("不支持的关注对象类型: %v", entityType)
+		}
+	}
+	items, pageResp, err := uc.repo.ListPage(ctx, page, typ)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListWatchlistItemsResponse{Page: pageResp, Items: make([]*v1.WatchlistItem, 0, len(items))}
+	for _, item := range items {
+		resp.Items = append(resp.Items, copyWatchlistItemToDTO(item))
+	}
+	return resp, nil
+}
+
+func copyWatchlistItemToDTO(item *data.WatchlistItem) *v1.WatchlistItem {
+	dto := &v1.WatchlistItem{
+		Id:                     uint64(item.ID),
+		EntityId:               item.EntityId,
+		RefreshIntervalMinutes: int32(item.RefreshIntervalMinutes),
+		Note:                   item.Note,
+		CreatedAt:              item.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:              item.UpdatedAt.Format(time.RFC3339),
+	}
+	for k, v := range watchEntityTypes {
+		if v == item.EntityType {
+			dto.EntityType = k
+		}
+	}
+	return dto
+}
@@ -27,6 +27,8 @@ type Bootstrap struct {
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Job           *Job                   `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"` // 新增此行
+	Reporting     *Reporting             `protobuf:"bytes,4,opt,name=reporting,proto3" json:"reporting,omitempty"`
+	Alerting      *Alerting              `protobuf:"bytes,5,opt,name=alerting,proto3" json:"alerting,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,6 +84,20 @@ func (x *Bootstrap) GetJob() *Job {
 	return nil
 }
 
+func (x *Bootstrap) GetReporting() *Reporting {
+	if x != nil {
+		return x.Reporting
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAlerting() *Alerting {
+	if x != nil {
+		return x.Alerting
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -139,6 +155,7 @@ type Data struct {
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
 	Datasources   []*DataSource          `protobuf:"bytes,4,rep,name=datasources,proto3" json:"datasources,omitempty"`
+	Export        *Data_Export           `protobuf:"bytes,5,opt,name=export,proto3" json:"export,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -194,6 +211,13 @@ func (x *Data) GetDatasources() []*DataSource {
 	return nil
 }
 
+func (x *Data) GetExport() *Data_Export {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
 type DataSource struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -425,6 +449,7 @@ func (x *Feigua) GetCookieContent() string {
 type Job struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	FetchVideoRankCron string                 `protobuf:"bytes,1,opt,name=fetch_video_rank_cron,json=fetchVideoRankCron,proto3" json:"fetch_video_rank_cron,omitempty"`
+	TrendRefresh       *TrendRefresh          `protobuf:"bytes,2,opt,name=trend_refresh,json=trendRefresh,proto3" json:"trend_refresh,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -466,6 +491,203 @@ func (x *Job) GetFetchVideoRankCron() string {
 	return ""
 }
 
+func (x *Job) GetTrendRefresh() *TrendRefresh {
+	if x != nil {
+		return x.TrendRefresh
+	}
+	return nil
+}
+
+// 已采集视频的总览/趋势重新采集策略：按发布时长逐步拉长刷新间隔，近期有销售增长的视频加快刷新，长期无增长的视频停止跟踪。
+// 未配置的字段使用默认值
+type TrendRefresh struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 关闭重新采集，只做首次采集
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// 默认：3 天内 6 小时，7 天内 12 小时，30 天内 24 小时
+	Tiers []*TrendRefresh_Tier `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// 超出所有分段时的刷新间隔，默认 72 小时
+	DefaultInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=default_interval,json=defaultInterval,proto3" json:"default_interval,omitempty"`
+	// 最近一天的趋势有新增销量或销售额时，刷新间隔乘以该系数，默认 0.5
+	GrowthFactor float64 `protobuf:"fixed64,4,opt,name=growth_factor,json=growthFactor,proto3" json:"growth_factor,omitempty"`
+	// 连续多少天没有新增销量、销售额和点赞后停止跟踪，默认 7
+	InactiveDays int32 `protobuf:"varint,5,opt,name=inactive_days,json=inactiveDays,proto3" json:"inactive_days,omitempty"`
+	// 发布超过该时长的视频不再跟踪，默认 90 天
+	MaxAge        *durationpb.Duration `protobuf:"bytes,6,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendRefresh) Reset() {
+	*x = TrendRefresh{}
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendRefresh) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendRefresh) ProtoMessage() {}
+
+func (x *TrendRefresh) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendRefresh.ProtoReflect.Descriptor instead.
+func (*TrendRefresh) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TrendRefresh) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *TrendRefresh) GetTiers() []*TrendRefresh_Tier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *TrendRefresh) GetDefaultInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DefaultInterval
+	}
+	return nil
+}
+
+func (x *TrendRefresh) GetGrowthFactor() float64 {
+	if x != nil {
+		return x.GrowthFactor
+	}
+	return 0
+}
+
+func (x *TrendRefresh) GetInactiveDays() int32 {
+	if x != nil {
+		return x.InactiveDays
+	}
+	return 0
+}
+
+func (x *TrendRefresh) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+// 定时报告配置：榜单处理完成后按定义生成报告，并推送到指定的 webhook
+type Reporting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Reporting_Webhook   `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	Reports       []*Reporting_Report    `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reporting) Reset() {
+	*x = Reporting{}
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reporting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reporting) ProtoMessage() {}
+
+func (x *Reporting) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reporting.ProtoReflect.Descriptor instead.
+func (*Reporting) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Reporting) GetWebhooks() []*Reporting_Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *Reporting) GetReports() []*Reporting_Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// 告警通知渠道配置，告警规则通过 name 引用
+type Alerting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifiers     []*Alerting_Notifier   `protobuf:"bytes,1,rep,name=notifiers,proto3" json:"notifiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alerting) Reset() {
+	*x = Alerting{}
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alerting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alerting) ProtoMessage() {}
+
+func (x *Alerting) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alerting.ProtoReflect.Descriptor instead.
+func (*Alerting) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Alerting) GetNotifiers() []*Alerting_Notifier {
+	if x != nil {
+		return x.Notifiers
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -477,7 +699,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -489,7 +711,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +759,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -549,7 +771,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +818,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +830,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	mi := &file_internal_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,7 +873,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -663,7 +885,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	mi := &file_internal_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,6 +936,70 @@ func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+// 数据导出配置
+type Data_Export struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 异步导出文件的存放目录，默认 ./exports
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// 同步流式导出的最长耗时，不受 server.http.timeout 限制，默认 30 分钟
+	StreamTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=stream_timeout,json=streamTimeout,proto3" json:"stream_timeout,omitempty"`
+	// 同时执行的异步导出任务数，默认 2
+	MaxRunningJobs int32 `protobuf:"varint,3,opt,name=max_running_jobs,json=maxRunningJobs,proto3" json:"max_running_jobs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Data_Export) Reset() {
+	*x = Data_Export{}
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Export) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Export) ProtoMessage() {}
+
+func (x *Data_Export) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Export.ProtoReflect.Descriptor instead.
+func (*Data_Export) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Export) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *Data_Export) GetStreamTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.StreamTimeout
+	}
+	return nil
+}
+
+func (x *Data_Export) GetMaxRunningJobs() int32 {
+	if x != nil {
+		return x.MaxRunningJobs
+	}
+	return 0
+}
+
 type DataSource_Headless struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -724,7 +1010,7 @@ type DataSource_Headless struct {
 
 func (x *DataSource_Headless) Reset() {
 	*x = DataSource_Headless{}
-	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -736,7 +1022,7 @@ func (x *DataSource_Headless) String() string {
 func (*DataSource_Headless) ProtoMessage() {}
 
 func (x *DataSource_Headless) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,16 +1052,443 @@ func (x *DataSource_Headless) GetUserAgent() string {
 	return ""
 }
 
+// 按发布时长分段的刷新间隔，发布时长不超过 max_age 的视频使用该段的 interval，按 max_age 升序匹配
+type TrendRefresh_Tier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxAge        *durationpb.Duration   `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendRefresh_Tier) Reset() {
+	*x = TrendRefresh_Tier{}
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendRefresh_Tier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendRefresh_Tier) ProtoMessage() {}
+
+func (x *TrendRefresh_Tier) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendRefresh_Tier.ProtoReflect.Descriptor instead.
+func (*TrendRefresh_Tier) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *TrendRefresh_Tier) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *TrendRefresh_Tier) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+// Webhook 推送目标
+type Reporting_Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 目标名称，供 Report.webhooks 引用
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// 消息格式：generic（默认）| dingtalk | feishu | wecom
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// 钉钉、飞书机器人的加签密钥，为空表示不加签
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// 附加的请求头，仅 generic 类型使用
+	Headers map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 单次推送超时，默认 10 秒
+	Timeout       *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reporting_Webhook) Reset() {
+	*x = Reporting_Webhook{}
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reporting_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reporting_Webhook) ProtoMessage() {}
+
+func (x *Reporting_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reporting_Webhook.ProtoReflect.Descriptor instead.
+func (*Reporting_Webhook) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *Reporting_Webhook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Reporting_Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Reporting_Webhook) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Reporting_Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Reporting_Webhook) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Reporting_Webhook) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Reporting_Report struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 报告名称，唯一，用于日志和手动触发
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 报告标题，为空时使用 name
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// 数据来源，目前仅支持 video_rank
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// 榜单周期：day | week | month，该周期的榜单处理完成后生成
+	Period string `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	// 取前多少条，默认 50
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 排序字段，同榜单列表接口的 sort_by，默认按名次
+	SortBy    string `protobuf:"bytes,6,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder string `protobuf:"bytes,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// 只统计该品类及其子品类，0 表示不限
+	CategoryId uint64 `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// 输出格式：markdown（默认）| html | csv
+	Format string `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
+	// 输出的列，为空时使用默认列
+	Columns []string `protobuf:"bytes,10,rep,name=columns,proto3" json:"columns,omitempty"`
+	// 推送目标名称
+	Webhooks      []string `protobuf:"bytes,11,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reporting_Report) Reset() {
+	*x = Reporting_Report{}
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reporting_Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reporting_Report) ProtoMessage() {}
+
+func (x *Reporting_Report) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reporting_Report.ProtoReflect.Descriptor instead.
+func (*Reporting_Report) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *Reporting_Report) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Reporting_Report) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Reporting_Report) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Reporting_Report) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Reporting_Report) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Reporting_Report) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *Reporting_Report) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *Reporting_Report) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Reporting_Report) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Reporting_Report) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Reporting_Report) GetWebhooks() []string {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type Alerting_Email struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SMTP 服务器地址 host:port，为空时只把邮件内容写入日志
+	SmtpAddr      string   `protobuf:"bytes,1,opt,name=smtp_addr,json=smtpAddr,proto3" json:"smtp_addr,omitempty"`
+	Username      string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	From          string   `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            []string `protobuf:"bytes,5,rep,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alerting_Email) Reset() {
+	*x = Alerting_Email{}
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alerting_Email) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alerting_Email) ProtoMessage() {}
+
+func (x *Alerting_Email) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alerting_Email.ProtoReflect.Descriptor instead.
+func (*Alerting_Email) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *Alerting_Email) GetSmtpAddr() string {
+	if x != nil {
+		return x.SmtpAddr
+	}
+	return ""
+}
+
+func (x *Alerting_Email) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Alerting_Email) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Alerting_Email) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Alerting_Email) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type Alerting_Notifier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 渠道类型：log | webhook | email
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// type=webhook 时的推送目标，其中的 name 字段不使用
+	Webhook *Reporting_Webhook `protobuf:"bytes,3,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// type=email 时的邮件配置
+	Email         *Alerting_Email `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alerting_Notifier) Reset() {
+	*x = Alerting_Notifier{}
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alerting_Notifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alerting_Notifier) ProtoMessage() {}
+
+func (x *Alerting_Notifier) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alerting_Notifier.ProtoReflect.Descriptor instead.
+func (*Alerting_Notifier) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *Alerting_Notifier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Alerting_Notifier) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Alerting_Notifier) GetWebhook() *Reporting_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *Alerting_Notifier) GetEmail() *Alerting_Email {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
 var File_internal_conf_conf_proto protoreflect.FileDescriptor
 
 const file_internal_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x18internal/conf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x80\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xe7\x01\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
-	"\x03job\x18\x03 \x01(\v2\x0f.kratos.api.JobR\x03job\"\xb8\x02\n" +
+	"\x03job\x18\x03 \x01(\v2\x0f.kratos.api.JobR\x03job\x123\n" +
+	"\treporting\x18\x04 \x01(\v2\x15.kratos.api.ReportingR\treporting\x120\n" +
+	"\balerting\x18\x05 \x01(\v2\x14.kratos.api.AlertingR\balerting\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -786,11 +1499,12 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xb3\x03\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xed\x04\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x128\n" +
-	"\vdatasources\x18\x04 \x03(\v2\x16.kratos.api.DataSourceR\vdatasources\x1a:\n" +
+	"\vdatasources\x18\x04 \x03(\v2\x16.kratos.api.DataSourceR\vdatasources\x12/\n" +
+	"\x06export\x18\x05 \x01(\v2\x17.kratos.api.Data.ExportR\x06export\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xcf\x01\n" +
@@ -799,7 +1513,11 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12<\n" +
 	"\fread_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"\x86\x03\n" +
+	"\rwrite_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\x86\x01\n" +
+	"\x06Export\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x12@\n" +
+	"\x0estream_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\rstreamTimeout\x12(\n" +
+	"\x10max_running_jobs\x18\x03 \x01(\x05R\x0emaxRunningJobs\"\x86\x03\n" +
 	"\n" +
 	"DataSource\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
@@ -828,9 +1546,61 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\x05proxy\x18\n" +
 	" \x01(\tR\x05proxy\x12\x18\n" +
 	"\atimeout\x18\v \x01(\x05R\atimeout\x12%\n" +
-	"\x0ecookie_content\x18\f \x01(\tR\rcookieContent\"8\n" +
+	"\x0ecookie_content\x18\f \x01(\tR\rcookieContent\"w\n" +
 	"\x03Job\x121\n" +
-	"\x15fetch_video_rank_cron\x18\x01 \x01(\tR\x12fetchVideoRankCronB\x1dZ\x1baresdata/internal/conf;confb\x06proto3"
+	"\x15fetch_video_rank_cron\x18\x01 \x01(\tR\x12fetchVideoRankCron\x12=\n" +
+	"\rtrend_refresh\x18\x02 \x01(\v2\x18.kratos.api.TrendRefreshR\ftrendRefresh\"\x96\x03\n" +
+	"\fTrendRefresh\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x123\n" +
+	"\x05tiers\x18\x02 \x03(\v2\x1d.kratos.api.TrendRefresh.TierR\x05tiers\x12D\n" +
+	"\x10default_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0fdefaultInterval\x12#\n" +
+	"\rgrowth_factor\x18\x04 \x01(\x01R\fgrowthFactor\x12#\n" +
+	"\rinactive_days\x18\x05 \x01(\x05R\finactiveDays\x122\n" +
+	"\amax_age\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x1aq\n" +
+	"\x04Tier\x122\n" +
+	"\amax_age\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\"\xb5\x05\n" +
+	"\tReporting\x129\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x1d.kratos.api.Reporting.WebhookR\bwebhooks\x126\n" +
+	"\areports\x18\x02 \x03(\v2\x1c.kratos.api.Reporting.ReportR\areports\x1a\x92\x02\n" +
+	"\aWebhook\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12D\n" +
+	"\aheaders\x18\x05 \x03(\v2*.kratos.api.Reporting.Webhook.HeadersEntryR\aheaders\x123\n" +
+	"\atimeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x9f\x02\n" +
+	"\x06Report\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\tR\x06period\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x17\n" +
+	"\asort_by\x18\x06 \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\tR\tsortOrder\x12\x1f\n" +
+	"\vcategory_id\x18\b \x01(\x04R\n" +
+	"categoryId\x12\x16\n" +
+	"\x06format\x18\t \x01(\tR\x06format\x12\x18\n" +
+	"\acolumns\x18\n" +
+	" \x03(\tR\acolumns\x12\x1a\n" +
+	"\bwebhooks\x18\v \x03(\tR\bwebhooks\"\xea\x02\n" +
+	"\bAlerting\x12;\n" +
+	"\tnotifiers\x18\x01 \x03(\v2\x1d.kratos.api.Alerting.NotifierR\tnotifiers\x1a\x80\x01\n" +
+	"\x05Email\x12\x1b\n" +
+	"\tsmtp_addr\x18\x01 \x01(\tR\bsmtpAddr\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x03(\tR\x02to\x1a\x9d\x01\n" +
+	"\bNotifier\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x127\n" +
+	"\awebhook\x18\x03 \x01(\v2\x1d.kratos.api.Reporting.WebhookR\awebhook\x120\n" +
+	"\x05email\x18\x04 \x01(\v2\x1a.kratos.api.Alerting.EmailR\x05emailB\x1dZ\x1baresdata/internal/conf;confb\x06proto3"
 
 var (
 	file_internal_conf_conf_proto_rawDescOnce sync.Once
@@ -844,7 +1614,7 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_internal_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -852,32 +1622,59 @@ var file_internal_conf_conf_proto_goTypes = []any{
 	(*DataSource)(nil),          // 3: kratos.api.DataSource
 	(*Feigua)(nil),              // 4: kratos.api.Feigua
 	(*Job)(nil),                 // 5: kratos.api.Job
-	(*Server_HTTP)(nil),         // 6: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 7: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 8: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 9: kratos.api.Data.Redis
-	(*DataSource_Headless)(nil), // 10: kratos.api.DataSource.Headless
-	(*durationpb.Duration)(nil), // 11: google.protobuf.Duration
+	(*TrendRefresh)(nil),        // 6: kratos.api.TrendRefresh
+	(*Reporting)(nil),           // 7: kratos.api.Reporting
+	(*Alerting)(nil),            // 8: kratos.api.Alerting
+	(*Server_HTTP)(nil),         // 9: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 10: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 11: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 12: kratos.api.Data.Redis
+	(*Data_Export)(nil),         // 13: kratos.api.Data.Export
+	(*DataSource_Headless)(nil), // 14: kratos.api.DataSource.Headless
+	(*TrendRefresh_Tier)(nil),   // 15: kratos.api.TrendRefresh.Tier
+	(*Reporting_Webhook)(nil),   // 16: kratos.api.Reporting.Webhook
+	(*Reporting_Report)(nil),    // 17: kratos.api.Reporting.Report
+	nil,                         // 18: kratos.api.Reporting.Webhook.HeadersEntry
+	(*Alerting_Email)(nil),      // 19: kratos.api.Alerting.Email
+	(*Alerting_Notifier)(nil),   // 20: kratos.api.Alerting.Notifier
+	(*durationpb.Duration)(nil), // 21: google.protobuf.Duration
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	5,  // 2: kratos.api.Bootstrap.job:type_name -> kratos.api.Job
-	6,  // 3: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	7,  // 4: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	8,  // 5: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	9,  // 6: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	3,  // 7: kratos.api.Data.datasources:type_name -> kratos.api.DataSource
-	10, // 8: kratos.api.DataSource.headless:type_name -> kratos.api.DataSource.Headless
-	11, // 9: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	11, // 10: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	11, // 11: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	11, // 12: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	7,  // 3: kratos.api.Bootstrap.reporting:type_name -> kratos.api.Reporting
+	8,  // 4: kratos.api.Bootstrap.alerting:type_name -> kratos.api.Alerting
+	9,  // 5: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	10, // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	11, // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	12, // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	3,  // 9: kratos.api.Data.datasources:type_name -> kratos.api.DataSource
+	13, // 10: kratos.api.Data.export:type_name -> kratos.api.Data.Export
+	14, // 11: kratos.api.DataSource.headless:type_name -> kratos.api.DataSource.Headless
+	6,  // 12: kratos.api.Job.trend_refresh:type_name -> kratos.api.TrendRefresh
+	15, // 13: kratos.api.TrendRefresh.tiers:type_name -> kratos.api.TrendRefresh.Tier
+	21, // 14: kratos.api.TrendRefresh.default_interval:type_name -> google.protobuf.Duration
+	21, // 15: kratos.api.TrendRefresh.max_age:type_name -> google.protobuf.Duration
+	16, // 16: kratos.api.Reporting.webhooks:type_name -> kratos.api.Reporting.Webhook
+	17, // 17: kratos.api.Reporting.reports:type_name -> kratos.api.Reporting.Report
+	20, // 18: kratos.api.Alerting.notifiers:type_name -> kratos.api.Alerting.Notifier
+	21, // 19: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	21, // 20: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	21, // 21: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	21, // 22: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	21, // 23: kratos.api.Data.Export.stream_timeout:type_name -> google.protobuf.Duration
+	21, // 24: kratos.api.TrendRefresh.Tier.max_age:type_name -> google.protobuf.Duration
+	21, // 25: kratos.api.TrendRefresh.Tier.interval:type_name -> google.protobuf.Duration
+	18, // 26: kratos.api.Reporting.Webhook.headers:type_name -> kratos.api.Reporting.Webhook.HeadersEntry
+	21, // 27: kratos.api.Reporting.Webhook.timeout:type_name -> google.protobuf.Duration
+	16, // 28: kratos.api.Alerting.Notifier.webhook:type_name -> kratos.api.Reporting.Webhook
+	19, // 29: kratos.api.Alerting.Notifier.email:type_name -> kratos.api.Alerting.Email
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -891,7 +1688,7 @@ func file_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
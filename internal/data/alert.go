@@ -0,0 +1,279 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 告警规则类型
+const (
+	AlertRuleKindProductRankVideos = "product_rank_videos"
+	AlertRuleKindBloggerFansGrowth = "blogger_fans_growth"
+	AlertRuleKindVideoIncSalesGmv  = "video_inc_sales_gmv"
+)
+
+// 告警通知状态
+const (
+	AlertNotifyStatusPending = "pending"
+	AlertNotifyStatusSent    = "sent"
+	AlertNotifyStatusFailed  = "failed"
+)
+
+// alertCandidateLimit 是单条规则一次评估最多产生的告警数，按实际值从大到小截取
+const alertCandidateLimit = 1000
+
+// alertNotifyMaxAttempts 是一条告警最多的通知次数，超过后不再重试
+const alertNotifyMaxAttempts = 5
+
+// AlertRule 告警规则，Threshold 的含义由 Kind 决定
+type AlertRule struct {
+	ID         uint      `gorm:"primaryKey"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime;type:timestamp"`
+	Name       string    `gorm:"size:255;not null"`
+	Kind       string    `gorm:"size:64;not null"`
+	Threshold  float64   `gorm:"not null;default:0"`
+	SubjectIds []string  `gorm:"type:text;serializer:json;comment:只评估这些对象，为空表示全部"`
+	Notifiers  []string  `gorm:"type:text;serializer:json;comment:通知渠道名称"`
+	Enabled    bool      `gorm:"not null;default:false;index"`
+}
+
+func (AlertRule) TableName() string {
+	return "alert_rules"
+}
+
+// AlertEvent 告警记录，RuleName 和 Threshold 记录触发时的规则快照。
+// uk_alert_event 保证同一规则对同一对象在同一数据日期只告警一次，
+// 通知未成功的记录按 NotifyStatus 和 NotifyAttempts 在后续评估时重试
+type AlertEvent struct {
+	ID             uint      `gorm:"primaryKey"`
+	CreatedAt      time.Time `gorm:"autoCreateTime;type:timestamp"`
+	RuleId         uint      `gorm:"not null;uniqueIndex:uk_alert_event,priority:1"`
+	RuleName       string    `gorm:"size:255;not null;default:''"`
+	Kind           string    `gorm:"size:64;not null"`
+	SubjectId      string    `gorm:"size:255;not null;uniqueIndex:uk_alert_event,priority:2"`
+	SubjectName    string    `gorm:"type:text;not null;default:''"`
+	DateCode       string    `gorm:"size:8;not null;uniqueIndex:uk_alert_event,priority:3;comment:数据日期 YYYYMMDD"`
+	Value          float64   `gorm:"not null;default:0"`
+	Threshold      float64   `gorm:"not null;default:0"`
+	Message        string    `gorm:"type:text;not null;default:''"`
+	NotifyStatus   string    `gorm:"size:16;not null;index"`
+	NotifyError    string    `gorm:"type:text;not null;default:''"`
+	NotifyAttempts int       `gorm:"not null;default:0;comment:已尝试通知的次数"`
+}
+
+func (AlertEvent) TableName() string {
+	return "alert_events"
+}
+
+// AlertCandidate 是规则评估命中的一个对象
+type AlertCandidate struct {
+	SubjectId   string
+	SubjectName string
+	Value       float64
+}
+
+// AlertRepo 管理告警规则和告警记录，并按规则类型查询命中的对象
+type AlertRepo interface {
+	CreateRule(ctx context.Context, rule *AlertRule) error
+	// UpdateRule 按 ID 覆盖规则的全部字段，规则不存在时返回 ErrNotFound
+	UpdateRule(ctx context.Context, rule *AlertRule) error
+	// DeleteRule 删除规则，规则不存在时返回 ErrNotFound
+	DeleteRule(ctx context.Context, id uint) error
+	// GetRule 查询单条规则，不存在时返回 ErrNotFound
+	GetRule(ctx context.Context, id uint) (*AlertRule, error)
+	ListRulesPage(ctx context.Context, page *v1.PageRequest) ([]*AlertRule, *v1.PageResponse, error)
+	ListEnabledRules(ctx context.Context) ([]*AlertRule, error)
+	// ListEventsPage 按触发时间倒序分页查询告警记录，ruleID 为 0 表示全部
+	ListEventsPage(ctx context.Context, page *v1.PageRequest, ruleID uint) ([]*AlertEvent, *v1.PageResponse, error)
+
+	// LatestDataDate 查询某类规则数据来源的最新日期（YYYYMMDD），没有数据时返回空串
+	LatestDataDate(ctx context.Context, kind string) (string, error)
+	// Evaluate 查询规则在 dateCode 当天命中的对象
+	Evaluate(ctx context.Context, rule *AlertRule, dateCode string) ([]*AlertCandidate, error)
+	// CreateEvent 写入告警记录，同一规则、对象、日期已有记录时不写入并返回 false
+	CreateEvent(ctx context.Context, event *AlertEvent) (bool, error)
+	// UpdateEventNotify 记录一次通知的结果，并累加通知次数
+	UpdateEventNotify(ctx context.Context, id uint, status, notifyError string) error
+	// ListUnsentEvents 按 ID 升序返回未发送或发送失败、且通知次数未达上限的告警记录
+	ListUnsentEvents(ctx context.Context, limit int) ([]*AlertEvent, error)
+}
+
+type alertRepo struct {
+	*Data
+}
+
+// NewAlertRepo .
+func NewAlertRepo(data *Data) AlertRepo {
+	return &alertRepo{Data: data}
+}
+
+func (r *alertRepo) CreateRule(ctx context.Context, rule *AlertRule) error {
+	return r.db.WithContext(ctx).Create(rule).Error
+}
+
+func (r *alertRepo) UpdateRule(ctx context.Context, rule *AlertRule) error {
+	result := r.db.WithContext(ctx).Model(&AlertRule{ID: rule.ID}).
+		Select("name", "kind", "threshold", "subject_ids", "notifiers", "enabled", "updated_at").
+		Updates(rule)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *alertRepo) DeleteRule(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&AlertRule{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *alertRepo) GetRule(ctx context.Context, id uint) (*AlertRule, error) {
+	var rule AlertRule
+	if err := r.db.WithContext(ctx).First(&rule, id).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &rule, nil
+}
+
+func (r *alertRepo) ListRulesPage(ctx context.Context, page *v1.PageRequest) ([]*AlertRule, *v1.PageResponse, error) {
+	var rules []*AlertRule
+	pageResp, err := newQueryBuilder(r.db.WithContext(ctx).Model(&AlertRule{}), "id").
+		Sort("", v1.SortOrder_DESC, nil, "id", true).
+		Page(page, &rules)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rules, pageResp, nil
+}
+
+func (r *alertRepo) ListEnabledRules(ctx context.Context) ([]*AlertRule, error) {
+	var rules []*AlertRule
+	err := r.db.WithContext(ctx).Where("enabled = ?", true).Order("id").Find(&rules).Error
+	return rules, err
+}
+
+func (r *alertRepo) ListEventsPage(ctx context.Context, page *v1.PageRequest, ruleID uint) ([]*AlertEvent, *v1.PageResponse, error) {
+	var events []*AlertEvent
+	pageResp, err := newQueryBuilder(r.db.WithContext(ctx).Model(&AlertEvent{}), "id").
+		Eq("rule_id", ruleID).
+		Sort("", v1.SortOrder_DESC, nil, "id", true).
+		Page(page, &events)
+	if err != nil {
+		return nil, nil, err
+	}
+	return events, pageResp, nil
+}
+
+func (r *alertRepo) LatestDataDate(ctx context.Context, kind string) (string, error) {
+	db := r.db.WithContext(ctx)
+	var date string
+	var err error
+	switch kind {
+	case AlertRuleKindProductRankVideos:
+		err = db.Model(&VideoRank{}).Where("period_type = ?", RankPeriodDay).
+			Select("COALESCE(MAX(rank_date), '')").Scan(&date).Error
+	case AlertRuleKindBloggerFansGrowth:
+		err = db.Model(&BloggerSnapshot{}).Select("COALESCE(MAX(snapshot_date), '')").Scan(&date).Error
+	case AlertRuleKindVideoIncSalesGmv:
+		var dateCode int
+		err = db.Model(&VideoTrend{}).Select("COALESCE(MAX(date_code), 0)").Scan(&dateCode).Error
+		if dateCode > 0 {
+			date = strconv.Itoa(dateCode)
+		}
+	default:
+		return "", fmt.Errorf("未知的告警规则类型: %s", kind)
+	}
+	return date, err
+}
+
+func (r *alertRepo) Evaluate(ctx context.Context, rule *AlertRule, dateCode string) ([]*AlertCandidate, error) {
+	db := r.db.WithContext(ctx)
+	var q *gorm.DB
+	switch rule.Kind {
+	case AlertRuleKindProductRankVideos:
+		q = db.Table("video_ranks").
+			Select("goods_id AS subject_id, MAX(goods_title) AS subject_name, COUNT(DISTINCT aweme_id) AS value").
+			Where("period_type = ? AND rank_date = ? AND goods_id <> ''", RankPeriodDay, dateCode).
+			Group("goods_id").
+			Having("COUNT(DISTINCT aweme_id) >= ?", rule.Threshold)
+		if len(rule.SubjectIds) > 0 {
+			q = q.Where("goods_id IN ?", rule.SubjectIds)
+		}
+	case AlertRuleKindBloggerFansGrowth:
+		d, err := time.Parse("20060102", dateCode)
+		if err != nil {
+			return nil, fmt.Errorf("无效的日期 %q: %w", dateCode, err)
+		}
+		growth := "(cur.fans_num - prev.fans_num) * 100.0 / prev.fans_num"
+		q = db.Table("blogger_snapshots AS cur").
+			Select("CAST(cur.blogger_id AS TEXT) AS subject_id, COALESCE(b.blogger_name, '') AS subject_name, "+growth+" AS value").
+			Joins("JOIN blogger_snapshots AS prev ON prev.blogger_id = cur.blogger_id AND prev.snapshot_date = ?", d.AddDate(0, 0, -1).Format("20060102")).
+			Joins("LEFT JOIN bloggers AS b ON b.blogger_id = cur.blogger_id").
+			Where("cur.snapshot_date = ? AND prev.fans_num > 0", dateCode).
+			Where(growth+" > ?", rule.Threshold)
+		if len(rule.SubjectIds) > 0 {
+			q = q.Where("CAST(cur.blogger_id AS TEXT) IN ?", rule.SubjectIds)
+		}
+	case AlertRuleKindVideoIncSalesGmv:
+		dc, err := strconv.Atoi(dateCode)
+		if err != nil {
+			return nil, fmt.Errorf("无效的日期 %q: %w", dateCode, err)
+		}
+		// (aweme_id, date_code) 唯一，每个视频每天只有一条趋势数据
+		q = db.Table("video_trends AS t").
+			Select("t.aweme_id AS subject_id, COALESCE(v.aweme_desc, '') AS subject_name, t.inc_sales_gmv AS value").
+			Joins("LEFT JOIN videos AS v ON v.aweme_id = t.aweme_id").
+			Where("t.date_code = ? AND t.inc_sales_gmv > ?", dc, rule.Threshold)
+		if len(rule.SubjectIds) > 0 {
+			q = q.Where("t.aweme_id IN ?", rule.SubjectIds)
+		}
+	default:
+		return nil, fmt.Errorf("未知的告警规则类型: %s", rule.Kind)
+	}
+
+	var candidates []*AlertCandidate
+	err := q.Order("value DESC").Limit(alertCandidateLimit).Scan(&candidates).Error
+	return candidates, err
+}
+
+func (r *alertRepo) CreateEvent(ctx context.Context, event *AlertEvent) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "rule_id"}, {Name: "subject_id"}, {Name: "date_code"}},
+		DoNothing: true,
+	}).Create(event)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *alertRepo) UpdateEventNotify(ctx context.Context, id uint, status, notifyError string) error {
+	return r.db.WithContext(ctx).Model(&AlertEvent{ID: id}).Updates(map[string]any{
+		"notify_status":   status,
+		"notify_error":    notifyError,
+		"notify_attempts": gorm.Expr("notify_attempts + 1"),
+	}).Error
+}
+
+func (r *alertRepo) ListUnsentEvents(ctx context.Context, limit int) ([]*AlertEvent, error) {
+	var events []*AlertEvent
+	err := r.db.WithContext(ctx).
+		Where("notify_status IN ? AND notify_attempts < ?",
+			[]string{AlertNotifyStatusPending, AlertNotifyStatusFailed}, alertNotifyMaxAttempts).
+		Order("id").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
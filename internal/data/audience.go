@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 画像对象类型
+const (
+	AudienceSubjectVideo   = "video"
+	AudienceSubjectBlogger = "blogger"
+)
+
+// 画像维度
+const (
+	AudienceDimensionGender   = "gender"
+	AudienceDimensionAge      = "age"
+	AudienceDimensionProvince = "province"
+	AudienceDimensionCity     = "city"
+)
+
+// AudienceProfile 视频观众或博主粉丝画像的表头，(subject_type, subject_id) 唯一，
+// 各维度的分布见 AudienceShare
+type AudienceProfile struct {
+	ID          uint      `gorm:"primaryKey"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime;type:timestamp"`
+	SubjectType string    `gorm:"size:16;not null;uniqueIndex:uk_audience_profile_subject,priority:1"`
+	SubjectId   string    `gorm:"size:255;not null;uniqueIndex:uk_audience_profile_subject,priority:2"`
+}
+
+func (AudienceProfile) TableName() string {
+	return "audience_profiles"
+}
+
+// AudienceShare 画像某个维度下一个分组的占比，每次采集整体替换该画像的全部分组
+type AudienceShare struct {
+	ID        uint    `gorm:"primaryKey"`
+	ProfileId uint    `gorm:"not null;uniqueIndex:uk_audience_share,priority:1"`
+	Dimension string  `gorm:"size:16;not null;uniqueIndex:uk_audience_share,priority:2"`
+	Bucket    string  `gorm:"size:64;not null;uniqueIndex:uk_audience_share,priority:3"`
+	Share     float64 `gorm:"not null;default:0;comment:占比(%)"`
+}
+
+func (AudienceShare) TableName() string {
+	return "audience_shares"
+}
+
+// audienceBucketJSON 是 videos.audience_profile_json 中的分组
+type audienceBucketJSON struct {
+	Bucket string  `json:"bucket"`
+	Share  float64 `json:"share"`
+}
+
+// AudienceRepo 管理视频和博主的画像
+type AudienceRepo interface {
+	// ReplaceProfile 整体替换对象的画像分布，视频的画像同时写入 videos.audience_profile_json
+	ReplaceProfile(ctx context.Context, subjectType, subjectId string, shares []*AudienceShare) error
+	// GetProfile 返回对象的画像及其分布，分布按维度、占比倒序排列；未采集过时返回 ErrNotFound
+	GetProfile(ctx context.Context, subjectType, subjectId string) (*AudienceProfile, []*AudienceShare, error)
+	// FindVideosForCollection 查找已采集过详情、interval 内没有采集过画像的视频，销售额高的优先
+	FindVideosForCollection(ctx context.Context, interval time.Duration, limit int) ([]string, error)
+	// FindBloggersForCollection 查找 interval 内没有采集过画像的博主，粉丝多的优先
+	FindBloggersForCollection(ctx context.Context, interval time.Duration, limit int) ([]int64, error)
+}
+
+type audienceRepo struct {
+	*Data
+}
+
+// NewAudienceRepo .
+func NewAudienceRepo(data *Data) AudienceRepo {
+	return &audienceRepo{Data: data}
+}
+
+func (r *audienceRepo) ReplaceProfile(ctx context.Context, subjectType, subjectId string, shares []*AudienceShare) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		profile := &AudienceProfile{SubjectType: subjectType, SubjectId: subjectId}
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subject_type"}, {Name: "subject_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
+		}, clause.Returning{}).Create(profile).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("profile_id = ?", profile.ID).Delete(&AudienceShare{}).Error; err != nil {
+			return err
+		}
+		if len(shares) > 0 {
+			for _, s := range shares {
+				s.ID, s.ProfileId = 0, profile.ID
+			}
+			if err := tx.Create(shares).Error; err != nil {
+				return err
+			}
+		}
+		if subjectType != AudienceSubjectVideo {
+			return nil
+		}
+
+		distributions := make(map[string][]audienceBucketJSON)
+		for _, s := range shares {
+			distributions[s.Dimension] = append(distributions[s.Dimension], audienceBucketJSON{Bucket: s.Bucket, Share: s.Share})
+		}
+		profileJSON, err := json.Marshal(distributions)
+		if err != nil {
+			return fmt.Errorf("序列化观众画像失败: %w", err)
+		}
+		return tx.Model(&Video{}).Where("aweme_id = ?", subjectId).
+			UpdateColumn("audience_profile_json", string(profileJSON)).Error
+	})
+}
+
+func (r *audienceRepo) GetProfile(ctx context.Context, subjectType, subjectId string) (*AudienceProfile, []*AudienceShare, error) {
+	var profile AudienceProfile
+	err := r.db.WithContext(ctx).
+		Where("subject_type = ? AND subject_id = ?", subjectType, subjectId).
+		First(&profile).Error
+	if err != nil {
+		return nil, nil, wrapNotFound(err)
+	}
+	var shares []*AudienceShare
+	err = r.db.WithContext(ctx).
+		Where("profile_id = ?", profile.ID).
+		Order("dimension").Order("share DESC").Order("id").
+		Find(&shares).Error
+	if err != nil {
+		return nil, nil, err
+	}
+	return &profile, shares, nil
+}
+
+func (r *audienceRepo) FindVideosForCollection(ctx context.Context, interval time.Duration, limit int) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Model(&Video{}).
+		Where("trend_updated_at IS NOT NULL").
+		Where("NOT EXISTS (SELECT 1 FROM source_data s WHERE s.entity_id = videos.aweme_id AND s.data_type = ? AND s.fetched_at > ?)",
+			DataTypeVideoAudience, time.Now().Add(-interval)).
+		Order("sales_gmv_high DESC").
+		Order("aweme_id").
+		Limit(limit).
+		Pluck("aweme_id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询待采集观众画像的视频失败: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *audienceRepo) FindBloggersForCollection(ctx context.Context, interval time.Duration, limit int) ([]int64, error) {
+	var ids []int64
+	err := r.db.WithContext(ctx).Model(&Blogger{}).
+		Where("NOT EXISTS (SELECT 1 FROM source_data s WHERE s.entity_id = CAST(bloggers.blogger_id AS TEXT) AND s.data_type = ? AND s.fetched_at > ?)",
+			DataTypeBloggerAudience, time.Now().Add(-interval)).
+		Order("blogger_fans_num DESC").
+		Order("blogger_id").
+		Limit(limit).
+		Pluck("blogger_id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询待采集粉丝画像的博主失败: %w", err)
+	}
+	return ids, nil
+}
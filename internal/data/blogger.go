@@ -26,8 +26,21 @@ func (Blogger) TableName() string {
 
 type BloggerRepo interface {
 	Upsert(ctx context.Context, blogger *Blogger) error
-	ListPage(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*Blogger, int64, error)
-	Get(ctx context.Context, bloggerId int64) (*Blogger, error) // 新增此行
+	ListPage(ctx context.Context, page *v1.PageRequest, query string, filter *v1.BloggerFilter, sortBy string, sortOrder v1.SortOrder) ([]*Blogger, *v1.PageResponse, error)
+	// Get 按 blogger_id 查询博主，不存在时返回 ErrNotFound
+	Get(ctx context.Context, bloggerId int64) (*Blogger, error)
+
+	// 以下为博主画像查询，日期格式为 "20060102"
+	ListVideos(ctx context.Context, bloggerID int64, limit int) ([]*Video, int64, error)
+	ListPromotedProducts(ctx context.Context, bloggerID int64, periodType, startDate, endDate string) ([]*BloggerProduct, error)
+	GetRankStats(ctx context.Context, bloggerID int64, periodType, startDate, endDate string) (*BloggerRankStats, error)
+	ListFansFromTrends(ctx context.Context, bloggerID int64, startDate, endDate string) ([]*BloggerFansPoint, error)
+	ListFansFromRanks(ctx context.Context, bloggerID int64, periodType, startDate, endDate string) ([]*BloggerFansPoint, error)
+
+	// UpsertSnapshots 写入博主每日快照
+	UpsertSnapshots(ctx context.Context, snapshots []*BloggerSnapshot) error
+	// ListSnapshots 查询博主的每日快照
+	ListSnapshots(ctx context.Context, bloggerID int64, startDate, endDate string) ([]*BloggerSnapshot, error)
 }
 
 type bloggerRepo struct {
@@ -54,47 +67,36 @@ func allBloggerColumnsFromRank() []string {
 	}
 }
 
-// ListPage 实现分页、模糊查询和排序
-func (r *bloggerRepo) ListPage(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*Blogger, int64, error) {
-	var bloggers []*Blogger
-	var total int64
-
-	db := r.db.WithContext(ctx).Model(&Blogger{})
+// bloggerSortFields 是博主列表的排序白名单
+var bloggerSortFields = SortFields{
+	"blogger_fans_num": "blogger_fans_num",
+	"created_at":       "created_at",
+	"updated_at":       "updated_at",
+}
 
-	// 模糊查询
-	if query != "" {
-		db = db.Where("blogger_name LIKE ?", "%"+query+"%")
-	}
+// ListPage 实现分页、模糊查询、结构化过滤和白名单排序
+func (r *bloggerRepo) ListPage(ctx context.Context, page *v1.PageRequest, query string, filter *v1.BloggerFilter, sortBy string, sortOrder v1.SortOrder) ([]*Blogger, *v1.PageResponse, error) {
+	var bloggers []*Blogger
 
-	// 获取总数
-	if err := db.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
+	qb := newQueryBuilder(r.db.WithContext(ctx).Model(&Blogger{}), "blogger_id").
+		Like("blogger_name", query)
+	applyBloggerFilter(qb, filter)
 
-	// 排序
-	if sortBy != "" {
-		var order string
-		switch sortOrder {
-		case v1.SortOrder_ASC:
-			order = sortBy + " ASC"
-		case v1.SortOrder_DESC:
-			order = sortBy + " DESC"
-		default:
-			order = sortBy + " DESC"
-		}
-		db = db.Order(order)
-	} else {
-		// 默认排序
-		db = db.Order("updated_at DESC")
+	pageResp, err := qb.Sort(sortBy, sortOrder, bloggerSortFields, "updated_at", true).
+		Page(page, &bloggers)
+	if err != nil {
+		return nil, nil, err
 	}
+	return bloggers, pageResp, nil
+}
 
-	// 分页
-	offset := (page - 1) * size
-	if err := db.Offset(offset).Limit(size).Find(&bloggers).Error; err != nil {
-		return nil, 0, err
+// applyBloggerFilter 把博主的结构化过滤条件追加到 qb，列表和搜索共用
+func applyBloggerFilter(qb *queryBuilder, filter *v1.BloggerFilter) {
+	if filter == nil {
+		return
 	}
-
-	return bloggers, total, nil
+	qb.Int64Range("blogger_fans_num", filter.FansNum).
+		Eq("blogger_tag", filter.Tag)
 }
 
 // CopyBloggerToDTO 将 data.Blogger 模型转换为 v1.BloggerDTO
@@ -118,7 +120,7 @@ func CopyBloggerToDTO(b *Blogger) *v1.BloggerDTO {
 func (r *bloggerRepo) Get(ctx context.Context, bloggerId int64) (*Blogger, error) {
 	var blogger Blogger
 	if err := r.db.WithContext(ctx).Where("blogger_id = ?", bloggerId).First(&blogger).Error; err != nil {
-		return nil, err
+		return nil, wrapNotFound(err)
 	}
 	return &blogger, nil
 }
@@ -0,0 +1,156 @@
+package data
+
+import (
+	"context"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// BloggerProduct 是博主带货的某个商品在榜单上的汇总
+type BloggerProduct struct {
+	GoodsId         string
+	GoodsTitle      string
+	GoodsCoverUrl   string
+	BrandName       string
+	VideoCount      int64
+	RankAppearances int64
+	SalesCountLow   int64
+	SalesCountHigh  int64
+	TotalSalesLow   int64
+	TotalSalesHigh  int64
+}
+
+// BloggerRankStats 是博主在某周期榜单上的上榜汇总
+type BloggerRankStats struct {
+	Appearances    int64
+	VideoCount     int64
+	RankDates      int64
+	BestPosition   int
+	FirstSeenDate  string
+	LastSeenDate   string
+	SalesCountLow  int64
+	SalesCountHigh int64
+	TotalSalesLow  int64
+	TotalSalesHigh int64
+}
+
+// BloggerFansPoint 是博主某天的粉丝数，Date 格式为 "20060102"
+type BloggerFansPoint struct {
+	Date string
+	Fans int64
+}
+
+// bloggerRankScope 限定某个博主在某周期、某日期范围内的榜单记录，日期为空时不限制
+func (r *bloggerRepo) bloggerRankScope(ctx context.Context, bloggerID int64, periodType, startDate, endDate string) *gorm.DB {
+	db := r.db.WithContext(ctx).Model(&VideoRank{}).
+		Where("blogger_id = ? AND period_type = ?", bloggerID, periodType)
+	if startDate != "" {
+		db = db.Where("rank_date >= ?", startDate)
+	}
+	if endDate != "" {
+		db = db.Where("rank_date <= ?", endDate)
+	}
+	return db
+}
+
+// ListVideos 按发布时间倒序返回博主最近的 limit 个作品，以及作品总数
+func (r *bloggerRepo) ListVideos(ctx context.Context, bloggerID int64, limit int) ([]*Video, int64, error) {
+	db := r.db.WithContext(ctx).Model(&Video{}).Where("blogger_id = ?", bloggerID)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var videos []*Video
+	if err := db.Order("aweme_pub_time DESC, aweme_id DESC").Limit(limit).Find(&videos).Error; err != nil {
+		return nil, 0, err
+	}
+	return videos, total, nil
+}
+
+// ListPromotedProducts 按累计销售额范围高值倒序返回博主带货并上榜的商品
+func (r *bloggerRepo) ListPromotedProducts(ctx context.Context, bloggerID int64, periodType, startDate, endDate string) ([]*BloggerProduct, error) {
+	var rows []*BloggerProduct
+	err := r.bloggerRankScope(ctx, bloggerID, periodType, startDate, endDate).
+		Select(`goods_id,
+			MAX(goods_title) AS goods_title,
+			MAX(goods_cover_url) AS goods_cover_url,
+			MAX(brand_name) AS brand_name,
+			COUNT(DISTINCT aweme_id) AS video_count,
+			COUNT(*) AS rank_appearances,
+			SUM(sales_count_low) AS sales_count_low,
+			SUM(sales_count_high) AS sales_count_high,
+			SUM(total_sales_low) AS total_sales_low,
+			SUM(total_sales_high) AS total_sales_high`).
+		Where("goods_id <> ''").
+		Group("goods_id").
+		Order("total_sales_high DESC, goods_id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// GetRankStats 汇总博主的上榜次数、上榜视频数、期数、最好名次和累计销量/销售额范围
+func (r *bloggerRepo) GetRankStats(ctx context.Context, bloggerID int64, periodType, startDate, endDate string) (*BloggerRankStats, error) {
+	var stats BloggerRankStats
+	err := r.bloggerRankScope(ctx, bloggerID, periodType, startDate, endDate).
+		Select(`COUNT(*) AS appearances,
+			COUNT(DISTINCT aweme_id) AS video_count,
+			COUNT(DISTINCT rank_date) AS rank_dates,
+			COALESCE(MIN(NULLIF(rank_position, 0)), 0) AS best_position,
+			COALESCE(MIN(rank_date), '') AS first_seen_date,
+			COALESCE(MAX(rank_date), '') AS last_seen_date,
+			COALESCE(SUM(sales_count_low), 0) AS sales_count_low,
+			COALESCE(SUM(sales_count_high), 0) AS sales_count_high,
+			COALESCE(SUM(total_sales_low), 0) AS total_sales_low,
+			COALESCE(SUM(total_sales_high), 0) AS total_sales_high`).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// ListFansFromTrends 从博主作品的每日趋势中提取粉丝数，同一天取最大值，按日期升序
+func (r *bloggerRepo) ListFansFromTrends(ctx context.Context, bloggerID int64, startDate, endDate string) ([]*BloggerFansPoint, error) {
+	db := r.db.WithContext(ctx).Model(&VideoTrend{}).
+		Where("aweme_id IN (?)", r.db.Model(&Video{}).Select("aweme_id").Where("blogger_id = ?", bloggerID)).
+		Where("fans > 0")
+	if start, err := strconv.Atoi(startDate); err == nil {
+		db = db.Where("date_code >= ?", start)
+	}
+	if end, err := strconv.Atoi(endDate); err == nil {
+		db = db.Where("date_code <= ?", end)
+	}
+
+	var rows []struct {
+		DateCode int
+		Fans     int64
+	}
+	if err := db.Select("date_code, MAX(fans) AS fans").Group("date_code").Order("date_code ASC").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	points := make([]*BloggerFansPoint, 0, len(rows))
+	for _, row := range rows {
+		points = append(points, &BloggerFansPoint{Date: strconv.Itoa(row.DateCode), Fans: row.Fans})
+	}
+	return points, nil
+}
+
+// ListFansFromRanks 从榜单记录中的博主粉丝数快照提取粉丝数，同一期取最大值，按日期升序
+func (r *bloggerRepo) ListFansFromRanks(ctx context.Context, bloggerID int64, periodType, startDate, endDate string) ([]*BloggerFansPoint, error) {
+	var points []*BloggerFansPoint
+	err := r.bloggerRankScope(ctx, bloggerID, periodType, startDate, endDate).
+		Select("rank_date AS date, MAX(blogger_fans_num) AS fans").
+		Where("blogger_fans_num > 0").
+		Group("rank_date").
+		Order("rank_date ASC").
+		Scan(&points).Error
+	if err != nil {
+		return nil, err
+	}
+	return points, nil
+}
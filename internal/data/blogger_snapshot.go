@@ -0,0 +1,68 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BloggerSnapshot 博主每日快照，记录榜单数据中观测到的粉丝数和标签，避免维度表覆盖后丢失历史
+type BloggerSnapshot struct {
+	ID           uint   `gorm:"primaryKey"`
+	BloggerId    int64  `gorm:"not null;uniqueIndex:uk_blogger_snapshot_date,priority:1"`
+	SnapshotDate string `gorm:"size:8;not null;uniqueIndex:uk_blogger_snapshot_date,priority:2;comment:快照日期 YYYYMMDD"`
+	FansNum      int64  `gorm:"not null;default:0"`
+	BloggerTag   string `gorm:"size:255;not null;default:''"`
+	SourceDataId int64  `gorm:"index;comment:来源 source_data ID"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;type:timestamp"`
+}
+
+func (BloggerSnapshot) TableName() string {
+	return "blogger_snapshots"
+}
+
+// UpsertSnapshots 按 (blogger_id, snapshot_date) 写入快照，同一天重复写入时以最后一次为准
+func (r *bloggerRepo) UpsertSnapshots(ctx context.Context, snapshots []*BloggerSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "blogger_id"}, {Name: "snapshot_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"fans_num", "blogger_tag", "source_data_id", "updated_at"}),
+	}).CreateInBatches(snapshots, len(snapshots)).Error
+}
+
+// ListSnapshots 按日期升序返回博主在 [startDate, endDate] 内的快照，日期为空时不限制
+func (r *bloggerRepo) ListSnapshots(ctx context.Context, bloggerID int64, startDate, endDate string) ([]*BloggerSnapshot, error) {
+	db := r.db.WithContext(ctx).Where("blogger_id = ?", bloggerID)
+	if startDate != "" {
+		db = db.Where("snapshot_date >= ?", startDate)
+	}
+	if endDate != "" {
+		db = db.Where("snapshot_date <= ?", endDate)
+	}
+	var snapshots []*BloggerSnapshot
+	if err := db.Order("snapshot_date ASC").Find(&snapshots).Error; err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// backfillBloggerSnapshots 在快照表为空时，用历史日榜中的博主粉丝数初始化快照，
+// 同一博主同一天有多条榜单记录时取最后写入的一条。
+func backfillBloggerSnapshots(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&BloggerSnapshot{}).Limit(1).Count(&count).Error; err != nil || count > 0 {
+		return err
+	}
+	return db.Exec(`INSERT INTO blogger_snapshots (blogger_id, snapshot_date, fans_num, blogger_tag, source_data_id, created_at, updated_at)
+		SELECT DISTINCT ON (blogger_id, rank_date) blogger_id, rank_date, blogger_fans_num, blogger_tag, source_data_id, NOW(), NOW()
+		FROM video_ranks
+		WHERE period_type = ? AND blogger_id <> 0
+		ORDER BY blogger_id, rank_date, id DESC
+		ON CONFLICT DO NOTHING`, RankPeriodDay).Error
+}
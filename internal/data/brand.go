@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/gorm"
+)
+
+// Brand 品牌维度表，由榜单 ETL 根据商品的 brand_name 归并生成
+type Brand struct {
+	NamedDimension
+}
+
+func (Brand) TableName() string {
+	return "brands"
+}
+
+// BrandRepo 管理品牌维度及其榜单分析查询，日期格式为 "20060102"
+type BrandRepo interface {
+	// Ensure 返回名称对应的品牌 ID，不存在时创建；名称为空时返回 nil
+	Ensure(ctx context.Context, name string) (*uint, error)
+	// Get 查询单个品牌，不存在时返回 ErrNotFound
+	Get(ctx context.Context, id uint) (*Brand, error)
+	ListPage(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) ([]*DimensionWithCount, *v1.PageResponse, error)
+	// ListNameVariants 返回归并到该品牌下的全部原始名称
+	ListNameVariants(ctx context.Context, id uint) ([]string, error)
+	// CountProducts 统计归并到该品牌下的商品数
+	CountProducts(ctx context.Context, id uint) (int64, error)
+
+	GetSalesSummary(ctx context.Context, id uint, periodType, startDate, endDate string) (*DimensionSalesSummary, error)
+	ListDailySales(ctx context.Context, id uint, periodType, startDate, endDate string) ([]*DimensionDailySales, error)
+	ListTopProducts(ctx context.Context, id uint, periodType, startDate, endDate string, limit int) ([]*DimensionTopProduct, error)
+}
+
+type brandRepo struct {
+	*Data
+	store *namedDimensionStore
+}
+
+// NewBrandRepo .
+func NewBrandRepo(data *Data) BrandRepo {
+	return &brandRepo{Data: data, store: newBrandStore(data.db)}
+}
+
+func newBrandStore(db *gorm.DB) *namedDimensionStore {
+	return &namedDimensionStore{db: db, table: Brand{}.TableName(), productColumn: "brand_id", nameColumn: "brand_name"}
+}
+
+func (r *brandRepo) Ensure(ctx context.Context, name string) (*uint, error) {
+	return r.store.ensure(ctx, name)
+}
+
+func (r *brandRepo) Get(ctx context.Context, id uint) (*Brand, error) {
+	row, err := r.store.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &Brand{NamedDimension: *row}, nil
+}
+
+func (r *brandRepo) ListPage(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) ([]*DimensionWithCount, *v1.PageResponse, error) {
+	return r.store.listPage(ctx, page, query, sortBy, sortOrder)
+}
+
+func (r *brandRepo) ListNameVariants(ctx context.Context, id uint) ([]string, error) {
+	return r.store.nameVariants(ctx, id)
+}
+
+func (r *brandRepo) CountProducts(ctx context.Context, id uint) (int64, error) {
+	return r.store.countProducts(ctx, id)
+}
+
+func (r *brandRepo) GetSalesSummary(ctx context.Context, id uint, periodType, startDate, endDate string) (*DimensionSalesSummary, error) {
+	return r.store.salesSummary(ctx, id, periodType, startDate, endDate)
+}
+
+func (r *brandRepo) ListDailySales(ctx context.Context, id uint, periodType, startDate, endDate string) ([]*DimensionDailySales, error) {
+	return r.store.dailySales(ctx, id, periodType, startDate, endDate)
+}
+
+func (r *brandRepo) ListTopProducts(ctx context.Context, id uint, periodType, startDate, endDate string, limit int) ([]*DimensionTopProduct, error) {
+	return r.store.topProducts(ctx, id, periodType, startDate, endDate, limit)
+}
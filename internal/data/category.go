@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Jayleonc/aresdata/pkg/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Category 规范化后的类目树节点，Path 为从一级类目到本节点的完整路径，例如 "美妆>护肤>面霜"
+type Category struct {
+	ID        uint      `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime;type:timestamp"`
+	Name      string    `gorm:"size:255;not null"`
+	ParentId  uint      `gorm:"not null;default:0;index;comment:父类目ID，0表示一级类目"`
+	Level     int       `gorm:"not null;default:1;comment:层级，从1开始"`
+	Path      string    `gorm:"size:1024;not null;uniqueIndex"`
+}
+
+func (Category) TableName() string {
+	return "categories"
+}
+
+// ProductCategory 商品与末级类目的关联，一个商品可以属于多个类目
+type ProductCategory struct {
+	GoodsId    string `gorm:"primaryKey;size:1024"`
+	CategoryId uint   `gorm:"primaryKey;index"`
+}
+
+func (ProductCategory) TableName() string {
+	return "product_categories"
+}
+
+// CategoryWithCount 是类目及其子树下的商品数
+type CategoryWithCount struct {
+	Category
+	ChildCount   int64
+	ProductCount int64
+}
+
+// CategoryRepo 管理类目树及商品类目关联
+type CategoryRepo interface {
+	// SyncProduct 解析商品的类目字符串，补齐类目树并替换商品的类目关联
+	SyncProduct(ctx context.Context, goodsID, categoryNames string) error
+	// Get 查询单个类目，不存在时返回 ErrNotFound
+	Get(ctx context.Context, id uint) (*Category, error)
+	// List 查询某个父类目下的子类目，recursive 为 true 时返回整个子树；parentID 为 0 表示从根开始
+	List(ctx context.Context, parentID uint, recursive bool) ([]*CategoryWithCount, error)
+}
+
+type categoryRepo struct {
+	*Data
+
+	// pathIDs 缓存已确认存在的类目路径，避免 ETL 对每条榜单记录都访问数据库
+	pathIDs sync.Map
+}
+
+// NewCategoryRepo .
+func NewCategoryRepo(data *Data) CategoryRepo {
+	return &categoryRepo{Data: data}
+}
+
+func (r *categoryRepo) SyncProduct(ctx context.Context, goodsID, categoryNames string) error {
+	paths := utils.ParseCategoryPaths(categoryNames)
+	if goodsID == "" || len(paths) == 0 {
+		return nil
+	}
+	links := make([]*ProductCategory, 0, len(paths))
+	for _, names := range paths {
+		id, err := r.ensurePath(ctx, names)
+		if err != nil {
+			return err
+		}
+		links = append(links, &ProductCategory{GoodsId: goodsID, CategoryId: id})
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("goods_id = ?", goodsID).Delete(&ProductCategory{}).Error; err != nil {
+			return fmt.Errorf("删除商品类目关联失败 (goods_id: %s): %w", goodsID, err)
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&links).Error
+	})
+}
+
+// ensurePath 逐级创建类目路径上缺失的节点，返回末级类目的 ID
+func (r *categoryRepo) ensurePath(ctx context.Context, names []string) (uint, error) {
+	var parentID uint
+	for i, name := range names {
+		path := strings.Join(names[:i+1], utils.CategoryPathSeparator)
+		if id, ok := r.pathIDs.Load(path); ok {
+			parentID = id.(uint)
+			continue
+		}
+		category := &Category{Name: name, ParentId: parentID, Level: i + 1, Path: path}
+		// 冲突时做一次无实际变化的更新，使 RETURNING 能带回已存在节点的 ID
+		err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "path"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name"}),
+		}).Create(category).Error
+		if err != nil {
+			return 0, fmt.Errorf("创建类目 %s 失败: %w", path, err)
+		}
+		r.pathIDs.Store(path, category.ID)
+		parentID = category.ID
+	}
+	return parentID, nil
+}
+
+func (r *categoryRepo) Get(ctx context.Context, id uint) (*Category, error) {
+	var category Category
+	if err := r.db.WithContext(ctx).First(&category, id).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &category, nil
+}
+
+func (r *categoryRepo) List(ctx context.Context, parentID uint, recursive bool) ([]*CategoryWithCount, error) {
+	db := r.db.WithContext(ctx).Table("categories AS c").
+		Select(`c.*,
+			(SELECT COUNT(*) FROM categories ch WHERE ch.parent_id = c.id) AS child_count,
+			(SELECT COUNT(DISTINCT pc.goods_id) FROM product_categories pc
+				JOIN categories d ON d.id = pc.category_id
+				WHERE ` + categorySubtreeCond("d", "c") + `) AS product_count`)
+	switch {
+	case !recursive:
+		db = db.Where("c.parent_id = ?", parentID)
+	case parentID != 0:
+		db = db.Joins("JOIN categories a ON a.id = ?", parentID).
+			Where(categorySubtreeCond("c", "a") + " AND c.id <> a.id")
+	}
+
+	var categories []*CategoryWithCount
+	if err := db.Order("c.path ASC").Scan(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// backfillCategories 在 product_categories 为空时，根据已有商品的 category_names 建立类目树和关联
+func backfillCategories(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&ProductCategory{}).Limit(1).Count(&count).Error; err != nil || count > 0 {
+		return err
+	}
+	repo := &categoryRepo{Data: &Data{db: db}}
+	var products []*Product
+	return db.Select("goods_id", "category_names").Where("category_names <> ''").
+		FindInBatches(&products, 500, func(tx *gorm.DB, batch int) error {
+			for _, p := range products {
+				if err := repo.SyncProduct(context.Background(), p.GoodsId, p.CategoryNames); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
+
+// categorySubtreeCond 返回"节点 node 属于以 ancestor 为根的子树（包含自身）"的 SQL 条件
+func categorySubtreeCond(node, ancestor string) string {
+	return "(" + node + ".path = " + ancestor + ".path OR left(" + node + ".path, length(" + ancestor + ".path) + 1) = " +
+		ancestor + ".path || '" + utils.CategoryPathSeparator + "')"
+}
+
+// categoryGoodsSubquery 返回属于某个类目（包含全部后代类目）的 goods_id 子查询，用于列表过滤
+func categoryGoodsSubquery(db *gorm.DB, categoryID uint64) *gorm.DB {
+	return db.Table("product_categories AS pc").
+		Select("pc.goods_id").
+		Joins("JOIN categories d ON d.id = pc.category_id").
+		Joins("JOIN categories a ON a.id = ?", categoryID).
+		Where(categorySubtreeCond("d", "a"))
+}
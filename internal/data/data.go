@@ -1,6 +1,9 @@
 package data
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/Jayleonc/aresdata/internal/conf"
 	"time"
 
@@ -21,8 +24,31 @@ var ProviderSet = wire.NewSet(
 	NewVideoTrendRepo,
 	NewProductRepo,
 	NewBloggerRepo,
+	NewSearchRepo,
+	NewRollupRepo,
+	NewCategoryRepo,
+	NewBrandRepo,
+	NewShopRepo,
+	NewExportJobRepo,
+	NewAlertRepo,
+	NewWatchlistRepo,
+	NewVideoCommentRepo,
+	NewAudienceRepo,
 )
 
+// ErrNotFound 表示查询的记录不存在。仓库把 gorm.ErrRecordNotFound 包装为该错误后返回，
+// biz 层据此判断记录不存在，不需要依赖 gorm
+var ErrNotFound = errors.New("record not found")
+
+// wrapNotFound 把 gorm.ErrRecordNotFound 包装为 ErrNotFound，包装后的错误同时匹配两者，
+// 仍按 gorm.ErrRecordNotFound 判断的调用方不受影响
+func wrapNotFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
+
 // Data .
 type Data struct {
 	db          *gorm.DB
@@ -62,7 +88,48 @@ func NewData(c *conf.Data, redisClient redis.Cmdable, logger log.Logger) (*Data,
 		_ = redisClient.(*redis.Client).Close()
 	}
 
-	db.AutoMigrate(&SourceData{}, &VideoRank{}, &Video{}, &VideoTrend{}, &Product{}, &Blogger{})
+	// 自然键唯一索引创建前需要先清理历史重复数据
+	if err := migrateVideoRankUniqueKey(db); err != nil {
+		helper.Errorf("迁移 video_ranks 唯一键失败: %v", err)
+	}
+	if err := migrateVideoTrendUniqueKey(db); err != nil {
+		helper.Errorf("迁移 video_trends 唯一键失败: %v", err)
+	}
+	if err := migrateLegacyVideoRankDataType(db); err != nil {
+		helper.Errorf("%v", err)
+	}
+	if err := dropVideoRankHistoryView(db); err != nil {
+		helper.Errorf("迁移 video_rank_history 视图失败: %v", err)
+	}
+	err = db.AutoMigrate(&SourceData{}, &VideoRank{}, &Video{}, &VideoTrend{}, &Brand{}, &Shop{}, &Product{}, &Blogger{}, &BloggerSnapshot{}, &ProductSnapshot{}, &DailyRollup{}, &RollupDirtyDate{}, &Category{}, &ProductCategory{}, &ExportJob{}, &AlertRule{}, &AlertEvent{}, &WatchlistItem{}, &VideoCollectionState{}, &VideoComment{}, &VideoCommentKeyword{}, &AudienceProfile{}, &AudienceShare{})
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("自动迁移数据表失败: %w", err)
+	}
+	if err := createVideoRankHistoryView(db); err != nil {
+		helper.Errorf("迁移 video_rank_history 视图失败: %v", err)
+	}
+	if err := backfillBloggerSnapshots(db); err != nil {
+		helper.Errorf("初始化 blogger_snapshots 失败: %v", err)
+	}
+	if err := backfillProductSnapshots(db); err != nil {
+		helper.Errorf("初始化 product_snapshots 失败: %v", err)
+	}
+	if err := newBrandStore(db).backfill(); err != nil {
+		helper.Errorf("初始化 brands 失败: %v", err)
+	}
+	if err := newShopStore(db).backfill(); err != nil {
+		helper.Errorf("初始化 shops 失败: %v", err)
+	}
+	if err := backfillCategories(db); err != nil {
+		helper.Errorf("初始化商品类目失败: %v", err)
+	}
+	if err := migrateRollupDimensionValues(db); err != nil {
+		helper.Errorf("迁移 daily_rollups 维度值失败: %v", err)
+	}
+	if err := createSearchIndexes(db); err != nil {
+		helper.Errorf("创建全文检索索引失败: %v", err)
+	}
 
 	return &Data{
 		db:     db,
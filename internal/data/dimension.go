@@ -0,0 +1,248 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/pkg/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NamedDimension 是品牌、店铺这类只有名称的维度的公共字段。
+// NormalizedName 为 utils.NormalizeDimensionName 生成的去重键，名称写法不同但去重键相同的记录会归并为同一个实体。
+type NamedDimension struct {
+	ID             uint      `gorm:"primaryKey"`
+	Name           string    `gorm:"size:255;not null;comment:首次出现时的名称"`
+	NormalizedName string    `gorm:"size:255;not null;uniqueIndex"`
+	CreatedAt      time.Time `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime;type:timestamp"`
+}
+
+// DimensionWithCount 是维度实体及其关联的商品数
+type DimensionWithCount struct {
+	NamedDimension
+	ProductCount int64
+}
+
+// DimensionSalesSummary 是某个品牌或店铺下全部商品在一段时间内的榜单汇总
+type DimensionSalesSummary struct {
+	ProductCount   int64
+	VideoCount     int64
+	BloggerCount   int64
+	RankEntries    int64
+	SalesCountLow  int64
+	SalesCountHigh int64
+	TotalSalesLow  int64
+	TotalSalesHigh int64
+}
+
+// DimensionDailySales 是某个品牌或店铺单期榜单上的销量汇总
+type DimensionDailySales struct {
+	RankDate       string
+	VideoCount     int64
+	GoodsCount     int64
+	SalesCountLow  int64
+	SalesCountHigh int64
+	TotalSalesLow  int64
+	TotalSalesHigh int64
+}
+
+// DimensionTopProduct 是某个品牌或店铺下按销售额排序的商品
+type DimensionTopProduct struct {
+	GoodsId        string
+	GoodsTitle     string
+	GoodsCoverUrl  string
+	VideoCount     int64
+	SalesCountLow  int64
+	SalesCountHigh int64
+	TotalSalesLow  int64
+	TotalSalesHigh int64
+}
+
+// dimensionSortFields 是品牌、店铺列表的排序白名单
+var dimensionSortFields = SortFields{
+	"product_count": "product_count",
+	"created_at":    "created_at",
+	"name":          "name",
+}
+
+// namedDimensionStore 实现品牌、店铺共用的读写逻辑。
+// table 为维度表名，productColumn 为 products 中指向该维度的外键列，nameColumn 为 products 中的原始名称列。
+type namedDimensionStore struct {
+	db            *gorm.DB
+	table         string
+	productColumn string
+	nameColumn    string
+
+	// ids 缓存去重键对应的 ID，避免 ETL 对每条榜单记录都访问数据库
+	ids sync.Map
+}
+
+// ensure 返回名称对应的维度 ID，不存在时创建；名称为空或去重键为空时返回 nil
+func (s *namedDimensionStore) ensure(ctx context.Context, name string) (*uint, error) {
+	key := utils.NormalizeDimensionName(name)
+	if key == "" {
+		return nil, nil
+	}
+	if id, ok := s.ids.Load(key); ok {
+		v := id.(uint)
+		return &v, nil
+	}
+	row := &NamedDimension{Name: strings.TrimSpace(name), NormalizedName: key}
+	// 冲突时只刷新 updated_at，保留首次出现的名称，同时让 RETURNING 带回已存在记录的 ID
+	err := s.db.WithContext(ctx).Table(s.table).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "normalized_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
+	}).Create(row).Error
+	if err != nil {
+		return nil, fmt.Errorf("写入 %s 失败 (%s): %w", s.table, name, err)
+	}
+	s.ids.Store(key, row.ID)
+	return &row.ID, nil
+}
+
+func (s *namedDimensionStore) get(ctx context.Context, id uint) (*NamedDimension, error) {
+	var row NamedDimension
+	if err := s.db.WithContext(ctx).Table(s.table).Where("id = ?", id).Take(&row).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &row, nil
+}
+
+// listPage 分页查询维度实体，query 同时模糊匹配名称和去重键
+func (s *namedDimensionStore) listPage(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) ([]*DimensionWithCount, *v1.PageResponse, error) {
+	db := s.db.WithContext(ctx).Table("(?) AS d", s.db.Table(s.table+" AS t").
+		Select("t.*, (SELECT COUNT(*) FROM products p WHERE p."+s.productColumn+" = t.id) AS product_count"))
+	if query != "" {
+		db = db.Where("d.name ILIKE ? OR d.normalized_name LIKE ?",
+			"%"+escapeLike(query)+"%", "%"+escapeLike(utils.NormalizeDimensionName(query))+"%")
+	}
+	var rows []*DimensionWithCount
+	pageResp, err := newQueryBuilder(db, "id").
+		Sort(sortBy, sortOrder, dimensionSortFields, "product_count", true).
+		Page(page, &rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, pageResp, nil
+}
+
+// nameVariants 返回归并到该实体下的商品中出现过的全部原始名称
+func (s *namedDimensionStore) nameVariants(ctx context.Context, id uint) ([]string, error) {
+	var names []string
+	err := s.db.WithContext(ctx).Model(&Product{}).
+		Distinct(s.nameColumn).
+		Where(s.productColumn+" = ?", id).
+		Order(s.nameColumn).
+		Pluck(s.nameColumn, &names).Error
+	return names, err
+}
+
+func (s *namedDimensionStore) countProducts(ctx context.Context, id uint) (int64, error) {
+	var count int64
+	err := s.db.WithContext(ctx).Model(&Product{}).Where(s.productColumn+" = ?", id).Count(&count).Error
+	return count, err
+}
+
+// rankScope 限定该实体下全部商品在某周期、某日期范围内的榜单记录
+func (s *namedDimensionStore) rankScope(ctx context.Context, id uint, periodType, startDate, endDate string) *gorm.DB {
+	db := s.db.WithContext(ctx).Table("video_ranks AS r").
+		Where("r.period_type = ?", periodType).
+		Where("r.goods_id IN (?)", s.db.Model(&Product{}).Select("goods_id").Where(s.productColumn+" = ?", id))
+	if startDate != "" {
+		db = db.Where("r.rank_date >= ?", startDate)
+	}
+	if endDate != "" {
+		db = db.Where("r.rank_date <= ?", endDate)
+	}
+	return db
+}
+
+func (s *namedDimensionStore) salesSummary(ctx context.Context, id uint, periodType, startDate, endDate string) (*DimensionSalesSummary, error) {
+	var summary DimensionSalesSummary
+	err := s.rankScope(ctx, id, periodType, startDate, endDate).
+		Select(`COUNT(DISTINCT r.goods_id) AS product_count,
+			COUNT(DISTINCT r.aweme_id) AS video_count,
+			COUNT(DISTINCT r.blogger_id) AS blogger_count,
+			COUNT(*) AS rank_entries,
+			COALESCE(SUM(r.sales_count_low), 0) AS sales_count_low,
+			COALESCE(SUM(r.sales_count_high), 0) AS sales_count_high,
+			COALESCE(SUM(r.total_sales_low), 0) AS total_sales_low,
+			COALESCE(SUM(r.total_sales_high), 0) AS total_sales_high`).
+		Scan(&summary).Error
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+func (s *namedDimensionStore) dailySales(ctx context.Context, id uint, periodType, startDate, endDate string) ([]*DimensionDailySales, error) {
+	var rows []*DimensionDailySales
+	err := s.rankScope(ctx, id, periodType, startDate, endDate).
+		Select(`r.rank_date,
+			COUNT(DISTINCT r.aweme_id) AS video_count,
+			COUNT(DISTINCT r.goods_id) AS goods_count,
+			COALESCE(SUM(r.sales_count_low), 0) AS sales_count_low,
+			COALESCE(SUM(r.sales_count_high), 0) AS sales_count_high,
+			COALESCE(SUM(r.total_sales_low), 0) AS total_sales_low,
+			COALESCE(SUM(r.total_sales_high), 0) AS total_sales_high`).
+		Group("r.rank_date").
+		Order("r.rank_date ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (s *namedDimensionStore) topProducts(ctx context.Context, id uint, periodType, startDate, endDate string, limit int) ([]*DimensionTopProduct, error) {
+	var rows []*DimensionTopProduct
+	err := s.rankScope(ctx, id, periodType, startDate, endDate).
+		Joins("JOIN products p ON p.goods_id = r.goods_id").
+		Select(`r.goods_id,
+			MAX(p.goods_title) AS goods_title,
+			MAX(p.goods_cover_url) AS goods_cover_url,
+			COUNT(DISTINCT r.aweme_id) AS video_count,
+			COALESCE(SUM(r.sales_count_low), 0) AS sales_count_low,
+			COALESCE(SUM(r.sales_count_high), 0) AS sales_count_high,
+			COALESCE(SUM(r.total_sales_low), 0) AS total_sales_low,
+			COALESCE(SUM(r.total_sales_high), 0) AS total_sales_high`).
+		Group("r.goods_id").
+		Order("total_sales_high DESC, r.goods_id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// backfill 在维度表为空时，根据已有商品的原始名称建立维度实体并回填商品外键
+func (s *namedDimensionStore) backfill() error {
+	var count int64
+	if err := s.db.Table(s.table).Limit(1).Count(&count).Error; err != nil || count > 0 {
+		return err
+	}
+	var names []string
+	if err := s.db.Model(&Product{}).Distinct(s.nameColumn).Where(s.nameColumn+" <> ''").Pluck(s.nameColumn, &names).Error; err != nil {
+		return err
+	}
+	for _, name := range names {
+		id, err := s.ensure(context.Background(), name)
+		if err != nil {
+			return err
+		}
+		if id == nil {
+			continue
+		}
+		if err := s.db.Model(&Product{}).Where(s.nameColumn+" = ?", name).Update(s.productColumn, *id).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+)
+
+// 导出任务状态
+const (
+	ExportJobStatusPending   = "pending"
+	ExportJobStatusRunning   = "running"
+	ExportJobStatusSucceeded = "succeeded"
+	ExportJobStatusFailed    = "failed"
+)
+
+// ExportJob 异步导出任务，导出文件写入本地导出目录，FileName 为目录内的文件名
+type ExportJob struct {
+	ID         uint       `gorm:"primaryKey"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt  time.Time  `gorm:"autoUpdateTime;type:timestamp"`
+	Entity     string     `gorm:"size:32;not null"`
+	Format     string     `gorm:"size:16;not null"`
+	Request    string     `gorm:"type:text;not null;comment:导出请求（JSON）"`
+	Status     string     `gorm:"size:16;not null;index"`
+	FileName   string     `gorm:"size:255;not null;default:''"`
+	FileSize   int64      `gorm:"not null;default:0"`
+	RowCount   int64      `gorm:"not null;default:0"`
+	Error      string     `gorm:"type:text;not null;default:''"`
+	StartedAt  *time.Time `gorm:"type:timestamp"`
+	FinishedAt *time.Time `gorm:"type:timestamp"`
+}
+
+func (ExportJob) TableName() string {
+	return "export_jobs"
+}
+
+// ExportJobRepo 管理异步导出任务
+type ExportJobRepo interface {
+	Create(ctx context.Context, job *ExportJob) error
+	// Get 查询单个导出任务，不存在时返回 ErrNotFound
+	Get(ctx context.Context, id uint) (*ExportJob, error)
+	ListPage(ctx context.Context, page *v1.PageRequest) ([]*ExportJob, *v1.PageResponse, error)
+	// MarkRunning 把任务标记为执行中
+	MarkRunning(ctx context.Context, id uint) error
+	// Finish 记录任务结果，errMsg 为空表示成功
+	Finish(ctx context.Context, id uint, fileName string, fileSize, rowCount int64, errMsg string) error
+	// FailUnfinished 把进程退出时未完成的任务标记为失败，在服务启动时调用
+	FailUnfinished(ctx context.Context, reason string) (int64, error)
+}
+
+type exportJobRepo struct {
+	*Data
+}
+
+// NewExportJobRepo .
+func NewExportJobRepo(data *Data) ExportJobRepo {
+	return &exportJobRepo{Data: data}
+}
+
+func (r *exportJobRepo) Create(ctx context.Context, job *ExportJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+func (r *exportJobRepo) Get(ctx context.Context, id uint) (*ExportJob, error) {
+	var job ExportJob
+	if err := r.db.WithContext(ctx).First(&job, id).Error; err != nil {
+		return nil, wrapNotFound(err)
+	}
+	return &job, nil
+}
+
+// ListPage 按创建时间倒序分页查询导出任务
+func (r *exportJobRepo) ListPage(ctx context.Context, page *v1.PageRequest) ([]*ExportJob, *v1.PageResponse, error) {
+	var jobs []*ExportJob
+	pageResp, err := newQueryBuilder(r.db.WithContext(ctx).Model(&ExportJob{}), "id").
+		Sort("", v1.SortOrder_DESC, nil, "id", true).
+		Page(page, &jobs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return jobs, pageResp, nil
+}
+
+func (r *exportJobRepo) MarkRunning(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&ExportJob{ID: id}).Updates(map[string]any{
+		"status":     ExportJobStatusRunning,
+		"started_at": time.Now(),
+	}).Error
+}
+
+func (r *exportJobRepo) Finish(ctx context.Context, id uint, fileName string, fileSize, rowCount int64, errMsg string) error {
+	status := ExportJobStatusSucceeded
+	if errMsg != "" {
+		status = ExportJobStatusFailed
+	}
+	return r.db.WithContext(ctx).Model(&ExportJob{ID: id}).Updates(map[string]any{
+		"status":      status,
+		"file_name":   fileName,
+		"file_size":   fileSize,
+		"row_count":   rowCount,
+		"error":       errMsg,
+		"finished_at": time.Now(),
+	}).Error
+}
+
+func (r *exportJobRepo) FailUnfinished(ctx context.Context, reason string) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&ExportJob{}).
+		Where("status IN ?", []string{ExportJobStatusPending, ExportJobStatusRunning}).
+		Updates(map[string]any{
+			"status":      ExportJobStatusFailed,
+			"error":       reason,
+			"finished_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
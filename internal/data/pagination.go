@@ -0,0 +1,286 @@
+package data
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidPageToken 表示游标无法解析，或与当前请求的排序方式不匹配
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// pageCursor 是 next_page_token 的内容，序列化后以 base64 编码对调用方保持不透明。
+// Sort 记录生成游标时的排序键，换了排序方式的游标不能继续使用。
+type pageCursor struct {
+	Sort   string        `json:"s"`
+	Values []cursorValue `json:"v"` // 依次为排序列的值、pk 的值；排序列就是 pk 时只有一个值
+}
+
+// cursorValue 带类型地保存游标中的列值，避免 JSON 往返丢失时间和大整数的精度
+type cursorValue struct {
+	Type  string `json:"t"` // "s" string, "i" int64, "f" float64, "t" time, "n" null
+	Value string `json:"v,omitempty"`
+}
+
+// Page 按分页请求执行查询：游标模式使用 keyset 条件，否则使用 OFFSET/LIMIT。
+// 必须在 Sort 之后调用。
+func (b *queryBuilder) Page(page *v1.PageRequest, dest any) (*v1.PageResponse, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if page == nil {
+		page = &v1.PageRequest{Page: 1, Size: 10}
+	}
+	size := int(page.Size)
+	if size <= 0 {
+		size = 10
+	}
+
+	cursorMode := page.Cursor || page.PageToken != ""
+	resp := &v1.PageResponse{}
+
+	switch page.TotalMode {
+	case v1.TotalMode_TOTAL_MODE_EXACT:
+		if err := b.count(&resp.Total); err != nil {
+			return nil, err
+		}
+	case v1.TotalMode_TOTAL_MODE_APPROXIMATE:
+		total, err := b.estimateCount()
+		if err != nil {
+			return nil, err
+		}
+		resp.Total, resp.TotalApproximate = total, true
+	case v1.TotalMode_TOTAL_MODE_DEFAULT:
+		if !cursorMode {
+			if err := b.count(&resp.Total); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !cursorMode {
+		pageNum := int(page.Page)
+		if pageNum < 1 {
+			pageNum = 1
+		}
+		if err := b.db.Offset((pageNum - 1) * size).Limit(size).Find(dest).Error; err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	if page.PageToken != "" {
+		cursor, err := b.decodeCursor(page.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if err := b.applyCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// 多取一条用于判断是否还有下一页
+	if err := b.db.Limit(size + 1).Find(dest).Error; err != nil {
+		return nil, err
+	}
+	rows := reflect.ValueOf(dest).Elem()
+	if rows.Len() > size {
+		rows.Set(rows.Slice(0, size))
+		token, err := b.encodeCursor(dest, rows.Index(size-1))
+		if err != nil {
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+	return resp, nil
+}
+
+// eachRow 按 qb 的条件和排序逐行读取全部结果并回调 fn，结果通过数据库游标流式读取，不会整体加载到内存。
+// 必须在 Sort 之后调用；fn 返回错误时停止读取并返回该错误。读取期间会一直占用一个数据库连接。
+func eachRow[T any](b *queryBuilder, fn func(*T) error) error {
+	if b.err != nil {
+		return b.err
+	}
+	rows, err := b.db.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var row T
+		if err := b.db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (b *queryBuilder) count(total *int64) error {
+	// 使用会话副本统计，避免影响后续的分页查询
+	return b.db.Session(&gorm.Session{}).Count(total).Error
+}
+
+// estimateCount 使用 PostgreSQL 查询计划中的行数估算总数，代价与表大小无关
+func (b *queryBuilder) estimateCount() (int64, error) {
+	query := b.db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		var rows []map[string]any
+		return tx.Find(&rows)
+	})
+	var plan string
+	err := b.db.Session(&gorm.Session{NewDB: true}).
+		Raw("EXPLAIN (FORMAT JSON) " + query).
+		Row().Scan(&plan)
+	if err != nil {
+		return 0, fmt.Errorf("估算总数失败: %w", err)
+	}
+	var explained []struct {
+		Plan struct {
+			PlanRows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explained); err != nil || len(explained) == 0 {
+		return 0, fmt.Errorf("解析查询计划失败: %v", err)
+	}
+	return int64(explained[0].Plan.PlanRows), nil
+}
+
+// sortKey 标识当前排序方式，写入游标用于校验
+func (b *queryBuilder) sortKey() string {
+	if b.sortDesc {
+		return b.sortColumn + ":desc"
+	}
+	return b.sortColumn + ":asc"
+}
+
+// applyCursor 追加 keyset 条件。PostgreSQL 中 NULL 在 DESC 时排最前、ASC 时排最后，这里按同样的规则续读。
+func (b *queryBuilder) applyCursor(c *pageCursor) error {
+	if b.sortColumn == b.pk {
+		if len(c.Values) != 1 {
+			return ErrInvalidPageToken
+		}
+		pk := c.Values[0].decode()
+		if b.sortDesc {
+			b.db = b.db.Where(b.pk+" < ?", pk)
+		} else {
+			b.db = b.db.Where(b.pk+" > ?", pk)
+		}
+		return nil
+	}
+
+	if len(c.Values) != 2 {
+		return ErrInvalidPageToken
+	}
+	col, pk := b.sortColumn, b.pk
+	sortVal, pkVal := c.Values[0], c.Values[1].decode()
+	switch {
+	case sortVal.Type == "n" && b.sortDesc:
+		// NULL 组尚未读完：继续读 NULL 组的剩余部分，然后是全部非 NULL 行
+		b.db = b.db.Where("(("+col+" IS NULL AND "+pk+" < ?) OR "+col+" IS NOT NULL)", pkVal)
+	case sortVal.Type == "n":
+		b.db = b.db.Where(col+" IS NULL AND "+pk+" > ?", pkVal)
+	case b.sortDesc:
+		// NULL 组已经在前面读过，行比较遇到 NULL 为假，自然排除
+		b.db = b.db.Where("("+col+", "+pk+") < (?, ?)", sortVal.decode(), pkVal)
+	default:
+		b.db = b.db.Where("(("+col+", "+pk+") > (?, ?) OR "+col+" IS NULL)", sortVal.decode(), pkVal)
+	}
+	return nil
+}
+
+func (b *queryBuilder) decodeCursor(token string) (*pageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var c pageCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if c.Sort != b.sortKey() {
+		return nil, fmt.Errorf("%w: 游标的排序方式与请求不一致", ErrInvalidPageToken)
+	}
+	return &c, nil
+}
+
+// encodeCursor 从当前页最后一行读取排序列和 pk 的值生成游标
+func (b *queryBuilder) encodeCursor(dest any, last reflect.Value) (string, error) {
+	stmt := &gorm.Statement{DB: b.db}
+	if err := stmt.Parse(dest); err != nil {
+		return "", err
+	}
+	for last.Kind() == reflect.Ptr {
+		last = last.Elem()
+	}
+
+	columns := []string{b.sortColumn, b.pk}
+	if b.sortColumn == b.pk {
+		columns = columns[:1]
+	}
+	c := pageCursor{Sort: b.sortKey()}
+	for _, column := range columns {
+		field := stmt.Schema.LookUpField(column)
+		if field == nil {
+			return "", fmt.Errorf("游标列 %s 不存在于 %s", column, stmt.Schema.Name)
+		}
+		value, _ := field.ValueOf(context.Background(), last)
+		c.Values = append(c.Values, newCursorValue(value))
+	}
+
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func newCursorValue(value any) cursorValue {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return cursorValue{Type: "n"}
+	}
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if t, ok := rv.Interface().(time.Time); ok {
+		return cursorValue{Type: "t", Value: t.Format(time.RFC3339Nano)}
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cursorValue{Type: "i", Value: strconv.FormatInt(rv.Int(), 10)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cursorValue{Type: "i", Value: strconv.FormatUint(rv.Uint(), 10)}
+	case reflect.Float32, reflect.Float64:
+		return cursorValue{Type: "f", Value: strconv.FormatFloat(rv.Float(), 'g', -1, 64)}
+	default:
+		return cursorValue{Type: "s", Value: fmt.Sprint(rv.Interface())}
+	}
+}
+
+func (v cursorValue) decode() any {
+	switch v.Type {
+	case "i":
+		n, _ := strconv.ParseInt(v.Value, 10, 64)
+		return n
+	case "f":
+		f, _ := strconv.ParseFloat(v.Value, 64)
+		return f
+	case "t":
+		t, _ := time.Parse(time.RFC3339Nano, v.Value)
+		return t
+	case "n":
+		return nil
+	default:
+		return v.Value
+	}
+}
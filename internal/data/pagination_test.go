@@ -0,0 +1,188 @@
+package data
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// cursorTestRow 是游标测试使用的模型，Score 可为 NULL
+type cursorTestRow struct {
+	ID        uint
+	Score     *float64
+	Name      string
+	UpdatedAt time.Time
+}
+
+var cursorTestSortFields = SortFields{"id": "id", "score": "score", "name": "name", "updated_at": "updated_at"}
+
+// dryRunDB 返回只生成 SQL、不连接数据库的 *gorm.DB
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("打开 DryRun 数据库失败: %v", err)
+	}
+	return db
+}
+
+func newCursorTestBuilder(t *testing.T, sortBy string, order v1.SortOrder) *queryBuilder {
+	return newQueryBuilder(dryRunDB(t).Model(&cursorTestRow{}), "id").Sort(sortBy, order, cursorTestSortFields, "id", true)
+}
+
+func TestCursorValueRoundTrip(t *testing.T) {
+	score := 3.5
+	var nilScore *float64
+	at := time.Date(2025, 7, 1, 8, 30, 0, 123456789, time.UTC)
+	tests := []struct {
+		name     string
+		value    any
+		wantType string
+		want     any
+	}{
+		{name: "int", value: int(-42), wantType: "i", want: int64(-42)},
+		{name: "uint beyond float precision", value: uint64(9007199254740993), wantType: "i", want: int64(9007199254740993)},
+		{name: "float", value: 0.1, wantType: "f", want: 0.1},
+		{name: "pointer", value: &score, wantType: "f", want: 3.5},
+		{name: "nil pointer", value: nilScore, wantType: "n", want: nil},
+		{name: "untyped nil", value: nil, wantType: "n", want: nil},
+		{name: "string", value: "7481234567890", wantType: "s", want: "7481234567890"},
+		{name: "time keeps nanoseconds", value: at, wantType: "t", want: at},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newCursorValue(tt.value)
+			if v.Type != tt.wantType {
+				t.Fatalf("newCursorValue(%v).Type = %q, want %q", tt.value, v.Type, tt.wantType)
+			}
+			got := v.decode()
+			if gt, ok := got.(time.Time); ok {
+				if !gt.Equal(tt.want.(time.Time)) {
+					t.Errorf("decode() = %v, want %v", gt, tt.want)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("decode() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorEncodeDecode(t *testing.T) {
+	score := 9.5
+	rows := []*cursorTestRow{{ID: 11, Score: &score, Name: "a"}, {ID: 12, Name: "b"}}
+	tests := []struct {
+		name       string
+		sortBy     string
+		order      v1.SortOrder
+		last       int
+		wantValues []cursorValue
+	}{
+		{name: "pk only", sortBy: "", last: 0, wantValues: []cursorValue{{Type: "i", Value: "11"}}},
+		{name: "sort column and pk", sortBy: "score", order: v1.SortOrder_DESC, last: 0, wantValues: []cursorValue{{Type: "f", Value: "9.5"}, {Type: "i", Value: "11"}}},
+		{name: "null sort value", sortBy: "score", order: v1.SortOrder_ASC, last: 1, wantValues: []cursorValue{{Type: "n"}, {Type: "i", Value: "12"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newCursorTestBuilder(t, tt.sortBy, tt.order)
+			token, err := b.encodeCursor(&rows, reflect.ValueOf(rows).Index(tt.last))
+			if err != nil {
+				t.Fatalf("encodeCursor() error = %v", err)
+			}
+			c, err := b.decodeCursor(token)
+			if err != nil {
+				t.Fatalf("decodeCursor() error = %v", err)
+			}
+			if !reflect.DeepEqual(c.Values, tt.wantValues) {
+				t.Errorf("cursor values = %+v, want %+v", c.Values, tt.wantValues)
+			}
+
+			// 换了排序方式的游标必须被拒绝
+			other := newCursorTestBuilder(t, "name", v1.SortOrder_ASC)
+			if _, err := other.decodeCursor(token); !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("decodeCursor() with another sort error = %v, want ErrInvalidPageToken", err)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorMalformed(t *testing.T) {
+	for _, token := range []string{"not base64!", "bm90IGpzb24", ""} {
+		t.Run(token, func(t *testing.T) {
+			b := newCursorTestBuilder(t, "", v1.SortOrder_DESC)
+			if _, err := b.decodeCursor(token); !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("decodeCursor(%q) error = %v, want ErrInvalidPageToken", token, err)
+			}
+		})
+	}
+}
+
+func TestApplyCursor(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    string
+		order     v1.SortOrder
+		values    []cursorValue
+		wantWhere string
+		wantVars  []any
+		wantErr   bool
+	}{
+		{
+			name: "pk desc", sortBy: "", values: []cursorValue{{Type: "i", Value: "20"}},
+			wantWhere: "id < $1", wantVars: []any{int64(20)},
+		},
+		{
+			name: "pk asc", sortBy: "id", values: []cursorValue{{Type: "i", Value: "20"}}, order: v1.SortOrder_ASC,
+			wantWhere: "id > $1", wantVars: []any{int64(20)},
+		},
+		{
+			name: "desc non-null", sortBy: "score", order: v1.SortOrder_DESC,
+			values:    []cursorValue{{Type: "f", Value: "1.5"}, {Type: "i", Value: "7"}},
+			wantWhere: "(score, id) < ($1, $2)", wantVars: []any{1.5, int64(7)},
+		},
+		{
+			name: "asc non-null continues into nulls", sortBy: "score", order: v1.SortOrder_ASC,
+			values:    []cursorValue{{Type: "f", Value: "1.5"}, {Type: "i", Value: "7"}},
+			wantWhere: "((score, id) > ($1, $2) OR score IS NULL)", wantVars: []any{1.5, int64(7)},
+		},
+		{
+			name: "desc inside null group", sortBy: "score", order: v1.SortOrder_DESC,
+			values:    []cursorValue{{Type: "n"}, {Type: "i", Value: "7"}},
+			wantWhere: "((score IS NULL AND id < $1) OR score IS NOT NULL)", wantVars: []any{int64(7)},
+		},
+		{
+			name: "asc inside null group", sortBy: "score", order: v1.SortOrder_ASC,
+			values:    []cursorValue{{Type: "n"}, {Type: "i", Value: "7"}},
+			wantWhere: "score IS NULL AND id > $1", wantVars: []any{int64(7)},
+		},
+		{name: "pk cursor with two values", sortBy: "", values: []cursorValue{{Type: "i", Value: "1"}, {Type: "i", Value: "2"}}, wantErr: true},
+		{name: "sort cursor with one value", sortBy: "score", values: []cursorValue{{Type: "i", Value: "1"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newCursorTestBuilder(t, tt.sortBy, tt.order)
+			err := b.applyCursor(&pageCursor{Sort: b.sortKey(), Values: tt.values})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyCursor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			stmt := b.db.Find(&[]*cursorTestRow{}).Statement
+			sql := stmt.SQL.String()
+			if !strings.Contains(sql, "WHERE "+tt.wantWhere+" ORDER BY") {
+				t.Errorf("SQL = %s, want WHERE %s", sql, tt.wantWhere)
+			}
+			if !reflect.DeepEqual(stmt.Vars, tt.wantVars) {
+				t.Errorf("vars = %#v, want %#v", stmt.Vars, tt.wantVars)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"time"
 
@@ -23,6 +25,12 @@ type Product struct {
 	ShopName        string  `gorm:"size:255"`
 	BrandName       string  `gorm:"size:255"`
 	CategoryNames   string  `gorm:"size:1024"`
+
+	// 归并后的品牌、店铺，原始名称仍保留在 BrandName、ShopName 中
+	BrandId *uint  `gorm:"index"`
+	Brand   *Brand `gorm:"constraint:OnDelete:SET NULL"`
+	ShopId  *uint  `gorm:"index"`
+	Shop    *Shop  `gorm:"constraint:OnDelete:SET NULL"`
 }
 
 func (Product) TableName() string {
@@ -31,8 +39,20 @@ func (Product) TableName() string {
 
 type ProductRepo interface {
 	Upsert(ctx context.Context, product *Product) error
-	ListPage(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*Product, int64, error)
+	ListPage(ctx context.Context, page *v1.PageRequest, query string, filter *v1.ProductFilter, sortBy string, sortOrder v1.SortOrder) ([]*Product, *v1.PageResponse, error)
+	// Get 按 goods_id 查询商品，不存在时返回 ErrNotFound
 	Get(ctx context.Context, goodsId string) (*Product, error)
+
+	// 以下为基于 video_ranks / video_trends 的商品分析查询，日期格式为 "20060102"
+	GetRankSummary(ctx context.Context, goodsID, periodType, startDate, endDate string) (*ProductRankSummary, error)
+	ListDailySales(ctx context.Context, goodsID, periodType, startDate, endDate string) ([]*ProductDailySales, error)
+	ListTrendSales(ctx context.Context, goodsID, startDate, endDate string) ([]*ProductTrendSales, error)
+	ListTopVideos(ctx context.Context, goodsID, periodType, startDate, endDate string, limit int) ([]*ProductTopVideo, error)
+
+	// UpsertSnapshots 写入商品每日价格/佣金快照
+	UpsertSnapshots(ctx context.Context, snapshots []*ProductSnapshot) error
+	// ListSnapshots 查询商品的每日快照
+	ListSnapshots(ctx context.Context, goodsID, startDate, endDate string) ([]*ProductSnapshot, error)
 }
 
 type productRepo struct {
@@ -45,10 +65,17 @@ func NewProductRepo(data *Data) ProductRepo {
 
 // Upsert a product record. If the record with the same GoodsId exists, it will be updated.
 // Otherwise, a new record will be created. This is a safe upsert for rank data.
+// A nil BrandId/ShopId keeps the existing link as long as the brand/shop name is unchanged,
+// so a failed dimension lookup does not wipe a previously resolved foreign key.
 func (r *productRepo) Upsert(ctx context.Context, product *Product) error {
+	updates := clause.AssignmentColumns(allProductColumnsFromRank())
+	updates = append(updates,
+		keepDimensionLink("brand_id", "brand_name"),
+		keepDimensionLink("shop_id", "shop_name"),
+	)
 	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "goods_id"}},
-		DoUpdates: clause.AssignmentColumns(allProductColumnsFromRank()),
+		DoUpdates: updates,
 	}).Create(product).Error
 }
 
@@ -61,47 +88,53 @@ func allProductColumnsFromRank() []string {
 	}
 }
 
-// ListPage 实现分页、模糊查询和排序
-func (r *productRepo) ListPage(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*Product, int64, error) {
-	var products []*Product
-	var total int64
+// keepDimensionLink 生成维度外键的更新表达式：新值为空且名称未变时保留原有关联，名称变化时跟随新值
+func keepDimensionLink(idColumn, nameColumn string) clause.Assignment {
+	return clause.Assignment{
+		Column: clause.Column{Name: idColumn},
+		Value: gorm.Expr(fmt.Sprintf(
+			"COALESCE(EXCLUDED.%[1]s, CASE WHEN EXCLUDED.%[2]s = products.%[2]s THEN products.%[1]s END)",
+			idColumn, nameColumn)),
+	}
+}
 
-	db := r.db.WithContext(ctx).Model(&Product{})
+// productSortFields 是商品列表的排序白名单
+var productSortFields = SortFields{
+	"created_at":  "created_at",
+	"updated_at":  "updated_at",
+	"goods_price": "goods_price",
+}
 
-	// 模糊查询
-	if query != "" {
-		db = db.Where("goods_title LIKE ?", "%"+query+"%")
-	}
+// ListPage 实现分页、模糊查询、结构化过滤和白名单排序
+func (r *productRepo) ListPage(ctx context.Context, page *v1.PageRequest, query string, filter *v1.ProductFilter, sortBy string, sortOrder v1.SortOrder) ([]*Product, *v1.PageResponse, error) {
+	var products []*Product
 
-	// 获取总数
-	if err := db.Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
+	qb := newQueryBuilder(r.db.WithContext(ctx).Model(&Product{}), "goods_id").
+		Like("goods_title", query)
+	applyProductFilter(qb, r.db, filter)
 
-	// 排序逻辑重构
-	if sortBy != "" {
-		var order string
-		switch sortOrder {
-		case v1.SortOrder_ASC:
-			order = sortBy + " ASC"
-		case v1.SortOrder_DESC:
-			order = sortBy + " DESC"
-		default:
-			order = sortBy + " DESC"
-		}
-		db = db.Order(order)
-	} else {
-		// 默认排序
-		db = db.Order("updated_at DESC")
+	pageResp, err := qb.Sort(sortBy, sortOrder, productSortFields, "updated_at", true).
+		Page(page, &products)
+	if err != nil {
+		return nil, nil, err
 	}
+	return products, pageResp, nil
+}
 
-	// 分页
-	offset := (page - 1) * size
-	if err := db.Offset(offset).Limit(size).Find(&products).Error; err != nil {
-		return nil, 0, err
+// applyProductFilter 把商品的结构化过滤条件追加到 qb，列表和搜索共用
+func applyProductFilter(qb *queryBuilder, db *gorm.DB, filter *v1.ProductFilter) {
+	if filter == nil {
+		return
+	}
+	qb.Like("category_names", filter.Category).
+		Eq("brand_name", filter.BrandName).
+		Eq("shop_name", filter.ShopName).
+		Eq("brand_id", filter.BrandId).
+		Eq("shop_id", filter.ShopId).
+		DoubleRange("goods_price", filter.GoodsPrice)
+	if filter.CategoryId != 0 {
+		qb.Where("goods_id IN (?)", categoryGoodsSubquery(db, filter.CategoryId))
 	}
-
-	return products, total, nil
 }
 
 // CopyProductToDTO 将 data.Product 模型转换为 v1.ProductDTO
@@ -109,7 +142,7 @@ func CopyProductToDTO(p *Product) *v1.ProductDTO {
 	if p == nil {
 		return nil
 	}
-	return &v1.ProductDTO{
+	dto := &v1.ProductDTO{
 		GoodsId:         p.GoodsId,
 		CreatedAt:       p.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:       p.UpdatedAt.Format(time.RFC3339),
@@ -123,13 +156,20 @@ func CopyProductToDTO(p *Product) *v1.ProductDTO {
 		BrandName:       p.BrandName,
 		CategoryNames:   p.CategoryNames,
 	}
+	if p.BrandId != nil {
+		dto.BrandId = uint64(*p.BrandId)
+	}
+	if p.ShopId != nil {
+		dto.ShopId = uint64(*p.ShopId)
+	}
+	return dto
 }
 
 // Get finds a single product by its goods_id.
 func (r *productRepo) Get(ctx context.Context, goodsId string) (*Product, error) {
 	var product Product
 	if err := r.db.WithContext(ctx).Where("goods_id = ?", goodsId).First(&product).Error; err != nil {
-		return nil, err
+		return nil, wrapNotFound(err)
 	}
 	return &product, nil
 }
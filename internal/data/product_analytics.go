@@ -0,0 +1,152 @@
+package data
+
+import (
+	"context"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// ProductRankSummary 是某个商品在一段时间内的榜单聚合结果
+type ProductRankSummary struct {
+	VideoCount      int64
+	BloggerCount    int64
+	RankAppearances int64
+	FirstSeenDate   string
+	LastSeenDate    string
+	SalesCountLow   int64
+	SalesCountHigh  int64
+	TotalSalesLow   int64
+	TotalSalesHigh  int64
+}
+
+// ProductDailySales 是某个商品单期榜单上的销量汇总
+type ProductDailySales struct {
+	RankDate       string
+	VideoCount     int64
+	SalesCountLow  int64
+	SalesCountHigh int64
+	TotalSalesLow  int64
+	TotalSalesHigh int64
+}
+
+// ProductTrendSales 是带货某个商品的全部视频在某天的趋势增量汇总（来自 video_trends）
+type ProductTrendSales struct {
+	DateCode      int
+	IncSalesCount int64
+	IncSalesGmv   float64
+}
+
+// ProductTopVideo 是某个商品下按销售额排序的带货视频
+type ProductTopVideo struct {
+	AwemeId         string
+	AwemeDesc       string
+	AwemeCoverUrl   string
+	BloggerId       int64
+	BloggerName     string
+	RankAppearances int64
+	BestPosition    int
+	SalesCountLow   int64
+	SalesCountHigh  int64
+	TotalSalesLow   int64
+	TotalSalesHigh  int64
+}
+
+// productRankScope 限定某个商品在某周期、某日期范围内的榜单记录，日期格式为 "20060102"，为空时不限制
+func (r *productRepo) productRankScope(ctx context.Context, goodsID, periodType, startDate, endDate string) *gorm.DB {
+	db := r.db.WithContext(ctx).Model(&VideoRank{}).
+		Where("goods_id = ? AND period_type = ?", goodsID, periodType)
+	if startDate != "" {
+		db = db.Where("rank_date >= ?", startDate)
+	}
+	if endDate != "" {
+		db = db.Where("rank_date <= ?", endDate)
+	}
+	return db
+}
+
+// GetRankSummary 汇总商品的带货视频数、博主数、上榜次数、首末上榜日期和累计销量/销售额范围
+func (r *productRepo) GetRankSummary(ctx context.Context, goodsID, periodType, startDate, endDate string) (*ProductRankSummary, error) {
+	var summary ProductRankSummary
+	err := r.productRankScope(ctx, goodsID, periodType, startDate, endDate).
+		Select(`COUNT(DISTINCT aweme_id) AS video_count,
+			COUNT(DISTINCT blogger_id) AS blogger_count,
+			COUNT(*) AS rank_appearances,
+			COALESCE(MIN(rank_date), '') AS first_seen_date,
+			COALESCE(MAX(rank_date), '') AS last_seen_date,
+			COALESCE(SUM(sales_count_low), 0) AS sales_count_low,
+			COALESCE(SUM(sales_count_high), 0) AS sales_count_high,
+			COALESCE(SUM(total_sales_low), 0) AS total_sales_low,
+			COALESCE(SUM(total_sales_high), 0) AS total_sales_high`).
+		Scan(&summary).Error
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// ListDailySales 按 rank_date 汇总商品每期的销量/销售额范围，按日期升序
+func (r *productRepo) ListDailySales(ctx context.Context, goodsID, periodType, startDate, endDate string) ([]*ProductDailySales, error) {
+	var rows []*ProductDailySales
+	err := r.productRankScope(ctx, goodsID, periodType, startDate, endDate).
+		Select(`rank_date,
+			COUNT(DISTINCT aweme_id) AS video_count,
+			SUM(sales_count_low) AS sales_count_low,
+			SUM(sales_count_high) AS sales_count_high,
+			SUM(total_sales_low) AS total_sales_low,
+			SUM(total_sales_high) AS total_sales_high`).
+		Group("rank_date").
+		Order("rank_date ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ListTrendSales 按天汇总带货该商品的视频在 video_trends 中的销量和销售额增量，按日期升序
+func (r *productRepo) ListTrendSales(ctx context.Context, goodsID, startDate, endDate string) ([]*ProductTrendSales, error) {
+	db := r.db.WithContext(ctx).Model(&VideoTrend{}).
+		Where("aweme_id IN (?)", r.db.Model(&Video{}).Select("aweme_id").Where("goods_id = ?", goodsID))
+	if start, err := strconv.Atoi(startDate); err == nil {
+		db = db.Where("date_code >= ?", start)
+	}
+	if end, err := strconv.Atoi(endDate); err == nil {
+		db = db.Where("date_code <= ?", end)
+	}
+
+	var rows []*ProductTrendSales
+	err := db.Select("date_code, SUM(inc_sales_count) AS inc_sales_count, SUM(inc_sales_gmv) AS inc_sales_gmv").
+		Group("date_code").
+		Order("date_code ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ListTopVideos 按累计销售额范围高值倒序返回商品的带货视频
+func (r *productRepo) ListTopVideos(ctx context.Context, goodsID, periodType, startDate, endDate string, limit int) ([]*ProductTopVideo, error) {
+	var rows []*ProductTopVideo
+	err := r.productRankScope(ctx, goodsID, periodType, startDate, endDate).
+		Select(`aweme_id,
+			MAX(aweme_desc) AS aweme_desc,
+			MAX(aweme_cover_url) AS aweme_cover_url,
+			MAX(blogger_id) AS blogger_id,
+			MAX(blogger_name) AS blogger_name,
+			COUNT(*) AS rank_appearances,
+			COALESCE(MIN(NULLIF(rank_position, 0)), 0) AS best_position,
+			SUM(sales_count_low) AS sales_count_low,
+			SUM(sales_count_high) AS sales_count_high,
+			SUM(total_sales_low) AS total_sales_low,
+			SUM(total_sales_high) AS total_sales_high`).
+		Group("aweme_id").
+		Order("total_sales_high DESC, aweme_id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ProductSnapshot 商品每日快照，记录榜单数据中观测到的价格和佣金，避免维度表覆盖后丢失历史
+type ProductSnapshot struct {
+	ID              uint    `gorm:"primaryKey"`
+	GoodsId         string  `gorm:"size:1024;not null;uniqueIndex:uk_product_snapshot_date,priority:1"`
+	SnapshotDate    string  `gorm:"size:8;not null;uniqueIndex:uk_product_snapshot_date,priority:2;comment:快照日期 YYYYMMDD"`
+	GoodsPrice      float64 `gorm:"type:numeric"`
+	GoodsPriceRange string  `gorm:"size:255;not null;default:''"`
+	CosRatio        string  `gorm:"size:255;not null;default:''"`
+	CommissionPrice string  `gorm:"size:255;not null;default:''"`
+	SourceDataId    int64   `gorm:"index;comment:来源 source_data ID"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;type:timestamp"`
+}
+
+func (ProductSnapshot) TableName() string {
+	return "product_snapshots"
+}
+
+// UpsertSnapshots 按 (goods_id, snapshot_date) 写入快照，同一天重复写入时以最后一次为准
+func (r *productRepo) UpsertSnapshots(ctx context.Context, snapshots []*ProductSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "goods_id"}, {Name: "snapshot_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"goods_price", "goods_price_range", "cos_ratio", "commission_price", "source_data_id", "updated_at",
+		}),
+	}).CreateInBatches(snapshots, len(snapshots)).Error
+}
+
+// ListSnapshots 按日期升序返回商品在 [startDate, endDate] 内的快照，日期为空时不限制
+func (r *productRepo) ListSnapshots(ctx context.Context, goodsID, startDate, endDate string) ([]*ProductSnapshot, error) {
+	db := r.db.WithContext(ctx).Where("goods_id = ?", goodsID)
+	if startDate != "" {
+		db = db.Where("snapshot_date >= ?", startDate)
+	}
+	if endDate != "" {
+		db = db.Where("snapshot_date <= ?", endDate)
+	}
+	var snapshots []*ProductSnapshot
+	if err := db.Order("snapshot_date ASC").Find(&snapshots).Error; err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// backfillProductSnapshots 在快照表为空时，用历史日榜中的商品价格和佣金初始化快照，
+// 同一商品同一天有多条榜单记录时取最后写入的一条。
+func backfillProductSnapshots(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&ProductSnapshot{}).Limit(1).Count(&count).Error; err != nil || count > 0 {
+		return err
+	}
+	return db.Exec(`INSERT INTO product_snapshots (goods_id, snapshot_date, goods_price, goods_price_range, cos_ratio, commission_price, source_data_id, created_at, updated_at)
+		SELECT DISTINCT ON (goods_id, rank_date) goods_id, rank_date, goods_price, goods_price_range, cos_ratio, commission_price, source_data_id, NOW(), NOW()
+		FROM video_ranks
+		WHERE period_type = ? AND goods_id <> ''
+		ORDER BY goods_id, rank_date, id DESC
+		ON CONFLICT DO NOTHING`, RankPeriodDay).Error
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidSortField 表示请求的排序字段不在白名单内
+var ErrInvalidSortField = fmt.Errorf("invalid sort field")
+
+// SortFields 是排序字段白名单，key 为 API 中允许的 sort_by，value 为实际的列名
+type SortFields map[string]string
+
+// queryBuilder 是 video/product/blogger 等列表查询共用的条件构造器。
+// 所有过滤方法在参数为零值时都不追加条件，调用方可以直接把可选的过滤字段传进来。
+// pk 是表的唯一键列，排序时作为第二排序键保证顺序稳定，也是游标分页的 tie-breaker。
+type queryBuilder struct {
+	db  *gorm.DB
+	err error
+	pk  string
+
+	sortColumn string
+	sortDesc   bool
+}
+
+func newQueryBuilder(db *gorm.DB, pk string) *queryBuilder {
+	return &queryBuilder{db: db, pk: pk}
+}
+
+// Like 对列做模糊匹配
+func (b *queryBuilder) Like(column, keyword string) *queryBuilder {
+	if keyword != "" {
+		b.db = b.db.Where(column+" LIKE ?", "%"+keyword+"%")
+	}
+	return b
+}
+
+// Eq 对列做等值匹配，value 为零值时忽略
+func (b *queryBuilder) Eq(column string, value any) *queryBuilder {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return b
+		}
+	case int64:
+		if v == 0 {
+			return b
+		}
+	case uint64:
+		if v == 0 {
+			return b
+		}
+	}
+	b.db = b.db.Where(column+" = ?", value)
+	return b
+}
+
+// Int64Range 对列做闭区间过滤
+func (b *queryBuilder) Int64Range(column string, r *v1.Int64Range) *queryBuilder {
+	if r == nil {
+		return b
+	}
+	if r.Min != nil {
+		b.db = b.db.Where(column+" >= ?", r.GetMin())
+	}
+	if r.Max != nil {
+		b.db = b.db.Where(column+" <= ?", r.GetMax())
+	}
+	return b
+}
+
+// DoubleRange 对列做闭区间过滤
+func (b *queryBuilder) DoubleRange(column string, r *v1.DoubleRange) *queryBuilder {
+	if r == nil {
+		return b
+	}
+	if r.Min != nil {
+		b.db = b.db.Where(column+" >= ?", r.GetMin())
+	}
+	if r.Max != nil {
+		b.db = b.db.Where(column+" <= ?", r.GetMax())
+	}
+	return b
+}
+
+// TimeRange 对时间列做范围过滤，start/end 支持 RFC3339 或 "2006-01-02"；只有日期的 end 包含当天全天
+func (b *queryBuilder) TimeRange(column string, r *v1.TimeRange) *queryBuilder {
+	if r == nil || b.err != nil {
+		return b
+	}
+	if r.Start != "" {
+		start, _, err := parseFilterTime(r.Start)
+		if err != nil {
+			b.err = err
+			return b
+		}
+		b.db = b.db.Where(column+" >= ?", start)
+	}
+	if r.End != "" {
+		end, dateOnly, err := parseFilterTime(r.End)
+		if err != nil {
+			b.err = err
+			return b
+		}
+		if dateOnly {
+			b.db = b.db.Where(column+" < ?", end.AddDate(0, 0, 1))
+		} else {
+			b.db = b.db.Where(column+" <= ?", end)
+		}
+	}
+	return b
+}
+
+// Where 追加任意条件，用于子查询等无法用上面方法表达的场景
+func (b *queryBuilder) Where(query any, args ...any) *queryBuilder {
+	b.db = b.db.Where(query, args...)
+	return b
+}
+
+// Sort 按白名单排序，sortBy 为空时按 defaultColumn 排序；不在白名单内的字段返回 ErrInvalidSortField。
+// 排序总是追加 pk 作为第二排序键。
+func (b *queryBuilder) Sort(sortBy string, sortOrder v1.SortOrder, allowed SortFields, defaultColumn string, defaultDesc bool) *queryBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.sortColumn, b.sortDesc = defaultColumn, defaultDesc
+	if sortBy != "" {
+		column, ok := allowed[sortBy]
+		if !ok {
+			b.err = fmt.Errorf("%w: %s", ErrInvalidSortField, sortBy)
+			return b
+		}
+		b.sortColumn, b.sortDesc = column, sortOrder != v1.SortOrder_ASC
+	}
+
+	dir := " ASC"
+	if b.sortDesc {
+		dir = " DESC"
+	}
+	if b.sortColumn != b.pk {
+		b.db = b.db.Order(b.sortColumn + dir)
+	}
+	b.db = b.db.Order(b.pk + dir)
+	return b
+}
+
+// parseFilterTime 解析过滤条件中的时间，只有日期时按业务时区（Asia/Shanghai）解释
+func parseFilterTime(s string) (t time.Time, dateOnly bool, err error) {
+	loc, locErr := time.LoadLocation("Asia/Shanghai")
+	if locErr != nil {
+		loc = time.Local
+	}
+	if t, err = time.ParseInLocation("2006-01-02", s, loc); err == nil {
+		return t, true, nil
+	}
+	if t, err = time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("无效的时间格式 %q，应为 RFC3339 或 2006-01-02", s)
+}
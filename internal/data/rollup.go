@@ -0,0 +1,329 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 汇总维度，对应 daily_rollups.dimension
+const (
+	RollupDimensionAll        = "all"
+	RollupDimensionCategory   = "category"
+	RollupDimensionBrand      = "brand"
+	RollupDimensionShop       = "shop"
+	RollupDimensionBloggerTag = "blogger_tag"
+)
+
+// RollupDimensions 是支持的汇总维度，value 为生成 (rank_id, dimension_value) 的子查询，
+// 两个占位符依次为榜单周期和日期。品牌、店铺按商品关联的实体 ID 汇总，类目按商品所属类目及其全部上级类目的 ID 汇总，
+// 与 BrandService、ShopService 和类目过滤的口径一致；没有关联实体的榜单记录不计入对应维度。
+var RollupDimensions = map[string]string{
+	RollupDimensionAll: `SELECT r.id AS rank_id, '' AS dimension_value
+		FROM video_ranks r
+		WHERE r.period_type = ? AND r.rank_date = ?`,
+	RollupDimensionCategory: `SELECT DISTINCT r.id AS rank_id, CAST(a.id AS TEXT) AS dimension_value
+		FROM video_ranks r
+		JOIN product_categories pc ON pc.goods_id = r.goods_id
+		JOIN categories d ON d.id = pc.category_id
+		JOIN categories a ON ` + categorySubtreeCond("d", "a") + `
+		WHERE r.period_type = ? AND r.rank_date = ?`,
+	RollupDimensionBrand: `SELECT r.id AS rank_id, CAST(p.brand_id AS TEXT) AS dimension_value
+		FROM video_ranks r
+		JOIN products p ON p.goods_id = r.goods_id AND p.brand_id IS NOT NULL
+		WHERE r.period_type = ? AND r.rank_date = ?`,
+	RollupDimensionShop: `SELECT r.id AS rank_id, CAST(p.shop_id AS TEXT) AS dimension_value
+		FROM video_ranks r
+		JOIN products p ON p.goods_id = r.goods_id AND p.shop_id IS NOT NULL
+		WHERE r.period_type = ? AND r.rank_date = ?`,
+	RollupDimensionBloggerTag: `SELECT r.id AS rank_id, r.blogger_tag AS dimension_value
+		FROM video_ranks r
+		WHERE r.period_type = ? AND r.rank_date = ?`,
+}
+
+// rollupEntityDimensions 是维度值为实体 ID 的维度
+var rollupEntityDimensions = []string{RollupDimensionCategory, RollupDimensionBrand, RollupDimensionShop}
+
+// RollupSortFields 是按维度排行时允许的排序字段
+var RollupSortFields = SortFields{
+	"total_sales":     "total_sales_high",
+	"sales_count":     "sales_count_high",
+	"video_count":     "video_count",
+	"trend_sales_gmv": "trend_inc_sales_gmv",
+}
+
+// DailyRollup 按 (日期, 榜单周期, 维度, 维度值) 预聚合的榜单统计，供看板查询使用，
+// 由 build:daily_rollups 任务从 video_ranks 和 video_trends 重建。
+type DailyRollup struct {
+	ID             uint   `gorm:"primaryKey"`
+	RollupDate     string `gorm:"size:8;not null;uniqueIndex:uk_daily_rollup,priority:1;comment:榜单日期 YYYYMMDD"`
+	PeriodType     string `gorm:"size:16;not null;uniqueIndex:uk_daily_rollup,priority:2"`
+	Dimension      string `gorm:"size:32;not null;uniqueIndex:uk_daily_rollup,priority:3"`
+	DimensionValue string `gorm:"size:1024;not null;default:'';uniqueIndex:uk_daily_rollup,priority:4"`
+
+	VideoCount   int64 `gorm:"not null;default:0;comment:上榜视频数（去重）"`
+	GoodsCount   int64 `gorm:"not null;default:0;comment:上榜商品数（去重）"`
+	BloggerCount int64 `gorm:"not null;default:0;comment:上榜博主数（去重）"`
+	RankEntries  int64 `gorm:"not null;default:0;comment:榜单记录数"`
+
+	SalesCountLow  int64 `gorm:"not null;default:0"`
+	SalesCountHigh int64 `gorm:"not null;default:0"`
+	TotalSalesLow  int64 `gorm:"not null;default:0;comment:销售额范围低值（分）"`
+	TotalSalesHigh int64 `gorm:"not null;default:0;comment:销售额范围高值（分）"`
+
+	// 仅日榜有值：上榜视频当天在 video_trends 中的增量之和
+	TrendIncSalesCount int64   `gorm:"not null;default:0"`
+	TrendIncSalesGmv   float64 `gorm:"not null;default:0"`
+
+	UpdatedAt time.Time `gorm:"autoUpdateTime;type:timestamp"`
+}
+
+func (DailyRollup) TableName() string {
+	return "daily_rollups"
+}
+
+// RollupDirtyDate 标记需要重建汇总的 (榜单周期, 日期)，由 ETL 在写入榜单或趋势数据后登记。
+// 重建失败的日期按 rollupRetryBackoff 退避，退避期内不会被 ListDirty 返回，避免反复失败的日期占满批次。
+type RollupDirtyDate struct {
+	PeriodType string     `gorm:"primaryKey;size:16"`
+	RankDate   string     `gorm:"primaryKey;size:8"`
+	MarkedAt   time.Time  `gorm:"not null;type:timestamp"`
+	Attempts   int        `gorm:"not null;default:0;comment:连续重建失败次数"`
+	LastError  string     `gorm:"type:text;not null;default:''"`
+	RetryAfter *time.Time `gorm:"type:timestamp;index;comment:失败后下次允许重建的时间"`
+}
+
+func (RollupDirtyDate) TableName() string {
+	return "rollup_dirty_dates"
+}
+
+// RollupTotal 是某个维度值在一段时间内的汇总
+type RollupTotal struct {
+	DimensionValue     string
+	Days               int64
+	VideoCount         int64
+	RankEntries        int64
+	SalesCountLow      int64
+	SalesCountHigh     int64
+	TotalSalesLow      int64
+	TotalSalesHigh     int64
+	TrendIncSalesCount int64
+	TrendIncSalesGmv   float64
+}
+
+// RollupRepo 管理每日汇总表及其增量重建标记
+type RollupRepo interface {
+	// MarkDirty 登记需要重建的日期，重复登记会刷新登记时间
+	MarkDirty(ctx context.Context, periodType string, rankDates ...string) error
+	// ListDirty 按日期升序返回待重建的日期，处于失败退避期的日期不返回
+	ListDirty(ctx context.Context, limit int) ([]*RollupDirtyDate, error)
+	// ClearDirty 清除重建完成的标记；重建期间被再次登记的不会被清除
+	ClearDirty(ctx context.Context, dirty *RollupDirtyDate) error
+	// MarkFailed 记录重建失败并设置退避时间；重建期间被再次登记的不受影响，下次直接重试
+	MarkFailed(ctx context.Context, dirty *RollupDirtyDate, cause error) error
+	// Rebuild 在事务中重建某个周期某一天全部维度的汇总
+	Rebuild(ctx context.Context, periodType, rankDate string) error
+	// ListDaily 按日期升序查询某个维度的每日汇总，dimensionValue 为空时返回全部维度值
+	ListDaily(ctx context.Context, dimension, periodType, dimensionValue, startDate, endDate string) ([]*DailyRollup, error)
+	// ListTop 查询某个维度在一段时间内汇总后排名靠前的维度值
+	ListTop(ctx context.Context, dimension, periodType, startDate, endDate, sortBy string, limit int) ([]*RollupTotal, error)
+}
+
+type rollupRepo struct {
+	*Data
+}
+
+// NewRollupRepo .
+func NewRollupRepo(data *Data) RollupRepo {
+	return &rollupRepo{Data: data}
+}
+
+func (r *rollupRepo) MarkDirty(ctx context.Context, periodType string, rankDates ...string) error {
+	now := time.Now()
+	seen := make(map[string]struct{}, len(rankDates))
+	marks := make([]*RollupDirtyDate, 0, len(rankDates))
+	for _, d := range rankDates {
+		if _, ok := seen[d]; ok || d == "" {
+			continue
+		}
+		seen[d] = struct{}{}
+		marks = append(marks, &RollupDirtyDate{PeriodType: periodType, RankDate: d, MarkedAt: now})
+	}
+	if len(marks) == 0 {
+		return nil
+	}
+	// 数据有变化，重新登记时清除失败退避，立即重建
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "period_type"}, {Name: "rank_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"marked_at", "attempts", "last_error", "retry_after"}),
+	}).Create(&marks).Error
+}
+
+func (r *rollupRepo) ListDirty(ctx context.Context, limit int) ([]*RollupDirtyDate, error) {
+	var dirty []*RollupDirtyDate
+	err := r.db.WithContext(ctx).
+		Where("retry_after IS NULL OR retry_after <= ?", time.Now()).
+		Order("rank_date ASC, period_type ASC").
+		Limit(limit).
+		Find(&dirty).Error
+	if err != nil {
+		return nil, err
+	}
+	return dirty, nil
+}
+
+func (r *rollupRepo) ClearDirty(ctx context.Context, dirty *RollupDirtyDate) error {
+	return r.db.WithContext(ctx).
+		Where("period_type = ? AND rank_date = ? AND marked_at <= ?", dirty.PeriodType, dirty.RankDate, dirty.MarkedAt).
+		Delete(&RollupDirtyDate{}).Error
+}
+
+func (r *rollupRepo) MarkFailed(ctx context.Context, dirty *RollupDirtyDate, cause error) error {
+	attempts := dirty.Attempts + 1
+	retryAfter := time.Now().Add(rollupRetryBackoff(attempts))
+	return r.db.WithContext(ctx).Model(&RollupDirtyDate{}).
+		Where("period_type = ? AND rank_date = ? AND marked_at <= ?", dirty.PeriodType, dirty.RankDate, dirty.MarkedAt).
+		Updates(map[string]interface{}{
+			"attempts":    attempts,
+			"last_error":  cause.Error(),
+			"retry_after": retryAfter,
+		}).Error
+}
+
+const (
+	rollupRetryBaseDelay = 10 * time.Minute
+	rollupRetryMaxDelay  = 24 * time.Hour
+)
+
+// rollupRetryBackoff 返回第 attempts 次失败后的退避时间：从 10 分钟开始逐次翻倍，最长 24 小时
+func rollupRetryBackoff(attempts int) time.Duration {
+	delay := rollupRetryBaseDelay
+	for i := 1; i < attempts && delay < rollupRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, rollupRetryMaxDelay)
+}
+
+// Rebuild 先删除该日期的旧汇总，再按每个维度从 video_ranks 重新聚合。
+// 日榜会关联上榜视频当天的 video_trends 增量；同一视频带多个商品时会有多条榜单记录，
+// 趋势增量先按 (维度值, 视频) 去重再求和，每个视频在同一维度值下只计入一次。
+func (r *rollupRepo) Rebuild(ctx context.Context, periodType, rankDate string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("rollup_date = ? AND period_type = ?", rankDate, periodType).Delete(&DailyRollup{}).Error; err != nil {
+			return fmt.Errorf("删除旧汇总失败 (%s %s): %w", periodType, rankDate, err)
+		}
+		for dimension, source := range RollupDimensions {
+			err := tx.Exec(`WITH dims AS (`+source+`
+				), ranks AS (
+					SELECT d.dimension_value,
+						COUNT(DISTINCT r.aweme_id) AS video_count, COUNT(DISTINCT r.goods_id) AS goods_count,
+						COUNT(DISTINCT r.blogger_id) AS blogger_count, COUNT(*) AS rank_entries,
+						COALESCE(SUM(r.sales_count_low), 0) AS sales_count_low, COALESCE(SUM(r.sales_count_high), 0) AS sales_count_high,
+						COALESCE(SUM(r.total_sales_low), 0) AS total_sales_low, COALESCE(SUM(r.total_sales_high), 0) AS total_sales_high
+					FROM dims d
+					JOIN video_ranks r ON r.id = d.rank_id
+					GROUP BY d.dimension_value
+				), trends AS (
+					SELECT v.dimension_value,
+						SUM(t.inc_sales_count) AS inc_sales_count, SUM(t.inc_sales_gmv) AS inc_sales_gmv
+					FROM (
+						SELECT DISTINCT d.dimension_value, r.aweme_id, r.period_type, r.rank_date
+						FROM dims d
+						JOIN video_ranks r ON r.id = d.rank_id
+					) v
+					JOIN video_trends t
+						ON v.period_type = ? AND t.aweme_id = v.aweme_id AND t.date_code::text = v.rank_date
+					GROUP BY v.dimension_value
+				)
+				INSERT INTO daily_rollups (rollup_date, period_type, dimension, dimension_value,
+					video_count, goods_count, blogger_count, rank_entries,
+					sales_count_low, sales_count_high, total_sales_low, total_sales_high,
+					trend_inc_sales_count, trend_inc_sales_gmv, updated_at)
+				SELECT CAST(? AS TEXT), CAST(? AS TEXT), CAST(? AS TEXT), rk.dimension_value,
+					rk.video_count, rk.goods_count, rk.blogger_count, rk.rank_entries,
+					rk.sales_count_low, rk.sales_count_high, rk.total_sales_low, rk.total_sales_high,
+					COALESCE(tr.inc_sales_count, 0), COALESCE(tr.inc_sales_gmv, 0), NOW()
+				FROM ranks rk
+				LEFT JOIN trends tr ON tr.dimension_value = rk.dimension_value`,
+				periodType, rankDate, RankPeriodDay, rankDate, periodType, dimension).Error
+			if err != nil {
+				return fmt.Errorf("重建 %s 维度汇总失败 (%s %s): %w", dimension, periodType, rankDate, err)
+			}
+		}
+		return nil
+	})
+}
+
+// migrateRollupDimensionValues 处理按名称汇总的旧数据：品牌、店铺、类目的维度值改为实体 ID 后，
+// 旧行无法与新行合并，删除旧行并把对应日期登记为待重建。
+func migrateRollupDimensionValues(db *gorm.DB) error {
+	legacy := "dimension IN ? AND dimension_value !~ '^[0-9]+$'"
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`INSERT INTO rollup_dirty_dates (period_type, rank_date, marked_at)
+			SELECT DISTINCT period_type, rollup_date, NOW() FROM daily_rollups WHERE `+legacy+`
+			ON CONFLICT (period_type, rank_date) DO UPDATE SET marked_at = EXCLUDED.marked_at`,
+			rollupEntityDimensions).Error
+		if err != nil {
+			return fmt.Errorf("登记待重建的汇总日期失败: %w", err)
+		}
+		if err := tx.Where(legacy, rollupEntityDimensions).Delete(&DailyRollup{}).Error; err != nil {
+			return fmt.Errorf("删除按名称汇总的旧数据失败: %w", err)
+		}
+		return nil
+	})
+}
+
+// rollupScope 限定某个维度、周期和日期范围内的汇总行，日期为空时不限制
+func (r *rollupRepo) rollupScope(ctx context.Context, dimension, periodType, startDate, endDate string) *gorm.DB {
+	db := r.db.WithContext(ctx).Model(&DailyRollup{}).
+		Where("dimension = ? AND period_type = ?", dimension, periodType)
+	if startDate != "" {
+		db = db.Where("rollup_date >= ?", startDate)
+	}
+	if endDate != "" {
+		db = db.Where("rollup_date <= ?", endDate)
+	}
+	return db
+}
+
+func (r *rollupRepo) ListDaily(ctx context.Context, dimension, periodType, dimensionValue, startDate, endDate string) ([]*DailyRollup, error) {
+	db := r.rollupScope(ctx, dimension, periodType, startDate, endDate)
+	if dimensionValue != "" {
+		db = db.Where("dimension_value = ?", dimensionValue)
+	}
+	var rows []*DailyRollup
+	if err := db.Order("rollup_date ASC, dimension_value ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *rollupRepo) ListTop(ctx context.Context, dimension, periodType, startDate, endDate, sortBy string, limit int) ([]*RollupTotal, error) {
+	column, ok := RollupSortFields[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSortField, sortBy)
+	}
+	var rows []*RollupTotal
+	err := r.rollupScope(ctx, dimension, periodType, startDate, endDate).
+		Select(`dimension_value,
+			COUNT(*) AS days,
+			SUM(video_count) AS video_count,
+			SUM(rank_entries) AS rank_entries,
+			SUM(sales_count_low) AS sales_count_low,
+			SUM(sales_count_high) AS sales_count_high,
+			SUM(total_sales_low) AS total_sales_low,
+			SUM(total_sales_high) AS total_sales_high,
+			SUM(trend_inc_sales_count) AS trend_inc_sales_count,
+			SUM(trend_inc_sales_gmv) AS trend_inc_sales_gmv`).
+		Group("dimension_value").
+		Order(column + " DESC, dimension_value ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
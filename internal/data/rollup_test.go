@@ -0,0 +1,26 @@
+package data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRollupRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: 10 * time.Minute},
+		{attempts: 1, want: 10 * time.Minute},
+		{attempts: 2, want: 20 * time.Minute},
+		{attempts: 4, want: 80 * time.Minute},
+		{attempts: 8, want: 1280 * time.Minute},
+		{attempts: 9, want: 24 * time.Hour},
+		{attempts: 1000, want: 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := rollupRetryBackoff(tt.attempts); got != tt.want {
+			t.Errorf("rollupRetryBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/gorm"
+)
+
+// searchIndexes 是全文检索依赖的 pg_trgm GIN 索引。
+// 三元组索引同时加速 LIKE/ILIKE '%q%' 和相似度运算符，对中文这类不分词的文本也有效，
+// 列表接口里已有的模糊查询也会因此走索引。
+var searchIndexes = []struct {
+	name, table, column string
+}{
+	{"idx_videos_aweme_desc_trgm", "videos", "aweme_desc"},
+	{"idx_products_goods_title_trgm", "products", "goods_title"},
+	{"idx_bloggers_blogger_name_trgm", "bloggers", "blogger_name"},
+}
+
+// createSearchIndexes 启用 pg_trgm 扩展并创建检索索引，需要在 AutoMigrate 之后执行
+func createSearchIndexes(db *gorm.DB) error {
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return err
+	}
+	for _, idx := range searchIndexes {
+		sql := "CREATE INDEX IF NOT EXISTS " + idx.name + " ON " + idx.table +
+			" USING gin (" + idx.column + " gin_trgm_ops)"
+		if err := db.Exec(sql).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VideoSearchHit 视频搜索结果
+type VideoSearchHit struct {
+	Video
+	Score float64
+}
+
+// ProductSearchHit 商品搜索结果
+type ProductSearchHit struct {
+	Product
+	Score float64
+}
+
+// BloggerSearchHit 博主搜索结果
+type BloggerSearchHit struct {
+	Blogger
+	Score float64
+}
+
+// SearchRepo 基于 pg_trgm 的全文检索
+type SearchRepo interface {
+	SearchVideos(ctx context.Context, query string, filter *v1.VideoFilter, limit int) ([]*VideoSearchHit, error)
+	SearchProducts(ctx context.Context, query string, filter *v1.ProductFilter, limit int) ([]*ProductSearchHit, error)
+	SearchBloggers(ctx context.Context, query string, filter *v1.BloggerFilter, limit int) ([]*BloggerSearchHit, error)
+}
+
+type searchRepo struct {
+	*Data
+}
+
+// NewSearchRepo .
+func NewSearchRepo(data *Data) SearchRepo {
+	return &searchRepo{Data: data}
+}
+
+func (r *searchRepo) SearchVideos(ctx context.Context, query string, filter *v1.VideoFilter, limit int) ([]*VideoSearchHit, error) {
+	var hits []*VideoSearchHit
+	qb := r.match(ctx, "videos", "aweme_desc", query)
+	applyVideoFilter(qb, r.db, filter)
+	if err := r.find(qb, limit, &hits); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+func (r *searchRepo) SearchProducts(ctx context.Context, query string, filter *v1.ProductFilter, limit int) ([]*ProductSearchHit, error) {
+	var hits []*ProductSearchHit
+	qb := r.match(ctx, "products", "goods_title", query)
+	applyProductFilter(qb, r.db, filter)
+	if err := r.find(qb, limit, &hits); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+func (r *searchRepo) SearchBloggers(ctx context.Context, query string, filter *v1.BloggerFilter, limit int) ([]*BloggerSearchHit, error) {
+	var hits []*BloggerSearchHit
+	qb := r.match(ctx, "bloggers", "blogger_name", query)
+	applyBloggerFilter(qb, filter)
+	if err := r.find(qb, limit, &hits); err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
+
+// match 构造检索条件和相关度得分。
+// 命中条件为子串包含（ILIKE）或词相似度超过 pg_trgm.word_similarity_threshold（<% 运算符，容忍错别字）；
+// 得分 = 子串命中加 1 分 + word_similarity，保证包含完整关键字的结果排在仅相似的结果之前。
+func (r *searchRepo) match(ctx context.Context, table, column, query string) *queryBuilder {
+	pattern := "%" + escapeLike(query) + "%"
+	db := r.db.WithContext(ctx).Table(table).
+		Select(table+".*, (CASE WHEN "+column+" ILIKE ? THEN 1 ELSE 0 END) + word_similarity(?, "+column+") AS score", pattern, query).
+		Where(column+" ILIKE ? OR ? <% "+column, pattern, query)
+	return newQueryBuilder(db, "")
+}
+
+func (r *searchRepo) find(qb *queryBuilder, limit int, dest any) error {
+	if qb.err != nil {
+		return qb.err
+	}
+	return qb.db.Order("score DESC").Limit(limit).Find(dest).Error
+}
+
+// escapeLike 转义 LIKE 模式中的通配符，使关键字按字面匹配
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
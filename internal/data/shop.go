@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/gorm"
+)
+
+// Shop 店铺维度表，由榜单 ETL 根据商品的 shop_name 归并生成
+type Shop struct {
+	NamedDimension
+}
+
+func (Shop) TableName() string {
+	return "shops"
+}
+
+// ShopRepo 管理店铺维度及其榜单分析查询，日期格式为 "20060102"
+type ShopRepo interface {
+	// Ensure 返回名称对应的店铺 ID，不存在时创建；名称为空时返回 nil
+	Ensure(ctx context.Context, name string) (*uint, error)
+	// Get 查询单个店铺，不存在时返回 ErrNotFound
+	Get(ctx context.Context, id uint) (*Shop, error)
+	ListPage(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) ([]*DimensionWithCount, *v1.PageResponse, error)
+	// ListNameVariants 返回归并到该店铺下的全部原始名称
+	ListNameVariants(ctx context.Context, id uint) ([]string, error)
+	// CountProducts 统计归并到该店铺下的商品数
+	CountProducts(ctx context.Context, id uint) (int64, error)
+
+	GetSalesSummary(ctx context.Context, id uint, periodType, startDate, endDate string) (*DimensionSalesSummary, error)
+	ListDailySales(ctx context.Context, id uint, periodType, startDate, endDate string) ([]*DimensionDailySales, error)
+	ListTopProducts(ctx context.Context, id uint, periodType, startDate, endDate string, limit int) ([]*DimensionTopProduct, error)
+}
+
+type shopRepo struct {
+	*Data
+	store *namedDimensionStore
+}
+
+// NewShopRepo .
+func NewShopRepo(data *Data) ShopRepo {
+	return &shopRepo{Data: data, store: newShopStore(data.db)}
+}
+
+func newShopStore(db *gorm.DB) *namedDimensionStore {
+	return &namedDimensionStore{db: db, table: Shop{}.TableName(), productColumn: "shop_id", nameColumn: "shop_name"}
+}
+
+func (r *shopRepo) Ensure(ctx context.Context, name string) (*uint, error) {
+	return r.store.ensure(ctx, name)
+}
+
+func (r *shopRepo) Get(ctx context.Context, id uint) (*Shop, error) {
+	row, err := r.store.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &Shop{NamedDimension: *row}, nil
+}
+
+func (r *shopRepo) ListPage(ctx context.Context, page *v1.PageRequest, query, sortBy string, sortOrder v1.SortOrder) ([]*DimensionWithCount, *v1.PageResponse, error) {
+	return r.store.listPage(ctx, page, query, sortBy, sortOrder)
+}
+
+func (r *shopRepo) ListNameVariants(ctx context.Context, id uint) ([]string, error) {
+	return r.store.nameVariants(ctx, id)
+}
+
+func (r *shopRepo) CountProducts(ctx context.Context, id uint) (int64, error) {
+	return r.store.countProducts(ctx, id)
+}
+
+func (r *shopRepo) GetSalesSummary(ctx context.Context, id uint, periodType, startDate, endDate string) (*DimensionSalesSummary, error) {
+	return r.store.salesSummary(ctx, id, periodType, startDate, endDate)
+}
+
+func (r *shopRepo) ListDailySales(ctx context.Context, id uint, periodType, startDate, endDate string) ([]*DimensionDailySales, error) {
+	return r.store.dailySales(ctx, id, periodType, startDate, endDate)
+}
+
+func (r *shopRepo) ListTopProducts(ctx context.Context, id uint, periodType, startDate, endDate string, limit int) ([]*DimensionTopProduct, error) {
+	return r.store.topProducts(ctx, id, periodType, startDate, endDate, limit)
+}
@@ -7,27 +7,40 @@ import (
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 // 在这里定义data_type，全部地方都适用这里的定义
 const (
-	DataTypeVideoRank            = "video_rank"
+	DataTypeVideoRank            = "video_rank" // 榜单类型前缀，实际写入的是 video_rank_{period}，见 VideoRankDataType
 	DataTypeVideoDetail          = "video_detail"
 	DataTypeVideoSummaryHeadless = "video_summary_headless"
 	DataTypeVideoTrendHeadless   = "video_trend_headless"
+	DataTypeVideoComment         = "video_comment"         // 评论列表的一页，entity_id 为 aweme_id
+	DataTypeVideoCommentKeyword  = "video_comment_keyword" // 评论关键词（分词）统计，entity_id 为 aweme_id
+	DataTypeVideoAudience        = "video_audience"        // 视频观众画像，entity_id 为 aweme_id
+	DataTypeBloggerAudience      = "blogger_audience"      // 博主粉丝画像，entity_id 为 blogger_id
 )
 
 // SourceDataRepo 是Biz层依赖的Data层接口，由 data/source_data.go 实现
 type SourceDataRepo interface {
 	Save(context.Context, *v1.SourceData) (*v1.SourceData, error)
-	UpdateStatus(ctx context.Context, id int64, status int32) error
+	// UpdateStatus 按状态机更新处理状态，不允许的流转返回 ErrInvalidStatusTransition
+	UpdateStatus(ctx context.Context, id int64, status SourceDataStatus) error
 
 	FindUnprocessed(ctx context.Context, dataType string) ([]*v1.SourceData, error)
-	// UpdateStatusAndLog 原子性地更新状态和处理日志
-	UpdateStatusAndLog(ctx context.Context, id int64, status int32, log string) error
+	// UpdateStatusAndLog 原子性地更新状态和处理日志，同样受状态机约束
+	UpdateStatusAndLog(ctx context.Context, id int64, status SourceDataStatus, log string) error
+	// MarkForReprocess 显式地将已处理/已过滤/出错的数据重置为未处理，返回实际重置的行数
+	MarkForReprocess(ctx context.Context, ids []int64) (int64, error)
+	// CountByStatus 按数据类型和状态分组统计数量，dataType 为空时统计全部类型
+	CountByStatus(ctx context.Context, dataType string) ([]*SourceDataStatusCount, error)
+	// FindByFilter 按重放条件查找原始数据的轻量信息（不含 raw_content）
+	FindByFilter(ctx context.Context, filter *SourceDataFilter) ([]*SourceDataBrief, error)
+	// FindByIDs 根据ID列表查找原始数据
+	FindByIDs(ctx context.Context, ids []int64) ([]*v1.SourceData, error)
 	// FindPartiallyCollectedEntityIDs 查找在指定时间后，只采集了部分数据类型的实体ID列表。
 	FindPartiallyCollectedEntityIDs(ctx context.Context, since time.Time, dataTypes []string) ([]string, error)
-	ListAllCollectedEntityIDs(ctx context.Context, dataTypes []string) ([]string, error)
 	// FindLatestByTypeAndEntityID 根据类型和实体ID查找最新的源数据记录
 	FindLatestByTypeAndEntityID(ctx context.Context, dataType string, entityId string) (*SourceData, error)
 }
@@ -36,16 +49,16 @@ type SourceDataRepo interface {
 // It is the Data Object (DO).
 // SourceData is the GORM model for storing raw data from various providers.
 type SourceData struct {
-	ID            int64     `gorm:"primaryKey"`
-	ProviderName  string    `gorm:"type:varchar(255);not null;index"`
-	DataType      string    `gorm:"type:varchar(255);not null;index"`
-	EntityId      string    `gorm:"type:varchar(255);index"`  // 可选，关联的主要实体ID，不同的数据类型可能有不同的ID
-	Status        int32     `gorm:"not null;default:0;index"` // 0: unprocessed, 1: processed, -1: error
-	FetchedAt     time.Time `gorm:"autoCreateTime;type:timestamp"`
-	Date          string    `gorm:"type:varchar(10);not null;index"`
-	RawContent    string    `gorm:"type:text"`
-	ProcessingLog string    `gorm:"type:text"`          // 存储ETL处理过程中的错误信息
-	Retries       int       `gorm:"not null;default:0"` // 重试次数
+	ID            int64            `gorm:"primaryKey"`
+	ProviderName  string           `gorm:"type:varchar(255);not null;index"`
+	DataType      string           `gorm:"type:varchar(255);not null;index"`
+	EntityId      string           `gorm:"type:varchar(255);index"`  // 可选，关联的主要实体ID，不同的数据类型可能有不同的ID
+	Status        SourceDataStatus `gorm:"not null;default:0;index"` // 见 SourceDataStatus
+	FetchedAt     time.Time        `gorm:"autoCreateTime;type:timestamp"`
+	Date          string           `gorm:"type:varchar(10);not null;index"`
+	RawContent    string           `gorm:"type:text"`
+	ProcessingLog string           `gorm:"type:text"`          // 存储ETL处理过程中的错误信息
+	Retries       int              `gorm:"not null;default:0"` // 重试次数
 
 	// --- 新增的请求上下文元数据 ---
 	RequestMethod  string `gorm:"type:varchar(10)"` // "GET", "POST", etc.
@@ -58,6 +71,56 @@ func (SourceData) TableName() string {
 	return "source_data"
 }
 
+// SourceDataFilter 描述了一次重放（reprocess）所选择的原始数据范围，各条件之间为 AND 关系
+type SourceDataFilter struct {
+	IDs          []int64
+	DataType     string
+	StartDate    string // 对应 source_data.date，格式 "20060102"，包含
+	EndDate      string // 对应 source_data.date，格式 "20060102"，包含
+	ProviderName string
+	Statuses     []SourceDataStatus
+	Limit        int
+}
+
+// Validate 校验日期为 YYYYMMDD 格式、状态为已定义的取值。
+// source_data.date 按字符串比较，其他格式的日期不会报错，只会选中错误的范围，因此必须在查询前拒绝。
+func (f *SourceDataFilter) Validate() error {
+	for _, date := range []string{f.StartDate, f.EndDate} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("20060102", date); err != nil {
+			return fmt.Errorf("无效的日期 %q，应为 YYYYMMDD 格式", date)
+		}
+	}
+	for _, st := range f.Statuses {
+		if !st.IsValid() {
+			return fmt.Errorf("未知的状态 %d", int32(st))
+		}
+	}
+	return nil
+}
+
+// IsEmpty 判断过滤条件是否为空，空条件意味着全表，调用方应拒绝
+func (f *SourceDataFilter) IsEmpty() bool {
+	return len(f.IDs) == 0 && f.DataType == "" && f.StartDate == "" && f.EndDate == "" &&
+		f.ProviderName == "" && len(f.Statuses) == 0
+}
+
+// SourceDataBrief 是不含原始内容的原始数据摘要，用于重放前的预览与统计
+type SourceDataBrief struct {
+	ID       int64
+	DataType string
+	Status   SourceDataStatus
+}
+
+// SourceDataStatusCount 是按数据类型和状态分组的统计结果
+type SourceDataStatusCount struct {
+	DataType string
+	Status   SourceDataStatus
+	Count    int64
+}
+
 type sourceDataRepo struct {
 	data *Data
 	log  *log.Helper
@@ -79,7 +142,7 @@ func CopySourceDataToDO(s *v1.SourceData) *SourceData {
 		ProviderName:   s.ProviderName,
 		DataType:       s.DataType,
 		EntityId:       s.EntityId,
-		Status:         s.Status,
+		Status:         SourceDataStatusFromProto(s.Status),
 		FetchedAt:      fetchedAt,
 		Date:           s.Date,
 		RawContent:     s.RawContent,
@@ -98,7 +161,7 @@ func CopySourceDataToDTO(s *SourceData) *v1.SourceData {
 		ProviderName:   s.ProviderName,
 		DataType:       s.DataType,
 		EntityId:       s.EntityId,
-		Status:         s.Status,
+		Status:         s.Status.ToProto(),
 		FetchedAt:      s.FetchedAt.Format(time.DateTime),
 		Date:           s.Date,
 		RawContent:     s.RawContent,
@@ -120,16 +183,76 @@ func (r *sourceDataRepo) Save(ctx context.Context, s *v1.SourceData) (*v1.Source
 }
 
 // UpdateStatus 更新原始数据的处理状态
-func (r *sourceDataRepo) UpdateStatus(ctx context.Context, id int64, status int32) error {
-	return r.data.db.WithContext(ctx).Model(&SourceData{}).Where("id = ?", id).Update("status", status).Error
+func (r *sourceDataRepo) UpdateStatus(ctx context.Context, id int64, status SourceDataStatus) error {
+	return r.transition(ctx, id, status, map[string]interface{}{
+		"status": status,
+	})
 }
 
 // UpdateStatusAndLog 更新原始数据的处理状态和日志
-func (r *sourceDataRepo) UpdateStatusAndLog(ctx context.Context, id int64, status int32, log string) error {
-	return r.data.db.WithContext(ctx).Model(&SourceData{}).Where("id = ?", id).Updates(map[string]interface{}{
+func (r *sourceDataRepo) UpdateStatusAndLog(ctx context.Context, id int64, status SourceDataStatus, log string) error {
+	return r.transition(ctx, id, status, map[string]interface{}{
 		"status":         status,
 		"processing_log": log,
-	}).Error
+	})
+}
+
+// transition 以条件更新的方式执行状态流转：只有当前状态允许流转到目标状态时才会更新，
+// 避免 "先查后改" 带来的并发问题。
+func (r *sourceDataRepo) transition(ctx context.Context, id int64, to SourceDataStatus, updates map[string]interface{}) error {
+	from := allowedSourceStatuses(to)
+	if len(from) == 0 {
+		return fmt.Errorf("%w: 任何状态都不能直接流转到 %s", ErrInvalidStatusTransition, to)
+	}
+
+	result := r.data.db.WithContext(ctx).Model(&SourceData{}).
+		Where("id = ? AND status IN ?", id, from).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	// 没有行被更新：要么记录不存在，要么当前状态不允许流转
+	var current SourceData
+	if err := r.data.db.WithContext(ctx).Select("id", "status").Where("id = ?", id).First(&current).Error; err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: source_data %d 从 %s 到 %s", ErrInvalidStatusTransition, id, current.Status, to)
+}
+
+// MarkForReprocess 将指定的原始数据重置为未处理，以便 ETL 重新处理。
+// 只有已处理、已过滤、出错的数据会被重置，未处理的数据保持不变。
+func (r *sourceDataRepo) MarkForReprocess(ctx context.Context, ids []int64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := r.data.db.WithContext(ctx).Model(&SourceData{}).
+		Where("id IN ? AND status IN ?", ids, reprocessableStatuses).
+		Updates(map[string]interface{}{
+			"status":  SourceDataStatusUnprocessed,
+			"retries": gorm.Expr("retries + 1"),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("重置原始数据状态失败: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// CountByStatus 按数据类型和状态分组统计数量
+func (r *sourceDataRepo) CountByStatus(ctx context.Context, dataType string) ([]*SourceDataStatusCount, error) {
+	var counts []*SourceDataStatusCount
+	db := r.data.db.WithContext(ctx).Model(&SourceData{}).
+		Select("data_type, status, COUNT(*) AS count")
+	if dataType != "" {
+		db = db.Where("data_type = ?", dataType)
+	}
+	if err := db.Group("data_type, status").Order("data_type, status").Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("按状态统计原始数据失败: %w", err)
+	}
+	return counts, nil
 }
 
 // FindPartiallyCollectedEntityIDs 查找在指定时间后，只采集了部分数据类型的实体ID列表。
@@ -153,7 +276,7 @@ func (r *sourceDataRepo) FindPartiallyCollectedEntityIDs(ctx context.Context, si
 // FindUnprocessed 查找所有未处理的数据
 func (r *sourceDataRepo) FindUnprocessed(ctx context.Context, dataType string) ([]*v1.SourceData, error) {
 	var models []*SourceData
-	if err := r.data.db.WithContext(ctx).Where("status = ? AND data_type = ?", 0, dataType).Find(&models).Error; err != nil {
+	if err := r.data.db.WithContext(ctx).Where("status = ? AND data_type = ?", SourceDataStatusUnprocessed, dataType).Find(&models).Error; err != nil {
 		return nil, err
 	}
 	var result []*v1.SourceData
@@ -163,21 +286,6 @@ func (r *sourceDataRepo) FindUnprocessed(ctx context.Context, dataType string) (
 	return result, nil
 }
 
-// ListAllCollectedEntityIDs 获取所有被 headless 服务采集过的视频ID列表。
-func (r *sourceDataRepo) ListAllCollectedEntityIDs(ctx context.Context, dataTypes []string) ([]string, error) {
-	var entityIDs []string
-
-	err := r.data.db.WithContext(ctx).Model(&SourceData{}).
-		Select("DISTINCT entity_id").
-		Where("data_type IN ?", dataTypes).
-		Pluck("entity_id", &entityIDs).Error
-
-	if err != nil {
-		return nil, fmt.Errorf("获取所有已采集的实体ID失败: %w", err)
-	}
-	return entityIDs, nil
-}
-
 // FindLatestByTypeAndEntityID 根据类型和实体ID查找最新的源数据记录
 func (r *sourceDataRepo) FindLatestByTypeAndEntityID(ctx context.Context, dataType string, entityId string) (*SourceData, error) {
 	var sourceData SourceData
@@ -190,3 +298,50 @@ func (r *sourceDataRepo) FindLatestByTypeAndEntityID(ctx context.Context, dataTy
 	}
 	return &sourceData, nil
 }
+
+// FindByFilter 按重放条件查找原始数据的轻量信息，按ID升序返回以保证重放顺序与采集顺序一致
+func (r *sourceDataRepo) FindByFilter(ctx context.Context, filter *SourceDataFilter) ([]*SourceDataBrief, error) {
+	var briefs []*SourceDataBrief
+	db := r.data.db.WithContext(ctx).Model(&SourceData{}).Select("id", "data_type", "status")
+	if len(filter.IDs) > 0 {
+		db = db.Where("id IN ?", filter.IDs)
+	}
+	if filter.DataType != "" {
+		db = db.Where("data_type = ?", filter.DataType)
+	}
+	if filter.StartDate != "" {
+		db = db.Where("date >= ?", filter.StartDate)
+	}
+	if filter.EndDate != "" {
+		db = db.Where("date <= ?", filter.EndDate)
+	}
+	if filter.ProviderName != "" {
+		db = db.Where("provider_name = ?", filter.ProviderName)
+	}
+	if len(filter.Statuses) > 0 {
+		db = db.Where("status IN ?", filter.Statuses)
+	}
+	if filter.Limit > 0 {
+		db = db.Limit(filter.Limit)
+	}
+	if err := db.Order("id ASC").Scan(&briefs).Error; err != nil {
+		return nil, fmt.Errorf("按条件查找原始数据失败: %w", err)
+	}
+	return briefs, nil
+}
+
+// FindByIDs 根据ID列表查找原始数据
+func (r *sourceDataRepo) FindByIDs(ctx context.Context, ids []int64) ([]*v1.SourceData, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var models []*SourceData
+	if err := r.data.db.WithContext(ctx).Where("id IN ?", ids).Order("id ASC").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	result := make([]*v1.SourceData, 0, len(models))
+	for _, m := range models {
+		result = append(result, CopySourceDataToDTO(m))
+	}
+	return result, nil
+}
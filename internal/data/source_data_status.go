@@ -0,0 +1,92 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+)
+
+// SourceDataStatus 是 source_data.status 的类型化表示，取值与 v1.SourceDataStatus 一一对应
+type SourceDataStatus int32
+
+const (
+	SourceDataStatusError       SourceDataStatus = -1 // 采集或处理出错
+	SourceDataStatusUnprocessed SourceDataStatus = 0  // 未处理，等待 ETL
+	SourceDataStatusProcessed   SourceDataStatus = 1  // 已处理
+	SourceDataStatusFiltered    SourceDataStatus = 2  // 已过滤，不满足入库条件
+)
+
+// ErrInvalidStatusTransition 表示请求的状态流转不在状态机允许的范围内
+var ErrInvalidStatusTransition = errors.New("invalid source_data status transition")
+
+// sourceDataTransitions 定义了 ETL 正常流程中允许的状态流转（from -> to）。
+// 已处理、已过滤、出错的数据不能回到未处理，只能通过 MarkForReprocess 显式重置。
+var sourceDataTransitions = map[SourceDataStatus][]SourceDataStatus{
+	SourceDataStatusUnprocessed: {SourceDataStatusProcessed, SourceDataStatusFiltered, SourceDataStatusError},
+}
+
+// reprocessableStatuses 是允许被显式重置为未处理的状态
+var reprocessableStatuses = []SourceDataStatus{
+	SourceDataStatusProcessed,
+	SourceDataStatusFiltered,
+	SourceDataStatusError,
+}
+
+// CanTransition 判断状态是否允许从 from 流转到 to
+func (s SourceDataStatus) CanTransition(to SourceDataStatus) bool {
+	for _, allowed := range sourceDataTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedSourceStatuses 返回允许流转到 to 的所有来源状态
+func allowedSourceStatuses(to SourceDataStatus) []SourceDataStatus {
+	var from []SourceDataStatus
+	for s, targets := range sourceDataTransitions {
+		for _, t := range targets {
+			if t == to {
+				from = append(from, s)
+			}
+		}
+	}
+	return from
+}
+
+// IsValid 判断状态是否为已定义的取值
+func (s SourceDataStatus) IsValid() bool {
+	switch s {
+	case SourceDataStatusError, SourceDataStatusUnprocessed, SourceDataStatusProcessed, SourceDataStatusFiltered:
+		return true
+	}
+	return false
+}
+
+// String 返回状态的可读名称，用于日志和错误信息
+func (s SourceDataStatus) String() string {
+	switch s {
+	case SourceDataStatusError:
+		return "error"
+	case SourceDataStatusUnprocessed:
+		return "unprocessed"
+	case SourceDataStatusProcessed:
+		return "processed"
+	case SourceDataStatusFiltered:
+		return "filtered"
+	default:
+		return fmt.Sprintf("unknown(%d)", int32(s))
+	}
+}
+
+// ToProto 将数据层状态转换为 API 层枚举
+func (s SourceDataStatus) ToProto() v1.SourceDataStatus {
+	return v1.SourceDataStatus(s)
+}
+
+// SourceDataStatusFromProto 将 API 层枚举转换为数据层状态
+func SourceDataStatusFromProto(s v1.SourceDataStatus) SourceDataStatus {
+	return SourceDataStatus(s)
+}
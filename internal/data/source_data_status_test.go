@@ -0,0 +1,75 @@
+package data
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSourceDataStatusCanTransition(t *testing.T) {
+	tests := []struct {
+		name string
+		from SourceDataStatus
+		to   SourceDataStatus
+		want bool
+	}{
+		{name: "unprocessed to processed", from: SourceDataStatusUnprocessed, to: SourceDataStatusProcessed, want: true},
+		{name: "unprocessed to filtered", from: SourceDataStatusUnprocessed, to: SourceDataStatusFiltered, want: true},
+		{name: "unprocessed to error", from: SourceDataStatusUnprocessed, to: SourceDataStatusError, want: true},
+		{name: "unprocessed to unprocessed", from: SourceDataStatusUnprocessed, to: SourceDataStatusUnprocessed, want: false},
+		{name: "processed to unprocessed", from: SourceDataStatusProcessed, to: SourceDataStatusUnprocessed, want: false},
+		{name: "processed to error", from: SourceDataStatusProcessed, to: SourceDataStatusError, want: false},
+		{name: "filtered to processed", from: SourceDataStatusFiltered, to: SourceDataStatusProcessed, want: false},
+		{name: "error to processed", from: SourceDataStatusError, to: SourceDataStatusProcessed, want: false},
+		{name: "error to unprocessed", from: SourceDataStatusError, to: SourceDataStatusUnprocessed, want: false},
+		{name: "unknown source", from: SourceDataStatus(7), to: SourceDataStatusProcessed, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.from.CanTransition(tt.to); got != tt.want {
+				t.Errorf("%s.CanTransition(%s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowedSourceStatuses(t *testing.T) {
+	tests := []struct {
+		name string
+		to   SourceDataStatus
+		want []SourceDataStatus
+	}{
+		{name: "processed", to: SourceDataStatusProcessed, want: []SourceDataStatus{SourceDataStatusUnprocessed}},
+		{name: "error", to: SourceDataStatusError, want: []SourceDataStatus{SourceDataStatusUnprocessed}},
+		{name: "unprocessed", to: SourceDataStatusUnprocessed, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allowedSourceStatuses(tt.to); !slices.Equal(got, tt.want) {
+				t.Errorf("allowedSourceStatuses(%s) = %v, want %v", tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourceDataStatusString(t *testing.T) {
+	tests := []struct {
+		status SourceDataStatus
+		want   string
+	}{
+		{status: SourceDataStatusError, want: "error"},
+		{status: SourceDataStatusUnprocessed, want: "unprocessed"},
+		{status: SourceDataStatusProcessed, want: "processed"},
+		{status: SourceDataStatusFiltered, want: "filtered"},
+		{status: SourceDataStatus(9), want: "unknown(9)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.status.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			if back := SourceDataStatusFromProto(tt.status.ToProto()); back != tt.status {
+				t.Errorf("proto round trip = %d, want %d", back, tt.status)
+			}
+		})
+	}
+}
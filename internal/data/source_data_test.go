@@ -0,0 +1,51 @@
+package data
+
+import "testing"
+
+func TestSourceDataFilterValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  SourceDataFilter
+		wantErr bool
+	}{
+		{name: "empty", filter: SourceDataFilter{}},
+		{name: "date range", filter: SourceDataFilter{StartDate: "20250701", EndDate: "20250707"}},
+		{name: "start only", filter: SourceDataFilter{StartDate: "20250701"}},
+		{name: "dashed start", filter: SourceDataFilter{StartDate: "2025-07-01"}, wantErr: true},
+		{name: "dashed end", filter: SourceDataFilter{StartDate: "20250701", EndDate: "2025-07-07"}, wantErr: true},
+		{name: "impossible date", filter: SourceDataFilter{EndDate: "20250231"}, wantErr: true},
+		{name: "short date", filter: SourceDataFilter{StartDate: "202507"}, wantErr: true},
+		{name: "defined statuses", filter: SourceDataFilter{Statuses: []SourceDataStatus{
+			SourceDataStatusError, SourceDataStatusUnprocessed, SourceDataStatusProcessed, SourceDataStatusFiltered,
+		}}},
+		{name: "undefined status", filter: SourceDataFilter{Statuses: []SourceDataStatus{SourceDataStatusProcessed, 5}}, wantErr: true},
+		{name: "negative undefined status", filter: SourceDataFilter{Statuses: []SourceDataStatus{-2}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.filter.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSourceDataFilterIsEmpty(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter SourceDataFilter
+		want   bool
+	}{
+		{name: "zero", filter: SourceDataFilter{}, want: true},
+		{name: "limit only", filter: SourceDataFilter{Limit: 10}, want: true},
+		{name: "data type", filter: SourceDataFilter{DataType: DataTypeVideoDetail}, want: false},
+		{name: "status", filter: SourceDataFilter{Statuses: []SourceDataStatus{SourceDataStatusError}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.IsEmpty(); got != tt.want {
+				t.Errorf("IsEmpty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/pkg/utils"
 	"time"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
@@ -38,6 +40,32 @@ type Video struct {
 	AwemeType          int32  `gorm:"type:integer"`
 	GoodsId            string `gorm:"size:255;comment:商品ID"`
 
+	// --- 数值化指标：由对应的 *Str 字段解析得到（见 NormalizeMetrics），用于数值排序和筛选 ---
+	PlayCountLow        int64   `gorm:"column:play_count_low;not null;default:0;comment:播放量低值"`
+	PlayCountHigh       int64   `gorm:"column:play_count_high;not null;default:0;index;comment:播放量高值"`
+	LikeCountLow        int64   `gorm:"column:like_count_low;not null;default:0;comment:点赞量低值"`
+	LikeCountHigh       int64   `gorm:"column:like_count_high;not null;default:0;index;comment:点赞量高值"`
+	CommentCountLow     int64   `gorm:"column:comment_count_low;not null;default:0;comment:评论量低值"`
+	CommentCountHigh    int64   `gorm:"column:comment_count_high;not null;default:0;comment:评论量高值"`
+	ShareCountLow       int64   `gorm:"column:share_count_low;not null;default:0;comment:分享量低值"`
+	ShareCountHigh      int64   `gorm:"column:share_count_high;not null;default:0;comment:分享量高值"`
+	CollectCountLow     int64   `gorm:"column:collect_count_low;not null;default:0;comment:收藏量低值"`
+	CollectCountHigh    int64   `gorm:"column:collect_count_high;not null;default:0;comment:收藏量高值"`
+	InteractionRateLow  float64 `gorm:"column:interaction_rate_low;not null;default:0;comment:互动率(%)低值"`
+	InteractionRateHigh float64 `gorm:"column:interaction_rate_high;not null;default:0;comment:互动率(%)高值"`
+	ScoreLow            float64 `gorm:"column:score_low;not null;default:0;comment:视频分数低值"`
+	ScoreHigh           float64 `gorm:"column:score_high;not null;default:0;comment:视频分数高值"`
+	LikeCommentRateLow  float64 `gorm:"column:like_comment_rate_low;not null;default:0;comment:点赞评论率(%)低值"`
+	LikeCommentRateHigh float64 `gorm:"column:like_comment_rate_high;not null;default:0;comment:点赞评论率(%)高值"`
+	SalesGmvLow         float64 `gorm:"column:sales_gmv_low;not null;default:0;comment:销售额(元)低值"`
+	SalesGmvHigh        float64 `gorm:"column:sales_gmv_high;not null;default:0;index;comment:销售额(元)高值"`
+	SalesCountLow       int64   `gorm:"column:sales_count_low;not null;default:0;comment:销量低值"`
+	SalesCountHigh      int64   `gorm:"column:sales_count_high;not null;default:0;index;comment:销量高值"`
+	GoodsCountLow       int64   `gorm:"column:goods_count_low;not null;default:0;comment:商品数低值"`
+	GoodsCountHigh      int64   `gorm:"column:goods_count_high;not null;default:0;comment:商品数高值"`
+	GpmLow              float64 `gorm:"column:gpm_low;not null;default:0;comment:GPM(元)低值"`
+	GpmHigh             float64 `gorm:"column:gpm_high;not null;default:0;comment:GPM(元)高值"`
+
 	// --- 详情信息 (来自下钻采集) 暂时用不上 ---
 	DyTagsJSON          string `gorm:"type:text"`
 	HotSearchWordsJSON  string `gorm:"type:text"`
@@ -56,19 +84,79 @@ func (Video) TableName() string {
 	return "videos"
 }
 
+// NormalizeMetrics 根据 *Str 字段解析并填充对应的数值化指标列。
+// 字符串为空或无法解析时对应数值保持为0。
+func (v *Video) NormalizeMetrics() {
+	v.PlayCountLow, v.PlayCountHigh = parseIntMetric(v.PlayCountStr)
+	v.LikeCountLow, v.LikeCountHigh = parseIntMetric(v.LikeCountStr)
+	v.CommentCountLow, v.CommentCountHigh = parseIntMetric(v.CommentCountStr)
+	v.ShareCountLow, v.ShareCountHigh = parseIntMetric(v.ShareCountStr)
+	v.CollectCountLow, v.CollectCountHigh = parseIntMetric(v.CollectCountStr)
+	v.InteractionRateLow, v.InteractionRateHigh = parseFloatMetric(v.InteractionRateStr)
+	v.ScoreLow, v.ScoreHigh = parseFloatMetric(v.ScoreStr)
+	v.LikeCommentRateLow, v.LikeCommentRateHigh = parseFloatMetric(v.LikeCommentRateStr)
+	v.SalesGmvLow, v.SalesGmvHigh = parseFloatMetric(v.SalesGmvStr)
+	v.SalesCountLow, v.SalesCountHigh = parseIntMetric(v.SalesCountStr)
+	v.GoodsCountLow, v.GoodsCountHigh = parseIntMetric(v.GoodsCountStr)
+	v.GpmLow, v.GpmHigh = parseFloatMetric(v.GpmStr)
+}
+
+func parseIntMetric(s string) (int64, int64) {
+	low, high, ok := utils.ParseMetricRange(s)
+	if !ok {
+		return 0, 0
+	}
+	return int64(low), int64(high)
+}
+
+func parseFloatMetric(s string) (float64, float64) {
+	low, high, ok := utils.ParseMetricRange(s)
+	if !ok {
+		return 0, 0
+	}
+	return low, high
+}
+
+// videoMetricColumns 是全部数值化指标列，用于批量回填时的定向更新
+var videoMetricColumns = []string{
+	"play_count_low", "play_count_high",
+	"like_count_low", "like_count_high",
+	"comment_count_low", "comment_count_high",
+	"share_count_low", "share_count_high",
+	"collect_count_low", "collect_count_high",
+	"interaction_rate_low", "interaction_rate_high",
+	"score_low", "score_high",
+	"like_comment_rate_low", "like_comment_rate_high",
+	"sales_gmv_low", "sales_gmv_high",
+	"sales_count_low", "sales_count_high",
+	"goods_count_low", "goods_count_high",
+	"gpm_low", "gpm_high",
+}
+
 type VideoRepo interface {
 	SaveSourceData(context.Context, *v1.SourceData) (*v1.SourceData, error)
 	FindVideosByIDs(ctx context.Context, awemeIDs []string, limit int) ([]*VideoForCollection, error)
 	UpsertFromRank(ctx context.Context, video *Video) error
 	UpdateFromSummary(ctx context.Context, video *Video) error
 	FindVideosNeedingSummaryUpdate(ctx context.Context, limit int) ([]*VideoForSummary, error)
-	ListPage(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*Video, int64, error)
+	ListPage(ctx context.Context, page *v1.PageRequest, query string, filter *v1.VideoFilter, sortBy string, sortOrder v1.SortOrder) ([]*Video, *v1.PageResponse, error)
+	// Iterate 按与 ListPage 相同的条件和排序流式读取全部视频，用于导出
+	Iterate(ctx context.Context, query string, filter *v1.VideoFilter, sortBy string, sortOrder v1.SortOrder, fn func(*Video) error) error
 	Get(ctx context.Context, awemeId string) (*Video, error)
 	FindRecentActiveAwemeIds(ctx context.Context, days int) ([]string, error)
 	//FindVideosNeedingTrendUpdate(ctx context.Context, limit int) ([]*VideoForTrend, error)
 	FindVideosForDetailsCollection(ctx context.Context, limit int) ([]*VideoForCollection, error)
 	UpdateTrendTimestamp(ctx context.Context, awemeId string) error
+	// FindVideosExcludingIDs 认领下一批需要首次采集详情的视频，并发调用返回互不相交的批次
 	FindVideosExcludingIDs(ctx context.Context, ids []string, limit int) ([]*VideoForCollection, error)
+	// FinishCollection 记录一次详情采集的结果并释放认领，collectErr 为 nil 表示成功
+	FinishCollection(ctx context.Context, awemeId string, collectErr error) error
+	// FindVideosForTrendRefresh 按刷新策略认领已采集过、到期需要重新采集的视频，超期最久的优先
+	FindVideosForTrendRefresh(ctx context.Context, policy *TrendRefreshPolicy, limit int) ([]*VideoForCollection, error)
+	// ListForMetricsBackfill 按 aweme_id 升序、从 afterAwemeID 之后分批读取视频，用于回填数值化指标
+	ListForMetricsBackfill(ctx context.Context, afterAwemeID string, limit int) ([]*Video, error)
+	// UpdateMetrics 只更新视频的数值化指标列
+	UpdateMetrics(ctx context.Context, video *Video) error
 } // End of VideoRepo interface
 
 type videoRepo struct {
@@ -89,53 +177,129 @@ func (r *videoRepo) UpsertFromRank(ctx context.Context, video *Video) error {
 	}).Create(video).Error
 }
 
-// UpdateFromSummary 安全地只更新 Video 模型中与总览数据相关的字段
+// videoSummaryColumns 是视频总览数据对应的列：原始字符串、数值化指标、视频类型和总览更新时间
+var videoSummaryColumns = append([]string{
+	"play_count_str", "like_count_str", "comment_count_str", "share_count_str", "collect_count_str",
+	"interaction_rate_str", "score_str", "like_comment_rate_str", "sales_gmv_str", "sales_count_str",
+	"goods_count_str", "gpm_str", "aweme_type", "summary_updated_at",
+}, videoMetricColumns...)
+
+// UpdateFromSummary 只更新 Video 模型中与总览数据相关的字段。
+// 通过 Select 指定列，指标归零（例如销量被平台修正为 0）时也会写入，而不是保留旧值。
 func (r *videoRepo) UpdateFromSummary(ctx context.Context, video *Video) error {
-	// 使用 Updates 方法，GORM 将只更新 video 对象中的非零值字段
-	return r.db.WithContext(ctx).Model(&Video{AwemeId: video.AwemeId}).Updates(video).Error
+	return r.db.WithContext(ctx).Model(&Video{AwemeId: video.AwemeId}).
+		Select(videoSummaryColumns).
+		Updates(video).Error
 }
 
-// ListPage 实现分页、模糊查询和排序
-func (r *videoRepo) ListPage(ctx context.Context, page, size int, query, sortBy string, sortOrder v1.SortOrder) ([]*Video, int64, error) {
+// listQuery 构造视频列表的模糊查询、结构化过滤和白名单排序
+func (r *videoRepo) listQuery(ctx context.Context, query string, filter *v1.VideoFilter, sortBy string, sortOrder v1.SortOrder) *queryBuilder {
+	qb := newQueryBuilder(r.db.WithContext(ctx).Model(&Video{}), "aweme_id").
+		Like("aweme_desc", query)
+	applyVideoFilter(qb, r.db, filter)
+	return qb.Sort(sortBy, sortOrder, videoSortFields, "aweme_pub_time", true)
+}
+
+// ListPage 实现分页、模糊查询、结构化过滤和白名单排序
+func (r *videoRepo) ListPage(ctx context.Context, page *v1.PageRequest, query string, filter *v1.VideoFilter, sortBy string, sortOrder v1.SortOrder) ([]*Video, *v1.PageResponse, error) {
 	var videos []*Video
-	var total int64
+	pageResp, err := r.listQuery(ctx, query, filter, sortBy, sortOrder).Page(page, &videos)
+	if err != nil {
+		return nil, nil, err
+	}
+	return videos, pageResp, nil
+}
 
-	db := r.db.WithContext(ctx).Model(&Video{})
+// Iterate 按与 ListPage 相同的条件和排序流式读取全部视频，用于导出
+func (r *videoRepo) Iterate(ctx context.Context, query string, filter *v1.VideoFilter, sortBy string, sortOrder v1.SortOrder, fn func(*Video) error) error {
+	return eachRow(r.listQuery(ctx, query, filter, sortBy, sortOrder), fn)
+}
 
-	// 模糊查询
-	if query != "" {
-		db = db.Where("aweme_desc LIKE ?", "%"+query+"%")
+// applyVideoFilter 把视频的结构化过滤条件追加到 qb，列表和搜索共用
+func applyVideoFilter(qb *queryBuilder, db *gorm.DB, filter *v1.VideoFilter) {
+	if filter == nil {
+		return
 	}
-
-	// 获取总数
-	if err := db.Count(&total).Error; err != nil {
-		return nil, 0, err
+	qb.TimeRange("aweme_pub_time", filter.PubTime).
+		Eq("blogger_id", filter.BloggerId).
+		Eq("goods_id", filter.GoodsId).
+		Int64Range("sales_count_high", filter.SalesCount).
+		Int64Range("like_count_high", filter.LikeCount).
+		Int64Range("play_count_high", filter.PlayCount).
+		DoubleRange("sales_gmv_high", filter.SalesGmv)
+
+	// 类目、品牌、店铺属于商品维度，通过绑定商品的子查询过滤
+	if filter.Category != "" || filter.BrandName != "" || filter.ShopName != "" || filter.BrandId != 0 || filter.ShopId != 0 {
+		products := newQueryBuilder(db.Model(&Product{}).Select("goods_id"), "goods_id").
+			Like("category_names", filter.Category).
+			Eq("brand_name", filter.BrandName).
+			Eq("shop_name", filter.ShopName).
+			Eq("brand_id", filter.BrandId).
+			Eq("shop_id", filter.ShopId)
+		qb.Where("goods_id IN (?)", products.db)
 	}
-
-	// 排序逻辑重构
-	if sortBy != "" {
-		var order string
-		switch sortOrder {
-		case v1.SortOrder_ASC:
-			order = sortBy + " ASC"
-		case v1.SortOrder_DESC:
-			order = sortBy + " DESC"
-		default:
-			order = sortBy + " DESC"
-		}
-		db = db.Order(order)
-	} else {
-		// 默认排序
-		db = db.Order("aweme_pub_time DESC")
+	if filter.CategoryId != 0 {
+		qb.Where("goods_id IN (?)", categoryGoodsSubquery(db, filter.CategoryId))
 	}
+}
+
+// videoSortFields 是视频列表的排序白名单。
+// 指标字段按数值列（范围高值）排序，*_str 形式保留兼容，同样映射到数值列以避免字符串字典序。
+var videoSortFields = SortFields{
+	"aweme_pub_time":     "aweme_pub_time",
+	"summary_updated_at": "summary_updated_at",
+	"created_at":         "created_at",
+	"updated_at":         "updated_at",
+
+	"play_count":        "play_count_high",
+	"like_count":        "like_count_high",
+	"comment_count":     "comment_count_high",
+	"share_count":       "share_count_high",
+	"collect_count":     "collect_count_high",
+	"interaction_rate":  "interaction_rate_high",
+	"score":             "score_high",
+	"like_comment_rate": "like_comment_rate_high",
+	"sales_gmv":         "sales_gmv_high",
+	"sales_count":       "sales_count_high",
+	"goods_count":       "goods_count_high",
+	"gpm":               "gpm_high",
+
+	"play_count_str":        "play_count_high",
+	"like_count_str":        "like_count_high",
+	"comment_count_str":     "comment_count_high",
+	"share_count_str":       "share_count_high",
+	"collect_count_str":     "collect_count_high",
+	"interaction_rate_str":  "interaction_rate_high",
+	"score_str":             "score_high",
+	"like_comment_rate_str": "like_comment_rate_high",
+	"sales_gmv_str":         "sales_gmv_high",
+	"sales_count_str":       "sales_count_high",
+	"goods_count_str":       "goods_count_high",
+	"gpm_str":               "gpm_high",
+}
 
-	// 分页
-	offset := (page - 1) * size
-	if err := db.Offset(offset).Limit(size).Find(&videos).Error; err != nil {
-		return nil, 0, err
+// ListForMetricsBackfill 使用 aweme_id 游标分批读取已有总览数据的视频，只查询指标字符串列
+func (r *videoRepo) ListForMetricsBackfill(ctx context.Context, afterAwemeID string, limit int) ([]*Video, error) {
+	var videos []*Video
+	err := r.db.WithContext(ctx).Model(&Video{}).
+		Select("aweme_id", "play_count_str", "like_count_str", "comment_count_str", "share_count_str",
+			"collect_count_str", "interaction_rate_str", "score_str", "like_comment_rate_str",
+			"sales_gmv_str", "sales_count_str", "goods_count_str", "gpm_str").
+		Where("aweme_id > ? AND summary_updated_at IS NOT NULL", afterAwemeID).
+		Order("aweme_id ASC").
+		Limit(limit).
+		Find(&videos).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询待回填指标的视频失败: %w", err)
 	}
+	return videos, nil
+}
 
-	return videos, total, nil
+// UpdateMetrics 只更新数值化指标列，包括零值，不触碰 updated_at
+func (r *videoRepo) UpdateMetrics(ctx context.Context, video *Video) error {
+	return r.db.WithContext(ctx).Model(&Video{AwemeId: video.AwemeId}).
+		Select(videoMetricColumns).
+		UpdateColumns(video).Error
 }
 
 // CopyVideoToDTO 将 data.Video 模型转换为 v1.VideoDTO
@@ -144,28 +308,52 @@ func CopyVideoToDTO(v *Video) *v1.VideoDTO {
 		return nil
 	}
 	dto := &v1.VideoDTO{
-		AwemeId:            v.AwemeId,
-		CreatedAt:          v.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:          v.UpdatedAt.Format(time.RFC3339),
-		AwemeDesc:          v.AwemeDesc,
-		AwemeCoverUrl:      v.AwemeCoverUrl,
-		AwemePubTime:       v.AwemePubTime.Format(time.RFC3339),
-		AwemeShareUrl:      v.AwemeShareUrl,
-		AwemeDetailUrl:     v.AwemeDetailUrl,
-		BloggerId:          v.BloggerId,
-		PlayCountStr:       v.PlayCountStr,
-		LikeCountStr:       v.LikeCountStr,
-		CommentCountStr:    v.CommentCountStr,
-		ShareCountStr:      v.ShareCountStr,
-		CollectCountStr:    v.CollectCountStr,
-		InteractionRateStr: v.InteractionRateStr,
-		ScoreStr:           v.ScoreStr,
-		LikeCommentRateStr: v.LikeCommentRateStr,
-		SalesGmvStr:        v.SalesGmvStr,
-		SalesCountStr:      v.SalesCountStr,
-		GoodsCountStr:      v.GoodsCountStr,
-		GpmStr:             v.GpmStr,
-		AwemeType:          v.AwemeType,
+		AwemeId:             v.AwemeId,
+		CreatedAt:           v.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           v.UpdatedAt.Format(time.RFC3339),
+		AwemeDesc:           v.AwemeDesc,
+		AwemeCoverUrl:       v.AwemeCoverUrl,
+		AwemePubTime:        v.AwemePubTime.Format(time.RFC3339),
+		AwemeShareUrl:       v.AwemeShareUrl,
+		AwemeDetailUrl:      v.AwemeDetailUrl,
+		BloggerId:           v.BloggerId,
+		PlayCountStr:        v.PlayCountStr,
+		LikeCountStr:        v.LikeCountStr,
+		CommentCountStr:     v.CommentCountStr,
+		ShareCountStr:       v.ShareCountStr,
+		CollectCountStr:     v.CollectCountStr,
+		InteractionRateStr:  v.InteractionRateStr,
+		ScoreStr:            v.ScoreStr,
+		LikeCommentRateStr:  v.LikeCommentRateStr,
+		SalesGmvStr:         v.SalesGmvStr,
+		SalesCountStr:       v.SalesCountStr,
+		GoodsCountStr:       v.GoodsCountStr,
+		GpmStr:              v.GpmStr,
+		AwemeType:           v.AwemeType,
+		PlayCountLow:        v.PlayCountLow,
+		PlayCountHigh:       v.PlayCountHigh,
+		LikeCountLow:        v.LikeCountLow,
+		LikeCountHigh:       v.LikeCountHigh,
+		CommentCountLow:     v.CommentCountLow,
+		CommentCountHigh:    v.CommentCountHigh,
+		ShareCountLow:       v.ShareCountLow,
+		ShareCountHigh:      v.ShareCountHigh,
+		CollectCountLow:     v.CollectCountLow,
+		CollectCountHigh:    v.CollectCountHigh,
+		InteractionRateLow:  v.InteractionRateLow,
+		InteractionRateHigh: v.InteractionRateHigh,
+		ScoreLow:            v.ScoreLow,
+		ScoreHigh:           v.ScoreHigh,
+		LikeCommentRateLow:  v.LikeCommentRateLow,
+		LikeCommentRateHigh: v.LikeCommentRateHigh,
+		SalesGmvLow:         v.SalesGmvLow,
+		SalesGmvHigh:        v.SalesGmvHigh,
+		SalesCountLow:       v.SalesCountLow,
+		SalesCountHigh:      v.SalesCountHigh,
+		GoodsCountLow:       v.GoodsCountLow,
+		GoodsCountHigh:      v.GoodsCountHigh,
+		GpmLow:              v.GpmLow,
+		GpmHigh:             v.GpmHigh,
 	}
 	if v.SummaryUpdatedAt != nil {
 		dto.SummaryUpdatedAt = v.SummaryUpdatedAt.Format(time.RFC3339)
@@ -241,7 +429,3 @@ func (r *videoRepo) SaveSourceData(ctx context.Context, d *v1.SourceData) (*v1.S
 	}
 	return CopySourceDataToDTO(sd), nil
 }
-
-func (r *videoRepo) FindVideosExcludingIDs(ctx context.Context, ids []string, limit int) ([]*VideoForCollection, error) {
-	panic("implement me")
-}
@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 视频详情采集状态
+const (
+	VideoCollectionClaimed   = "claimed"   // 已被某个 worker 认领，正在采集
+	VideoCollectionCollected = "collected" // 采集成功
+	VideoCollectionFailed    = "failed"    // 采集失败，租约过期后可以重试
+)
+
+const (
+	// videoCollectionLease 是认领的有效期，worker 异常退出时其认领的视频在租约过期后可被重新认领，
+	// 采集失败的视频也在租约过期后才会重试
+	videoCollectionLease = time.Hour
+	// videoCollectionMaxAttempts 是首次采集的最大尝试次数，超过后不再自动认领
+	videoCollectionMaxAttempts = 3
+)
+
+// VideoCollectionState 记录视频详情采集的认领和结果，每个视频一行。
+// 首次采集的待采集队列由 videos 与本表、source_data 反连接得到，不再需要在内存中构造已采集 ID 黑名单。
+type VideoCollectionState struct {
+	AwemeId     string     `gorm:"primaryKey;size:255"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime;type:timestamp"`
+	Status      string     `gorm:"size:16;not null;index"`
+	Attempts    int        `gorm:"not null;default:0;comment:认领次数"`
+	ClaimedAt   time.Time  `gorm:"type:timestamp;not null;comment:最近一次认领时间"`
+	CollectedAt *time.Time `gorm:"type:timestamp;comment:采集成功时间"`
+	LastError   string     `gorm:"type:text;not null;default:''"`
+}
+
+func (VideoCollectionState) TableName() string {
+	return "video_collection_states"
+}
+
+// FindVideosExcludingIDs 认领下一批需要首次采集详情的视频，新发布的视频优先，ids 中的视频不参与认领。
+// 候选视频是尚未产生过趋势数据、source_data 中没有 headless 采集记录、且未被其他 worker 持有有效认领的视频。
+// 候选行通过 FOR UPDATE SKIP LOCKED 选出，再以 upsert 写入认领状态，只有 upsert 成功的视频才会返回，
+// 因此并发的 worker 总是拿到互不相交的批次。
+func (r *videoRepo) FindVideosExcludingIDs(ctx context.Context, ids []string, limit int) ([]*VideoForCollection, error) {
+	var claimed []*VideoForCollection
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		leaseCutoff := now.Add(-videoCollectionLease)
+
+		query := tx.Table("videos AS v").
+			Select("v.aweme_id", "v.aweme_pub_time", "v.aweme_detail_url").
+			Joins("LEFT JOIN video_collection_states s ON s.aweme_id = v.aweme_id").
+			Where("v.trend_updated_at IS NULL").
+			Where("s.aweme_id IS NULL OR (s.status <> ? AND s.claimed_at < ? AND s.attempts < ?)",
+				VideoCollectionCollected, leaseCutoff, videoCollectionMaxAttempts).
+			Where("NOT EXISTS (SELECT 1 FROM source_data d WHERE d.entity_id = v.aweme_id AND d.data_type IN ?)",
+				[]string{DataTypeVideoTrendHeadless, DataTypeVideoSummaryHeadless})
+		if len(ids) > 0 {
+			query = query.Where("v.aweme_id NOT IN ?", ids)
+		}
+		var candidates []*VideoForCollection
+		err := query.Order("v.aweme_pub_time DESC").
+			Limit(limit).
+			Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: "v"}, Options: "SKIP LOCKED"}).
+			Scan(&candidates).Error
+		if err != nil {
+			return err
+		}
+		claimed, err = claimVideoCollections(tx, candidates, now,
+			"video_collection_states.status <> ? AND video_collection_states.claimed_at < ?",
+			VideoCollectionCollected, leaseCutoff)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("认领待首次采集的视频失败: %w", err)
+	}
+	return claimed, nil
+}
+
+// claimVideoCollections 以 upsert 写入 candidates 的认领状态，只返回认领成功的视频。
+// reclaimable 是已有状态行允许被重新认领的条件；同一视频被并发认领时，
+// 后提交的一方看到的是刚写入的认领，条件不成立而不更新、不返回。
+func claimVideoCollections(tx *gorm.DB, candidates []*VideoForCollection, now time.Time, reclaimable string, reclaimArgs ...any) ([]*VideoForCollection, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	placeholders := make([]string, 0, len(candidates))
+	args := make([]any, 0, len(candidates)*6+len(reclaimArgs))
+	for _, c := range candidates {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+		args = append(args, c.AwemeId, VideoCollectionClaimed, 1, now, now, now)
+	}
+	args = append(args, reclaimArgs...)
+	var claimedIDs []string
+	err := tx.Raw(`
+INSERT INTO video_collection_states (aweme_id, status, attempts, claimed_at, created_at, updated_at)
+VALUES `+strings.Join(placeholders, ", ")+`
+ON CONFLICT (aweme_id) DO UPDATE SET
+	status = EXCLUDED.status,
+	attempts = video_collection_states.attempts + 1,
+	claimed_at = EXCLUDED.claimed_at,
+	updated_at = EXCLUDED.updated_at
+WHERE `+reclaimable+`
+RETURNING aweme_id`, args...).Scan(&claimedIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	ok := make(map[string]bool, len(claimedIDs))
+	for _, id := range claimedIDs {
+		ok[id] = true
+	}
+	claimed := make([]*VideoForCollection, 0, len(claimedIDs))
+	for _, c := range candidates {
+		if ok[c.AwemeId] {
+			claimed = append(claimed, c)
+		}
+	}
+	return claimed, nil
+}
+
+// claimRefreshCollections 认领需要重新采集详情的视频（关注列表、趋势刷新）。
+// 已采集或采集失败的视频都可以再次认领，只跳过其他 worker 持有有效认领的视频。
+func claimRefreshCollections(db *gorm.DB, candidates []*VideoForCollection, now time.Time) ([]*VideoForCollection, error) {
+	return claimVideoCollections(db, candidates, now,
+		"video_collection_states.status <> ? OR video_collection_states.claimed_at < ?",
+		VideoCollectionClaimed, now.Add(-videoCollectionLease))
+}
+
+// FinishCollection 记录一次详情采集的结果，collectErr 为 nil 表示成功，同时释放认领。
+func (r *videoRepo) FinishCollection(ctx context.Context, awemeId string, collectErr error) error {
+	now := time.Now()
+	state := &VideoCollectionState{
+		AwemeId:   awemeId,
+		Status:    VideoCollectionCollected,
+		ClaimedAt: now,
+	}
+	updates := []string{"status", "last_error", "updated_at"}
+	if collectErr != nil {
+		state.Status = VideoCollectionFailed
+		state.LastError = collectErr.Error()
+	} else {
+		state.CollectedAt = &now
+		updates = append(updates, "collected_at")
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "aweme_id"}},
+		DoUpdates: clause.AssignmentColumns(updates),
+	}).Create(state).Error
+	if err != nil {
+		return fmt.Errorf("记录视频 %s 的采集结果失败: %w", awemeId, err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// commentSegmentsLimit 是同步写入 videos.comment_segments_json 的关键词数量
+const commentSegmentsLimit = 50
+
+// VideoComment 视频评论，comment_id 全局唯一，重复采集时更新点赞数和回复数
+type VideoComment struct {
+	ID          uint       `gorm:"primaryKey"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime;type:timestamp"`
+	CommentId   string     `gorm:"size:64;not null;uniqueIndex"`
+	AwemeId     string     `gorm:"size:255;not null;index"`
+	Content     string     `gorm:"type:text;not null;default:''"`
+	Nickname    string     `gorm:"size:255;not null;default:''"`
+	LikeCount   int64      `gorm:"not null;default:0"`
+	ReplyCount  int64      `gorm:"not null;default:0"`
+	IpLabel     string     `gorm:"size:64;not null;default:'';comment:IP属地"`
+	CommentTime *time.Time `gorm:"type:timestamp"`
+}
+
+func (VideoComment) TableName() string {
+	return "video_comments"
+}
+
+// VideoCommentKeyword 视频评论的关键词（分词）统计，每次采集整体替换该视频的全部关键词
+type VideoCommentKeyword struct {
+	ID        uint      `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime;type:timestamp"`
+	AwemeId   string    `gorm:"size:255;not null;uniqueIndex:uk_video_comment_keyword,priority:1"`
+	Keyword   string    `gorm:"size:128;not null;uniqueIndex:uk_video_comment_keyword,priority:2"`
+	Count     int64     `gorm:"not null;default:0;comment:出现次数"`
+	Ratio     float64   `gorm:"not null;default:0;comment:占比(%)"`
+}
+
+func (VideoCommentKeyword) TableName() string {
+	return "video_comment_keywords"
+}
+
+// commentSegment 是 videos.comment_segments_json 中的元素
+type commentSegment struct {
+	Keyword string  `json:"keyword"`
+	Count   int64   `json:"count"`
+	Ratio   float64 `json:"ratio"`
+}
+
+// videoCommentSortFields 是评论列表的排序白名单
+var videoCommentSortFields = SortFields{
+	"like_count":   "like_count",
+	"reply_count":  "reply_count",
+	"comment_time": "comment_time",
+}
+
+// VideoCommentRepo 管理视频评论和评论关键词
+type VideoCommentRepo interface {
+	// UpsertComments 写入评论，已存在的评论更新点赞数和回复数
+	UpsertComments(ctx context.Context, comments []*VideoComment) error
+	// ReplaceKeywords 整体替换视频的评论关键词，并把前 50 个关键词同步写入 videos.comment_segments_json
+	ReplaceKeywords(ctx context.Context, awemeId string, keywords []*VideoCommentKeyword) error
+	// ListPage 分页查询视频评论，默认按点赞数倒序
+	ListPage(ctx context.Context, page *v1.PageRequest, awemeId, query, sortBy string, sortOrder v1.SortOrder) ([]*VideoComment, *v1.PageResponse, error)
+	// ListKeywords 按出现次数倒序返回视频的评论关键词
+	ListKeywords(ctx context.Context, awemeId string, limit int) ([]*VideoCommentKeyword, error)
+	// FindVideosForCommentCollection 查找有评论、且 interval 内没有成功采集过评论的视频，评论多的优先；采集出错的记录不计入间隔，下一轮即可重试
+	FindVideosForCommentCollection(ctx context.Context, interval time.Duration, limit int) ([]*VideoForCollection, error)
+}
+
+type videoCommentRepo struct {
+	*Data
+}
+
+// NewVideoCommentRepo .
+func NewVideoCommentRepo(data *Data) VideoCommentRepo {
+	return &videoCommentRepo{Data: data}
+}
+
+func (r *videoCommentRepo) UpsertComments(ctx context.Context, comments []*VideoComment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "comment_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "like_count", "reply_count"}),
+	}).Create(comments).Error
+}
+
+func (r *videoCommentRepo) ReplaceKeywords(ctx context.Context, awemeId string, keywords []*VideoCommentKeyword) error {
+	segments := make([]commentSegment, 0, min(len(keywords), commentSegmentsLimit))
+	for _, k := range keywords {
+		if len(segments) == commentSegmentsLimit {
+			break
+		}
+		segments = append(segments, commentSegment{Keyword: k.Keyword, Count: k.Count, Ratio: k.Ratio})
+	}
+	segmentsJSON, err := json.Marshal(segments)
+	if err != nil {
+		return fmt.Errorf("序列化评论关键词失败: %w", err)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("aweme_id = ?", awemeId).Delete(&VideoCommentKeyword{}).Error; err != nil {
+			return err
+		}
+		if len(keywords) > 0 {
+			if err := tx.Create(keywords).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&Video{}).Where("aweme_id = ?", awemeId).
+			UpdateColumn("comment_segments_json", string(segmentsJSON)).Error
+	})
+}
+
+func (r *videoCommentRepo) ListPage(ctx context.Context, page *v1.PageRequest, awemeId, query, sortBy string, sortOrder v1.SortOrder) ([]*VideoComment, *v1.PageResponse, error) {
+	var comments []*VideoComment
+	pageResp, err := newQueryBuilder(r.db.WithContext(ctx).Model(&VideoComment{}), "id").
+		Eq("aweme_id", awemeId).
+		Like("content", query).
+		Sort(sortBy, sortOrder, videoCommentSortFields, "like_count", true).
+		Page(page, &comments)
+	if err != nil {
+		return nil, nil, err
+	}
+	return comments, pageResp, nil
+}
+
+func (r *videoCommentRepo) ListKeywords(ctx context.Context, awemeId string, limit int) ([]*VideoCommentKeyword, error) {
+	var keywords []*VideoCommentKeyword
+	err := r.db.WithContext(ctx).
+		Where("aweme_id = ?", awemeId).
+		Order("count DESC").Order("id").
+		Limit(limit).
+		Find(&keywords).Error
+	return keywords, err
+}
+
+func (r *videoCommentRepo) FindVideosForCommentCollection(ctx context.Context, interval time.Duration, limit int) ([]*VideoForCollection, error) {
+	var results []*VideoForCollection
+	err := r.db.WithContext(ctx).Model(&Video{}).
+		Select("aweme_id", "aweme_pub_time", "aweme_detail_url").
+		Where("comment_count_high > 0").
+		Where("NOT EXISTS (SELECT 1 FROM source_data s WHERE s.entity_id = videos.aweme_id AND s.data_type = ? AND s.fetched_at > ? AND s.status <> ?)",
+			DataTypeVideoCommentKeyword, time.Now().Add(-interval), SourceDataStatusError).
+		Order("comment_count_high DESC").
+		Order("aweme_id").
+		Limit(limit).
+		Find(&results).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询待采集评论的视频失败: %w", err)
+	}
+	return results, nil
+}
@@ -3,6 +3,8 @@ package data
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -60,9 +62,101 @@ func (r *videoRepo) FindVideosNeedingSummaryUpdate(ctx context.Context, limit in
 		Select("aweme_id", "aweme_pub_time").
 		Where("summary_updated_at IS NULL OR summary_updated_at < ?", twentyFourHoursAgo).
 		// 数据库在一次查询中，利用其强大的查询优化器，高效地完成 videos 表的筛选和与 source_data 表的关联子查询，直接返回最终的、精确的100个视频ID。
-		Where("NOT EXISTS (SELECT 1 FROM source_data WHERE entity_id = videos.aweme_id AND data_type = 'video_summary' AND status = 0 AND fetched_at > ?)", oneHourAgo).
+		Where("NOT EXISTS (SELECT 1 FROM source_data WHERE entity_id = videos.aweme_id AND data_type = 'video_summary' AND status = ? AND fetched_at > ?)", SourceDataStatusUnprocessed, oneHourAgo).
 		Order("summary_updated_at asc NULLS FIRST").
 		Limit(limit).
 		Find(&results).Error
 	return results, err
 }
+
+// RefreshTier 是刷新策略中的一个分段：发布时长不超过 MaxAge 的视频每隔 Interval 重新采集一次
+type RefreshTier struct {
+	MaxAge   time.Duration
+	Interval time.Duration
+}
+
+// TrendRefreshPolicy 是已采集视频的重新采集策略
+type TrendRefreshPolicy struct {
+	// Tiers 按 MaxAge 升序排列
+	Tiers           []RefreshTier
+	DefaultInterval time.Duration
+	// GrowthFactor 在最近一天的趋势有新增销量或销售额时乘到刷新间隔上
+	GrowthFactor float64
+	// InactiveDays 天内没有任何新增销量、销售额和点赞的视频停止跟踪
+	InactiveDays int
+	// MaxAge 是跟踪的最长发布时长
+	MaxAge time.Duration
+}
+
+// FindVideosForTrendRefresh 查找到期需要重新采集总览和趋势的视频。
+// 只考虑已经完成过趋势采集（trend_updated_at 非空）的视频，首次采集由 FindVideosExcludingIDs 负责；
+// 刷新间隔内已经采集过（source_data 中有记录，可能尚未被 ETL 处理）的视频也会跳过。
+// 返回的视频已在 video_collection_states 中认领，其他 worker 持有有效认领的视频不会返回。
+func (r *videoRepo) FindVideosForTrendRefresh(ctx context.Context, policy *TrendRefreshPolicy, limit int) ([]*VideoForCollection, error) {
+	now := time.Now()
+
+	// 按发布时长选择刷新间隔（秒）
+	var intervalCase strings.Builder
+	var args []any
+	intervalCase.WriteString("CASE")
+	for _, tier := range policy.Tiers {
+		intervalCase.WriteString(" WHEN v.aweme_pub_time > ? THEN ?")
+		args = append(args, now.Add(-tier.MaxAge), tier.Interval.Seconds())
+	}
+	intervalCase.WriteString(" ELSE ? END")
+	args = append(args, policy.DefaultInterval.Seconds(), policy.GrowthFactor)
+
+	inactiveSince := now.Add(-time.Duration(policy.InactiveDays) * 24 * time.Hour)
+	inactiveDateCode, _ := strconv.Atoi(inactiveSince.Format("20060102"))
+	args = append(args,
+		now.Add(-policy.MaxAge),
+		VideoCollectionClaimed, now.Add(-videoCollectionLease),
+		inactiveSince, inactiveDateCode,
+		now,
+		[]string{DataTypeVideoTrendHeadless, DataTypeVideoSummaryHeadless}, now,
+		now, limit,
+	)
+
+	db := r.db.WithContext(ctx)
+	var candidates []*VideoForCollection
+	err := db.Raw(`
+WITH candidates AS (
+	SELECT v.aweme_id, v.aweme_pub_time, v.aweme_detail_url, v.trend_updated_at,
+		(`+intervalCase.String()+`)
+			* (CASE WHEN latest.inc_sales_count > 0 OR latest.inc_sales_gmv > 0 THEN ? ELSE 1 END) AS interval_seconds
+	FROM videos v
+	LEFT JOIN LATERAL (
+		SELECT t.inc_sales_count, t.inc_sales_gmv FROM video_trends t
+		WHERE t.aweme_id = v.aweme_id ORDER BY t.date_code DESC LIMIT 1
+	) latest ON TRUE
+	LEFT JOIN video_collection_states cs ON cs.aweme_id = v.aweme_id
+	WHERE v.trend_updated_at IS NOT NULL
+		AND v.aweme_pub_time > ?
+		AND (cs.aweme_id IS NULL OR cs.status <> ? OR cs.claimed_at < ?)
+		-- 发布不足 InactiveDays 天的视频始终跟踪，更早的视频需要在这段时间内有过增长
+		AND (v.aweme_pub_time > ? OR EXISTS (
+			SELECT 1 FROM video_trends t
+			WHERE t.aweme_id = v.aweme_id AND t.date_code >= ?
+				AND (t.inc_sales_count > 0 OR t.inc_sales_gmv > 0 OR t.inc_like_count > 0)
+		))
+)
+SELECT aweme_id, aweme_pub_time, aweme_detail_url
+FROM candidates
+WHERE trend_updated_at < CAST(? AS TIMESTAMP) - interval_seconds * INTERVAL '1 second'
+	AND NOT EXISTS (
+		SELECT 1 FROM source_data s
+		WHERE s.entity_id = candidates.aweme_id
+			AND s.data_type IN ?
+			AND s.fetched_at > CAST(? AS TIMESTAMP) - interval_seconds * INTERVAL '1 second'
+	)
+ORDER BY EXTRACT(EPOCH FROM (CAST(? AS TIMESTAMP) - trend_updated_at)) / interval_seconds DESC
+LIMIT ?`, args...).Scan(&candidates).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询待刷新趋势的视频失败: %w", err)
+	}
+	claimed, err := claimRefreshCollections(db, candidates, now)
+	if err != nil {
+		return nil, fmt.Errorf("认领待刷新趋势的视频失败: %w", err)
+	}
+	return claimed, nil
+}
@@ -2,8 +2,11 @@ package data
 
 import (
 	"context"
+	"fmt"
 	v1 "github.com/Jayleonc/aresdata/api/v1"
 	"github.com/Jayleonc/aresdata/pkg/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strings"
 	"time"
 )
@@ -11,16 +14,31 @@ import (
 // VideoRankRepo defines the interface for batch creation of VideoRank records.
 // VideoRankRepo 定义了视频榜单数据的持久化接口。
 type VideoRankRepo interface {
-	// 批量创建视频榜单记录
+	// 批量写入视频榜单记录，按 (period_type, rank_date, aweme_id, goods_id) 幂等 upsert
 	BatchCreate(ctx context.Context, ranks []*v1.VideoRankDTO) error
+	// ReplaceBySource 在事务中用新记录替换同一 source_data 产生的旧记录，保证重放幂等
+	ReplaceBySource(ctx context.Context, sourceDataID int64, ranks []*v1.VideoRankDTO) error
 	// 查询单个视频榜单
 	GetByAwemeID(ctx context.Context, awemeID, rankType, rankDate string) (*v1.VideoRankDTO, error)
 	// 分页查询视频榜单
-	ListPage(ctx context.Context, page, size int, rankType, rankDate, sortBy, sortOrder string) ([]*v1.VideoRankDTO, int64, error)
+	ListPage(ctx context.Context, page *v1.PageRequest, rankType, rankDate string, categoryID uint64, sortBy, sortOrder string) ([]*v1.VideoRankDTO, *v1.PageResponse, error)
+	// Iterate 按与 ListPage 相同的条件和排序流式读取全部榜单记录，用于导出
+	Iterate(ctx context.Context, rankType, rankDate string, categoryID uint64, sortBy, sortOrder string, fn func(*VideoRank) error) error
+	// LatestRankDate 查询某周期最新一期榜单的日期，没有数据时返回空串
+	LatestRankDate(ctx context.Context, periodType string) (string, error)
 	// GetDistinctAwemeIDsByDate 获取指定日期之后上过榜的、不重复的视频ID
 	GetDistinctAwemeIDsByDate(ctx context.Context, sinceDate string) ([]string, error)
+	// ListHistory 查询单个视频在某周期榜单上的上榜历史（基于 video_rank_history 视图）
+	ListHistory(ctx context.Context, awemeID, periodType, startDate, endDate string) ([]*VideoRankHistory, error)
+	// ListHistoryByDate 查询某一期榜单，附带每条记录上一次上榜的名次和日期
+	ListHistoryByDate(ctx context.Context, periodType, rankDate string) ([]*VideoRankHistory, error)
+	// ListRankDates 查询一批视频截至某日的全部上榜日期，用于计算连续上榜期数
+	ListRankDates(ctx context.Context, periodType string, awemeIDs []string, untilDate string) (map[string][]string, error)
 }
 
+// videoRankUniqueIndex 是榜单记录自然键 (period_type, rank_date, aweme_id, goods_id) 上的唯一索引名
+const videoRankUniqueIndex = "uk_video_rank_natural_key"
+
 // VideoRank is the GORM model for storing video ranking data.
 type VideoRank struct {
 	ID        uint      `gorm:"primaryKey"`
@@ -28,13 +46,14 @@ type VideoRank struct {
 
 	// 榜单核心
 
-	PeriodType string `gorm:"column:period_type;size:1024;not null"`
-	RankDate   string `gorm:"column:rank_date;size:1024;not null"`
-	StartDate  string `gorm:"column:start_date;size:1024;not null;default:''"`
-	EndDate    string `gorm:"column:end_date;size:1024;not null;default:''"`
+	PeriodType   string `gorm:"column:period_type;size:1024;not null;uniqueIndex:uk_video_rank_natural_key,priority:1;index:idx_video_rank_item_date,priority:1"`
+	RankDate     string `gorm:"column:rank_date;size:1024;not null;uniqueIndex:uk_video_rank_natural_key,priority:2;index:idx_video_rank_item_date,priority:4"`
+	StartDate    string `gorm:"column:start_date;size:1024;not null;default:''"`
+	EndDate      string `gorm:"column:end_date;size:1024;not null;default:''"`
+	RankPosition int    `gorm:"column:rank_position;not null;default:0;comment:榜单名次，从1开始，0表示未知"`
 
 	// 视频信息
-	AwemeId        string    `gorm:"column:aweme_id;size:1024;not null"`
+	AwemeId        string    `gorm:"column:aweme_id;size:1024;not null;uniqueIndex:uk_video_rank_natural_key,priority:3;index:idx_video_rank_item_date,priority:2"`
 	AwemeCoverUrl  string    `gorm:"column:aweme_cover_url;size:1024;not null;default:''"`
 	AwemeDesc      string    `gorm:"column:aweme_desc;type:text;not null;default:''"`
 	AwemePubTime   time.Time `gorm:"column:aweme_pub_time;type:timestamp"`
@@ -44,7 +63,7 @@ type VideoRank struct {
 	AwemeDetailUrl string    `gorm:"column:aweme_detail_url;size:1024;not null;default:''"`
 
 	// 商品信息
-	GoodsId         string  `gorm:"column:goods_id;size:1024;not null"`
+	GoodsId         string  `gorm:"column:goods_id;size:1024;not null;uniqueIndex:uk_video_rank_natural_key,priority:4;index:idx_video_rank_item_date,priority:3"`
 	GoodsTitle      string  `gorm:"column:goods_title;type:text;not null;default:''"`
 	GoodsCoverUrl   string  `gorm:"column:goods_cover_url;size:1024;not null;default:''"`
 	GoodsPriceRange string  `gorm:"column:goods_price_range;size:1024;not null;default:''"`
@@ -73,6 +92,9 @@ type VideoRank struct {
 	SalesCountHigh int64 `gorm:"column:sales_count_high;comment:销量范围高值"`
 	TotalSalesLow  int64 `gorm:"column:total_sales_low;comment:销售额范围低值（分）"`
 	TotalSalesHigh int64 `gorm:"column:total_sales_high;comment:销售额范围高值（分）"`
+
+	// 数据血缘
+	SourceDataId int64 `gorm:"column:source_data_id;index;comment:来源 source_data ID"`
 }
 
 // videoRankRepo implements VideoRankRepo using GORM.
@@ -94,6 +116,16 @@ type videoRankRepo struct {
 	*Data
 }
 
+func (r *videoRankRepo) LatestRankDate(ctx context.Context, periodType string) (string, error) {
+	var rankDate string
+	err := r.db.WithContext(ctx).
+		Model(&VideoRank{}).
+		Where("period_type = ?", periodType).
+		Select("COALESCE(MAX(rank_date), '')").
+		Scan(&rankDate).Error
+	return rankDate, err
+}
+
 // GetByAwemeID 查询单个视频榜单
 func (r *videoRankRepo) GetByAwemeID(ctx context.Context, awemeID, rankType, rankDate string) (*v1.VideoRankDTO, error) {
 	var model VideoRank
@@ -104,70 +136,144 @@ func (r *videoRankRepo) GetByAwemeID(ctx context.Context, awemeID, rankType, ran
 	return CopyVideoRankToDTO(&model), nil
 }
 
-// ListPage 分页查询视频榜单
-func (r *videoRankRepo) ListPage(ctx context.Context, page, size int, rankType, rankDate, sortBy, sortOrder string) ([]*v1.VideoRankDTO, int64, error) {
-	var models []*VideoRank
-	var total int64
-
-	db := r.db.WithContext(ctx).Model(&VideoRank{})
+// videoRankSortFields 是榜单列表的排序白名单，key 沿用前端传入的字段名
+var videoRankSortFields = SortFields{
+	"salesCountStr": "sales_count_high", // 按销量范围最高值排序
+	"totalSalesStr": "total_sales_high", // 按销售额范围最高值排序
+	"rankPosition":  "rank_position",
+}
 
-	// 添加查询条件
-	if rankType != "" {
-		db = db.Where("period_type = ?", rankType)
-	}
-	if rankDate != "" {
-		db = db.Where("rank_date = ?", rankDate)
+// IsValidVideoRankSortField 判断榜单列表的排序字段是否在白名单内，空值表示按名次排序
+func IsValidVideoRankSortField(sortBy string) bool {
+	if sortBy == "" {
+		return true
 	}
+	_, ok := videoRankSortFields[sortBy]
+	return ok
+}
 
-	// 应用排序逻辑
-	if sortBy != "" {
-		var orderColumn string
-		switch sortBy {
-		case "salesCountStr":
-			orderColumn = "sales_count_high" // 按销量范围最高值排序
-		case "totalSalesStr":
-			orderColumn = "total_sales_high" // 按销售额范围最高值排序
-		// 可以根据需要添加其他排序字段
-		default:
-			orderColumn = "" // 对于未知的排序字段，忽略排序
-		}
-
-		if orderColumn != "" {
-			// 根据 sort_order 参数决定升序或降序
-			if strings.ToLower(sortOrder) == "desc" {
-				db = db.Order(orderColumn + " DESC")
-			} else {
-				db = db.Order(orderColumn + " ASC")
-			}
-		}
+// listQuery 构造榜单列表的查询条件和排序，默认按名次升序；未知的排序字段返回 ErrInvalidSortField
+func (r *videoRankRepo) listQuery(ctx context.Context, rankType, rankDate string, categoryID uint64, sortBy, sortOrder string) *queryBuilder {
+	order := v1.SortOrder_ASC
+	if strings.ToLower(sortOrder) == "desc" {
+		order = v1.SortOrder_DESC
 	}
 
-	// 计算总数
-	if err := db.Count(&total).Error; err != nil {
-		return nil, 0, err
+	qb := newQueryBuilder(r.db.WithContext(ctx).Model(&VideoRank{}), "id").
+		Eq("period_type", rankType).
+		Eq("rank_date", rankDate)
+	if categoryID != 0 {
+		qb.Where("goods_id IN (?)", categoryGoodsSubquery(r.db, categoryID))
 	}
+	return qb.Sort(sortBy, order, videoRankSortFields, "rank_position", false)
+}
 
-	// 分页查询
-	offset := (page - 1) * size
-	if err := db.Offset(offset).Limit(size).Find(&models).Error; err != nil {
-		return nil, 0, err
+// ListPage 分页查询视频榜单
+func (r *videoRankRepo) ListPage(ctx context.Context, page *v1.PageRequest, rankType, rankDate string, categoryID uint64, sortBy, sortOrder string) ([]*v1.VideoRankDTO, *v1.PageResponse, error) {
+	var models []*VideoRank
+	pageResp, err := r.listQuery(ctx, rankType, rankDate, categoryID, sortBy, sortOrder).Page(page, &models)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	result := make([]*v1.VideoRankDTO, 0, len(models))
 	for _, m := range models {
 		result = append(result, CopyVideoRankToDTO(m))
 	}
+	return result, pageResp, nil
+}
 
-	return result, total, nil
+func (r *videoRankRepo) Iterate(ctx context.Context, rankType, rankDate string, categoryID uint64, sortBy, sortOrder string, fn func(*VideoRank) error) error {
+	return eachRow(r.listQuery(ctx, rankType, rankDate, categoryID, sortBy, sortOrder), fn)
 }
 
-// BatchCreate inserts multiple VideoRank records into the database.
+// BatchCreate 批量写入榜单记录，自然键冲突时更新已有记录，重复写入同一批数据不会产生重复行。
 func (r *videoRankRepo) BatchCreate(ctx context.Context, ranks []*v1.VideoRankDTO) error {
 	var models []*VideoRank
 	for _, rank := range ranks {
 		models = append(models, copyVideoRankToDO(rank))
 	}
-	return r.db.WithContext(ctx).CreateInBatches(models, len(models)).Error
+	return upsertVideoRanks(r.db.WithContext(ctx), dedupVideoRanks(models))
+}
+
+// ReplaceBySource 先删除同一 source_data 产生的旧榜单记录，再插入新记录。
+// 同一页原始数据被重复处理时，结果与只处理一次相同。
+func (r *videoRankRepo) ReplaceBySource(ctx context.Context, sourceDataID int64, ranks []*v1.VideoRankDTO) error {
+	models := make([]*VideoRank, 0, len(ranks))
+	for _, rank := range ranks {
+		m := copyVideoRankToDO(rank)
+		m.SourceDataId = sourceDataID
+		models = append(models, m)
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("source_data_id = ?", sourceDataID).Delete(&VideoRank{}).Error; err != nil {
+			return fmt.Errorf("删除旧榜单数据失败 (source_data_id: %d): %w", sourceDataID, err)
+		}
+		return upsertVideoRanks(tx, dedupVideoRanks(models))
+	})
+}
+
+// upsertVideoRanks 按自然键执行 INSERT ... ON CONFLICT DO UPDATE
+func upsertVideoRanks(db *gorm.DB, models []*VideoRank) error {
+	if len(models) == 0 {
+		return nil
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "period_type"}, {Name: "rank_date"}, {Name: "aweme_id"}, {Name: "goods_id"},
+		},
+		DoUpdates: clause.AssignmentColumns(allVideoRankColumnsForUpsert()),
+	}).CreateInBatches(models, len(models)).Error
+}
+
+// allVideoRankColumnsForUpsert lists columns that are refreshed when the natural key already exists.
+func allVideoRankColumnsForUpsert() []string {
+	return []string{
+		"start_date", "end_date", "rank_position",
+		"aweme_cover_url", "aweme_desc", "aweme_pub_time", "aweme_share_url", "duration_str", "aweme_score_str", "aweme_detail_url",
+		"goods_title", "goods_cover_url", "goods_price_range", "goods_price", "cos_ratio", "commission_price",
+		"shop_name", "brand_name", "category_names",
+		"blogger_id", "blogger_uid", "blogger_name", "blogger_avatar", "blogger_fans_num", "blogger_tag",
+		"sales_count_str", "total_sales_str", "like_count_inc_str", "play_count_inc_str",
+		"sales_count_low", "sales_count_high", "total_sales_low", "total_sales_high",
+		"source_data_id",
+	}
+}
+
+// dedupVideoRanks 去除同一批次内自然键重复的记录（保留第一次出现，即名次最靠前的一条），
+// 否则 PostgreSQL 会因同一语句内重复更新同一行而报错。
+func dedupVideoRanks(models []*VideoRank) []*VideoRank {
+	seen := make(map[string]struct{}, len(models))
+	result := make([]*VideoRank, 0, len(models))
+	for _, m := range models {
+		key := m.PeriodType + "|" + m.RankDate + "|" + m.AwemeId + "|" + m.GoodsId
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, m)
+	}
+	return result
+}
+
+// migrateVideoRankUniqueKey 在创建自然键唯一索引之前清理历史重复数据。
+// 每组重复记录只保留 id 最大（最新写入）的一条；索引已存在时直接跳过。
+func migrateVideoRankUniqueKey(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&VideoRank{}) || m.HasIndex(&VideoRank{}, videoRankUniqueIndex) {
+		return nil
+	}
+	err := db.Exec(`DELETE FROM video_ranks a
+		USING video_ranks b
+		WHERE a.period_type = b.period_type
+		  AND a.rank_date = b.rank_date
+		  AND a.aweme_id = b.aweme_id
+		  AND a.goods_id = b.goods_id
+		  AND a.id < b.id`).Error
+	if err != nil {
+		return fmt.Errorf("清理重复榜单数据失败: %w", err)
+	}
+	return nil
 }
 
 // NewVideoRankRepo creates a new VideoRankRepo.
@@ -184,6 +290,7 @@ func copyVideoRankToDO(dto *v1.VideoRankDTO) *VideoRank {
 		RankDate:        dto.RankDate,
 		StartDate:       dto.StartDate,
 		EndDate:         dto.EndDate,
+		RankPosition:    int(dto.RankPosition),
 		AwemeId:         dto.AwemeId,
 		AwemeCoverUrl:   dto.AwemeCoverUrl,
 		AwemeDesc:       dto.AwemeDesc,
@@ -216,6 +323,7 @@ func copyVideoRankToDO(dto *v1.VideoRankDTO) *VideoRank {
 		SalesCountHigh:  dto.SalesCountHigh,
 		TotalSalesLow:   dto.TotalSalesLow,
 		TotalSalesHigh:  dto.TotalSalesHigh,
+		SourceDataId:    dto.SourceDataId,
 	}
 }
 
@@ -230,6 +338,7 @@ func CopyVideoRankToDTO(do *VideoRank) *v1.VideoRankDTO {
 		RankDate:        do.RankDate,
 		StartDate:       do.StartDate,
 		EndDate:         do.EndDate,
+		RankPosition:    int32(do.RankPosition),
 		AwemeId:         do.AwemeId,
 		AwemeCoverUrl:   do.AwemeCoverUrl,
 		AwemeDesc:       do.AwemeDesc,
@@ -262,5 +371,6 @@ func CopyVideoRankToDTO(do *VideoRank) *v1.VideoRankDTO {
 		SalesCountHigh:  do.SalesCountHigh,
 		TotalSalesLow:   do.TotalSalesLow,
 		TotalSalesHigh:  do.TotalSalesHigh,
+		SourceDataId:    do.SourceDataId,
 	}
 }
@@ -0,0 +1,118 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// VideoRankHistory 是 video_rank_history 视图的只读模型。
+// 视图在 video_ranks 的基础上，按 (period_type, aweme_id, goods_id) 分区计算上一次上榜的名次、日期以及首次上榜日期。
+// 注意 PreviousRankDate 只是上一次出现的日期，不一定是紧邻的上一个周期，是否连续需要调用方判断。
+type VideoRankHistory struct {
+	VideoRank `gorm:"embedded"`
+
+	PreviousPosition int    `gorm:"column:previous_position"`
+	PreviousRankDate string `gorm:"column:previous_rank_date"`
+	FirstSeenDate    string `gorm:"column:first_seen_date"`
+}
+
+func (VideoRankHistory) TableName() string {
+	return "video_rank_history"
+}
+
+// dropVideoRankHistoryView 删除 video_rank_history 视图。
+// 视图依赖 video_ranks 的列，必须在 AutoMigrate 修改表结构之前删除。
+func dropVideoRankHistoryView(db *gorm.DB) error {
+	if err := db.Exec(`DROP VIEW IF EXISTS video_rank_history`).Error; err != nil {
+		return fmt.Errorf("删除 video_rank_history 视图失败: %w", err)
+	}
+	return nil
+}
+
+// createVideoRankHistoryView 创建 video_rank_history 视图。
+// 视图使用 r.*，因此每次启动都随表结构一起重建，而不是 CREATE OR REPLACE。
+func createVideoRankHistoryView(db *gorm.DB) error {
+	err := db.Exec(`CREATE VIEW video_rank_history AS
+		SELECT r.*,
+			COALESCE(LAG(r.rank_position) OVER w, 0) AS previous_position,
+			COALESCE(LAG(r.rank_date) OVER w, '') AS previous_rank_date,
+			MIN(r.rank_date) OVER (PARTITION BY r.period_type, r.aweme_id, r.goods_id) AS first_seen_date
+		FROM video_ranks r
+		WINDOW w AS (PARTITION BY r.period_type, r.aweme_id, r.goods_id ORDER BY r.rank_date)`).Error
+	if err != nil {
+		return fmt.Errorf("创建 video_rank_history 视图失败: %w", err)
+	}
+	return nil
+}
+
+// ListHistory 按日期升序查询单个视频在指定周期榜单上的全部上榜记录，startDate/endDate 为空时不限制
+func (r *videoRankRepo) ListHistory(ctx context.Context, awemeID, periodType, startDate, endDate string) ([]*VideoRankHistory, error) {
+	db := r.db.WithContext(ctx).Model(&VideoRankHistory{}).
+		Where("aweme_id = ? AND period_type = ?", awemeID, periodType)
+	if startDate != "" {
+		db = db.Where("rank_date >= ?", startDate)
+	}
+	if endDate != "" {
+		db = db.Where("rank_date <= ?", endDate)
+	}
+	var rows []*VideoRankHistory
+	if err := db.Order("rank_date ASC, rank_position ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ListHistoryByDate 查询某一期榜单的全部记录，并附带每条记录上一次上榜的信息。
+// 不走 video_rank_history 视图：视图的窗口函数不按 rank_date 分区，日期过滤无法下推，
+// 每次查询都要计算该周期的全部历史；这里只取当期记录，再按 idx_video_rank_item_date 逐条查找上一次和首次上榜。
+func (r *videoRankRepo) ListHistoryByDate(ctx context.Context, periodType, rankDate string) ([]*VideoRankHistory, error) {
+	var rows []*VideoRankHistory
+	err := r.db.WithContext(ctx).Table("video_ranks AS r").
+		Select(`r.*,
+			COALESCE(prev.rank_position, 0) AS previous_position,
+			COALESCE(prev.rank_date, '') AS previous_rank_date,
+			COALESCE(fs.rank_date, r.rank_date) AS first_seen_date`).
+		Joins(`LEFT JOIN LATERAL (
+			SELECT p.rank_position, p.rank_date FROM video_ranks p
+			WHERE p.period_type = r.period_type AND p.aweme_id = r.aweme_id AND p.goods_id = r.goods_id AND p.rank_date < ?
+			ORDER BY p.rank_date DESC LIMIT 1
+		) prev ON TRUE`, rankDate).
+		Joins(`LEFT JOIN LATERAL (
+			SELECT f.rank_date FROM video_ranks f
+			WHERE f.period_type = r.period_type AND f.aweme_id = r.aweme_id AND f.goods_id = r.goods_id AND f.rank_date < ?
+			ORDER BY f.rank_date ASC LIMIT 1
+		) fs ON TRUE`, rankDate).
+		Where("r.period_type = ? AND r.rank_date = ?", periodType, rankDate).
+		Order("r.rank_position ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ListRankDates 返回一批视频在指定周期榜单上、不晚于 untilDate 的所有上榜日期（升序去重），key 为 aweme_id
+func (r *videoRankRepo) ListRankDates(ctx context.Context, periodType string, awemeIDs []string, untilDate string) (map[string][]string, error) {
+	result := make(map[string][]string, len(awemeIDs))
+	if len(awemeIDs) == 0 {
+		return result, nil
+	}
+	var rows []struct {
+		AwemeId  string
+		RankDate string
+	}
+	err := r.db.WithContext(ctx).Model(&VideoRank{}).
+		Distinct("aweme_id", "rank_date").
+		Where("period_type = ? AND aweme_id IN ? AND rank_date <= ?", periodType, awemeIDs, untilDate).
+		Order("aweme_id, rank_date").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.AwemeId] = append(result[row.AwemeId], row.RankDate)
+	}
+	return result, nil
+}
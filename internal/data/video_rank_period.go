@@ -0,0 +1,115 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 榜单周期，对应 video_ranks.period_type，也是 source_data.data_type 的后缀
+const (
+	RankPeriodDay   = "day"
+	RankPeriodWeek  = "week"
+	RankPeriodMonth = "month"
+)
+
+// VideoRankPeriods 是支持采集和处理的全部榜单周期
+var VideoRankPeriods = []string{RankPeriodDay, RankPeriodWeek, RankPeriodMonth}
+
+// IsValidRankPeriod 判断榜单周期是否受支持
+func IsValidRankPeriod(period string) bool {
+	for _, p := range VideoRankPeriods {
+		if p == period {
+			return true
+		}
+	}
+	return false
+}
+
+// VideoRankDataType 返回某个榜单周期对应的 source_data.data_type，例如 "video_rank_week"
+func VideoRankDataType(period string) string {
+	return DataTypeVideoRank + "_" + period
+}
+
+// VideoRankPeriodFromDataType 从 source_data.data_type 中解析榜单周期，不是榜单类型或周期未知时 ok 为 false
+func VideoRankPeriodFromDataType(dataType string) (period string, ok bool) {
+	period, found := strings.CutPrefix(dataType, DataTypeVideoRank+"_")
+	if !found || !IsValidRankPeriod(period) {
+		return "", false
+	}
+	return period, true
+}
+
+// VideoRankPeriodDates 根据周期和任意一天的 datecode 计算榜单的起止日期和主日期（rank_date），格式均为 "20060102"。
+// 日榜三者相同；周榜为所在周的周一到周日，rank_date 为周一；月榜为所在月的首末日，rank_date 为月初。
+func VideoRankPeriodDates(period, datecode string) (startDate, endDate, rankDate string) {
+	// 默认都为 datecode
+	startDate, endDate, rankDate = datecode, datecode, datecode
+	switch period {
+	case RankPeriodWeek:
+		d, err := time.Parse("20060102", datecode)
+		if err != nil {
+			return
+		}
+		weekday := int(d.Weekday())
+		if weekday == 0 {
+			weekday = 7 // 周日
+		}
+		monday := d.AddDate(0, 0, -weekday+1)
+		sunday := d.AddDate(0, 0, 7-weekday)
+		startDate = monday.Format("20060102")
+		endDate = sunday.Format("20060102")
+		rankDate = startDate
+	case RankPeriodMonth:
+		d, err := time.Parse("20060102", datecode)
+		if err != nil {
+			return
+		}
+		first := time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, d.Location())
+		last := first.AddDate(0, 1, -1)
+		startDate = first.Format("20060102")
+		endDate = last.Format("20060102")
+		rankDate = startDate
+	}
+	return
+}
+
+// ShiftRankDate 将榜单日期按周期移动 n 期，日期格式为 "20060102"。
+// 传入的日期会先对齐到所在周期的 rank_date。
+func ShiftRankDate(period, rankDate string, n int) (string, bool) {
+	if !IsValidRankPeriod(period) {
+		return "", false
+	}
+	_, _, aligned := VideoRankPeriodDates(period, rankDate)
+	d, err := time.Parse("20060102", aligned)
+	if err != nil {
+		return "", false
+	}
+	switch period {
+	case RankPeriodDay:
+		d = d.AddDate(0, 0, n)
+	case RankPeriodWeek:
+		d = d.AddDate(0, 0, 7*n)
+	case RankPeriodMonth:
+		d = d.AddDate(0, n, 0)
+	}
+	return d.Format("20060102"), true
+}
+
+// migrateLegacyVideoRankDataType 将旧版本写入的 data_type="video_rank" 记录按 entity_id（"{period}_{datecode}"）
+// 中的周期改写为 video_rank_{period}，使其能被对应的处理器识别
+func migrateLegacyVideoRankDataType(db *gorm.DB) error {
+	if !db.Migrator().HasTable(&SourceData{}) {
+		return nil
+	}
+	err := db.Exec(`UPDATE source_data
+		SET data_type = ? || '_' || split_part(entity_id, '_', 1)
+		WHERE data_type = ? AND split_part(entity_id, '_', 1) IN ?`,
+		DataTypeVideoRank, DataTypeVideoRank, VideoRankPeriods).Error
+	if err != nil {
+		return fmt.Errorf("迁移旧版榜单 data_type 失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package data
+
+import "testing"
+
+func TestVideoRankPeriodDates(t *testing.T) {
+	tests := []struct {
+		name          string
+		period        string
+		datecode      string
+		wantStartDate string
+		wantEndDate   string
+		wantRankDate  string
+	}{
+		{name: "day", period: RankPeriodDay, datecode: "20250702", wantStartDate: "20250702", wantEndDate: "20250702", wantRankDate: "20250702"},
+		{name: "week from wednesday", period: RankPeriodWeek, datecode: "20250702", wantStartDate: "20250630", wantEndDate: "20250706", wantRankDate: "20250630"},
+		{name: "week from monday", period: RankPeriodWeek, datecode: "20250630", wantStartDate: "20250630", wantEndDate: "20250706", wantRankDate: "20250630"},
+		{name: "week from sunday", period: RankPeriodWeek, datecode: "20250706", wantStartDate: "20250630", wantEndDate: "20250706", wantRankDate: "20250630"},
+		{name: "week across year", period: RankPeriodWeek, datecode: "20250101", wantStartDate: "20241230", wantEndDate: "20250105", wantRankDate: "20241230"},
+		{name: "month", period: RankPeriodMonth, datecode: "20250715", wantStartDate: "20250701", wantEndDate: "20250731", wantRankDate: "20250701"},
+		{name: "leap february", period: RankPeriodMonth, datecode: "20240210", wantStartDate: "20240201", wantEndDate: "20240229", wantRankDate: "20240201"},
+		{name: "malformed week unchanged", period: RankPeriodWeek, datecode: "2025-07-02", wantStartDate: "2025-07-02", wantEndDate: "2025-07-02", wantRankDate: "2025-07-02"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, rank := VideoRankPeriodDates(tt.period, tt.datecode)
+			if start != tt.wantStartDate || end != tt.wantEndDate || rank != tt.wantRankDate {
+				t.Errorf("VideoRankPeriodDates(%q, %q) = (%s, %s, %s), want (%s, %s, %s)",
+					tt.period, tt.datecode, start, end, rank, tt.wantStartDate, tt.wantEndDate, tt.wantRankDate)
+			}
+		})
+	}
+}
+
+func TestShiftRankDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		period   string
+		rankDate string
+		n        int
+		want     string
+		wantOk   bool
+	}{
+		{name: "previous day", period: RankPeriodDay, rankDate: "20250301", n: -1, want: "20250228", wantOk: true},
+		{name: "next day", period: RankPeriodDay, rankDate: "20241231", n: 1, want: "20250101", wantOk: true},
+		{name: "previous week aligns first", period: RankPeriodWeek, rankDate: "20250703", n: -1, want: "20250623", wantOk: true},
+		{name: "two weeks ahead", period: RankPeriodWeek, rankDate: "20250630", n: 2, want: "20250714", wantOk: true},
+		{name: "previous month", period: RankPeriodMonth, rankDate: "20250131", n: -1, want: "20241201", wantOk: true},
+		{name: "unknown period", period: "year", rankDate: "20250101", n: -1},
+		{name: "malformed date", period: RankPeriodDay, rankDate: "2025-01-01", n: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ShiftRankDate(tt.period, tt.rankDate, tt.n)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("ShiftRankDate(%q, %q, %d) = (%q, %v), want (%q, %v)", tt.period, tt.rankDate, tt.n, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestVideoRankPeriodFromDataType(t *testing.T) {
+	tests := []struct {
+		dataType string
+		want     string
+		wantOk   bool
+	}{
+		{dataType: "video_rank_day", want: RankPeriodDay, wantOk: true},
+		{dataType: "video_rank_month", want: RankPeriodMonth, wantOk: true},
+		{dataType: "video_rank", wantOk: false},
+		{dataType: "video_rank_year", wantOk: false},
+		{dataType: DataTypeVideoDetail, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dataType, func(t *testing.T) {
+			got, ok := VideoRankPeriodFromDataType(tt.dataType)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("VideoRankPeriodFromDataType(%q) = (%q, %v), want (%q, %v)", tt.dataType, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
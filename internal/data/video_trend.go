@@ -11,13 +11,16 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+// videoTrendUniqueIndex 是趋势数据自然键 (aweme_id, date_code) 上的唯一索引名
+const videoTrendUniqueIndex = "uk_video_trend_aweme_date"
+
 // VideoTrend 视频每日趋势数据模型
 type VideoTrend struct {
 	Id                 int64     `gorm:"primaryKey"`
 	CreatedAt          time.Time `gorm:"autoCreateTime;type:timestamp"`
 	UpdatedAt          time.Time `gorm:"autoUpdateTime;type:timestamp"`
-	AwemeId            string    `gorm:"size:255;not null;"` // 视频ID
-	DateCode           int       `gorm:"not null;"`          // 数据日期
+	AwemeId            string    `gorm:"size:255;not null;uniqueIndex:uk_video_trend_aweme_date,priority:1"` // 视频ID
+	DateCode           int       `gorm:"not null;uniqueIndex:uk_video_trend_aweme_date,priority:2"`          // 数据日期
 	LikeCount          int64
 	LikeCountStr       string `gorm:"size:20"`
 	ShareCount         int64
@@ -57,14 +60,38 @@ type VideoTrend struct {
 // VideoTrendRepo 定义视频趋势数据仓库接口
 type VideoTrendRepo interface {
 	BatchUpsert(ctx context.Context, trends []*VideoTrend) error
-	ListPage(ctx context.Context, page, size int, awemeId, startDate, endDate string) ([]*VideoTrend, int64, error)
+	ListPage(ctx context.Context, page *v1.PageRequest, awemeId, startDate, endDate string) ([]*VideoTrend, *v1.PageResponse, error)
+	// Iterate 按与 ListPage 相同的条件和排序流式读取全部趋势数据，用于导出
+	Iterate(ctx context.Context, awemeId, startDate, endDate string, fn func(*VideoTrend) error) error
 	BatchOverwrite(ctx context.Context, trends []*VideoTrend) error
+	// ListByAwemeIDs 查询一批视频的趋势数据，用于趋势分析
+	ListByAwemeIDs(ctx context.Context, awemeIDs []string, startDate, endDate int) ([]*VideoTrend, error)
+	// ListGrowth 按窗口增量对视频排序，用于"增长最快的视频"榜单
+	ListGrowth(ctx context.Context, metric string, prevStart, curStart, curEnd int, byRate bool, limit int) ([]*VideoTrendGrowth, error)
 }
 
 type videoTrendRepo struct {
 	*Data
 }
 
+// migrateVideoTrendUniqueKey 在创建 (aweme_id, date_code) 唯一索引之前清理历史重复数据。
+// 每组重复记录只保留 id 最大（最新写入）的一条；索引已存在时直接跳过。
+func migrateVideoTrendUniqueKey(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&VideoTrend{}) || m.HasIndex(&VideoTrend{}, videoTrendUniqueIndex) {
+		return nil
+	}
+	err := db.Exec(`DELETE FROM video_trends a
+		USING video_trends b
+		WHERE a.aweme_id = b.aweme_id
+		  AND a.date_code = b.date_code
+		  AND a.id < b.id`).Error
+	if err != nil {
+		return fmt.Errorf("清理重复趋势数据失败: %w", err)
+	}
+	return nil
+}
+
 // NewVideoTrendRepo .
 func NewVideoTrendRepo(data *Data) VideoTrendRepo {
 	return &videoTrendRepo{Data: data}
@@ -141,39 +168,32 @@ func (r *videoTrendRepo) BatchOverwrite(ctx context.Context, trends []*VideoTren
 	})
 }
 
-// ListPage 分页查询视频趋势数据
-func (r *videoTrendRepo) ListPage(ctx context.Context, page, size int, awemeId, startDate, endDate string) ([]*VideoTrend, int64, error) {
-	var trends []*VideoTrend
-	var total int64
-
-	db := r.db.WithContext(ctx).Model(&VideoTrend{})
-
-	// 应用筛选条件
-	if awemeId != "" {
-		db = db.Where("aweme_id = ?", awemeId)
-	}
+// listQuery 构造趋势列表的查询条件，默认按日期升序排序
+func (r *videoTrendRepo) listQuery(ctx context.Context, awemeId, startDate, endDate string) *queryBuilder {
+	qb := newQueryBuilder(r.db.WithContext(ctx).Model(&VideoTrend{}), "id").
+		Eq("aweme_id", awemeId)
 	if startDate != "" {
-		db = db.Where("date_code >= ?", startDate)
+		qb.Where("date_code >= ?", startDate)
 	}
 	if endDate != "" {
-		db = db.Where("date_code <= ?", endDate)
-	}
-
-	// 计算总数
-	if err := db.Count(&total).Error; err != nil {
-		return nil, 0, err
+		qb.Where("date_code <= ?", endDate)
 	}
 
-	// 默认按日期升序排序
-	db = db.Order("date_code ASC")
+	return qb.Sort("", v1.SortOrder_ASC, nil, "date_code", false)
+}
 
-	// 分页
-	offset := (page - 1) * size
-	if err := db.Offset(offset).Limit(size).Find(&trends).Error; err != nil {
-		return nil, 0, err
+// ListPage 分页查询视频趋势数据
+func (r *videoTrendRepo) ListPage(ctx context.Context, page *v1.PageRequest, awemeId, startDate, endDate string) ([]*VideoTrend, *v1.PageResponse, error) {
+	var trends []*VideoTrend
+	pageResp, err := r.listQuery(ctx, awemeId, startDate, endDate).Page(page, &trends)
+	if err != nil {
+		return nil, nil, err
 	}
+	return trends, pageResp, nil
+}
 
-	return trends, total, nil
+func (r *videoTrendRepo) Iterate(ctx context.Context, awemeId, startDate, endDate string, fn func(*VideoTrend) error) error {
+	return eachRow(r.listQuery(ctx, awemeId, startDate, endDate), fn)
 }
 
 // CopyVideoTrendToDTO 将 data.VideoTrend 模型转换为 v1.VideoTrendDTO
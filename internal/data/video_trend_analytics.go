@@ -0,0 +1,73 @@
+package data
+
+import (
+	"context"
+	"fmt"
+)
+
+// VideoTrendMetrics 是趋势分析支持的指标，key 为 API 中的指标名，value 为 video_trends 中的每日增量列
+var VideoTrendMetrics = map[string]string{
+	"sales_gmv":     "inc_sales_gmv",
+	"sales_count":   "inc_sales_count",
+	"like_count":    "inc_like_count",
+	"comment_count": "inc_comment_count",
+	"share_count":   "inc_share_count",
+	"collect_count": "inc_collect_count",
+}
+
+// VideoTrendGrowth 是单个视频在当前窗口和上一个等长窗口内的指标增量之和
+type VideoTrendGrowth struct {
+	AwemeId  string
+	Current  float64
+	Previous float64
+}
+
+// ListByAwemeIDs 返回一批视频在 [startDate, endDate] 内的趋势数据，按视频和日期升序；日期为 0 时不限制
+func (r *videoTrendRepo) ListByAwemeIDs(ctx context.Context, awemeIDs []string, startDate, endDate int) ([]*VideoTrend, error) {
+	if len(awemeIDs) == 0 {
+		return nil, nil
+	}
+	db := r.db.WithContext(ctx).Where("aweme_id IN ?", awemeIDs)
+	if startDate != 0 {
+		db = db.Where("date_code >= ?", startDate)
+	}
+	if endDate != 0 {
+		db = db.Where("date_code <= ?", endDate)
+	}
+	var trends []*VideoTrend
+	if err := db.Order("aweme_id ASC, date_code ASC").Find(&trends).Error; err != nil {
+		return nil, err
+	}
+	return trends, nil
+}
+
+// ListGrowth 按 [curStart, curEnd] 相对 [prevStart, curStart) 的指标增量差值倒序返回视频。
+// byRate 为 true 时按增长率排序，上一窗口为 0 的视频排在最后。
+func (r *videoTrendRepo) ListGrowth(ctx context.Context, metric string, prevStart, curStart, curEnd int, byRate bool, limit int) ([]*VideoTrendGrowth, error) {
+	column, ok := VideoTrendMetrics[metric]
+	if !ok {
+		return nil, fmt.Errorf("不支持的趋势指标: %s", metric)
+	}
+
+	order := "(current - previous) DESC"
+	if byRate {
+		order = "(current - previous) / NULLIF(previous, 0) DESC NULLS LAST, current DESC"
+	}
+	var rows []*VideoTrendGrowth
+	err := r.db.WithContext(ctx).Table("(?) AS g",
+		r.db.Model(&VideoTrend{}).
+			Select(`aweme_id,
+				COALESCE(SUM(CASE WHEN date_code >= ? THEN `+column+` END), 0) AS current,
+				COALESCE(SUM(CASE WHEN date_code < ? THEN `+column+` END), 0) AS previous`, curStart, curStart).
+			Where("date_code >= ? AND date_code <= ?", prevStart, curEnd).
+			Group("aweme_id"),
+	).
+		Where("current > 0").
+		Order(order + ", aweme_id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,141 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"gorm.io/gorm/clause"
+)
+
+// 关注对象类型
+const (
+	WatchEntityVideo   = "video"
+	WatchEntityProduct = "product"
+	WatchEntityBlogger = "blogger"
+)
+
+// WatchlistItem 关注对象，(entity_type, entity_id) 唯一。
+// 商品和达人通过 videos.goods_id、videos.blogger_id 展开为其关联的视频
+type WatchlistItem struct {
+	ID                     uint      `gorm:"primaryKey"`
+	CreatedAt              time.Time `gorm:"autoCreateTime;type:timestamp"`
+	UpdatedAt              time.Time `gorm:"autoUpdateTime;type:timestamp"`
+	EntityType             string    `gorm:"size:16;not null;uniqueIndex:uk_watchlist_entity,priority:1"`
+	EntityId               string    `gorm:"size:255;not null;uniqueIndex:uk_watchlist_entity,priority:2"`
+	RefreshIntervalMinutes int       `gorm:"not null;default:360;comment:重新采集间隔（分钟）"`
+	Note                   string    `gorm:"size:255;not null;default:''"`
+}
+
+func (WatchlistItem) TableName() string {
+	return "watchlist_items"
+}
+
+// WatchlistRepo 管理关注对象，并查询到期需要重新采集的视频
+type WatchlistRepo interface {
+	// Upsert 写入关注对象，已存在的对象更新刷新间隔和备注，写入后 items 带有 ID 和创建时间
+	Upsert(ctx context.Context, items []*WatchlistItem) error
+	// Delete 删除关注对象，不存在时返回 ErrNotFound
+	Delete(ctx context.Context, id uint) error
+	// ListPage 按添加时间倒序分页查询，entityType 为空表示全部
+	ListPage(ctx context.Context, page *v1.PageRequest, entityType string) ([]*WatchlistItem, *v1.PageResponse, error)
+	// FindDueVideos 认领关注对象关联的、距上次详情采集已超过刷新间隔的视频，新发布的视频优先。
+	// 一个视频同时被多个对象关注时，使用其中最短的刷新间隔；已被其他 worker 认领的视频不会返回。
+	FindDueVideos(ctx context.Context, limit int) ([]*VideoForCollection, error)
+}
+
+type watchlistRepo struct {
+	*Data
+}
+
+// NewWatchlistRepo .
+func NewWatchlistRepo(data *Data) WatchlistRepo {
+	return &watchlistRepo{Data: data}
+}
+
+func (r *watchlistRepo) Upsert(ctx context.Context, items []*WatchlistItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "entity_type"}, {Name: "entity_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"refresh_interval_minutes", "note", "updated_at"}),
+	}, clause.Returning{}).Create(items).Error
+}
+
+func (r *watchlistRepo) Delete(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&WatchlistItem{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *watchlistRepo) ListPage(ctx context.Context, page *v1.PageRequest, entityType string) ([]*WatchlistItem, *v1.PageResponse, error) {
+	var items []*WatchlistItem
+	pageResp, err := newQueryBuilder(r.db.WithContext(ctx).Model(&WatchlistItem{}), "id").
+		Eq("entity_type", entityType).
+		Sort("", v1.SortOrder_DESC, nil, "id", true).
+		Page(page, &items)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, pageResp, nil
+}
+
+func (r *watchlistRepo) FindDueVideos(ctx context.Context, limit int) ([]*VideoForCollection, error) {
+	db := r.db.WithContext(ctx)
+	now := time.Now()
+	// 按关注类型分别关联视频再合并，避免 OR 连接条件导致无法使用索引
+	var candidates []*VideoForCollection
+	err := db.Raw(`
+WITH watched AS (
+	SELECT w.entity_id AS aweme_id, w.refresh_interval_minutes
+	FROM watchlist_items w
+	WHERE w.entity_type = ?
+	UNION ALL
+	SELECT v.aweme_id, w.refresh_interval_minutes
+	FROM watchlist_items w
+	JOIN videos v ON v.goods_id = w.entity_id
+	WHERE w.entity_type = ?
+	UNION ALL
+	SELECT v.aweme_id, w.refresh_interval_minutes
+	FROM watchlist_items w
+	JOIN videos v ON CAST(v.blogger_id AS TEXT) = w.entity_id
+	WHERE w.entity_type = ?
+), due AS (
+	SELECT aweme_id, MIN(refresh_interval_minutes) AS refresh_minutes
+	FROM watched
+	GROUP BY aweme_id
+)
+SELECT v.aweme_id, v.aweme_pub_time, v.aweme_detail_url
+FROM due
+JOIN videos v ON v.aweme_id = due.aweme_id
+LEFT JOIN video_collection_states s ON s.aweme_id = v.aweme_id
+WHERE (s.aweme_id IS NULL OR s.status <> ? OR s.claimed_at < ?)
+	AND NOT EXISTS (
+		SELECT 1 FROM source_data d
+		WHERE d.entity_id = v.aweme_id
+			AND d.data_type IN ?
+			AND d.fetched_at > CAST(? AS TIMESTAMP) - due.refresh_minutes * INTERVAL '1 minute'
+	)
+ORDER BY v.aweme_pub_time DESC
+LIMIT ?`,
+		WatchEntityVideo, WatchEntityProduct, WatchEntityBlogger,
+		VideoCollectionClaimed, now.Add(-videoCollectionLease),
+		[]string{DataTypeVideoTrendHeadless, DataTypeVideoSummaryHeadless},
+		now, limit,
+	).Scan(&candidates).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询到期的关注视频失败: %w", err)
+	}
+	claimed, err := claimRefreshCollections(db, candidates, now)
+	if err != nil {
+		return nil, fmt.Errorf("认领到期的关注视频失败: %w", err)
+	}
+	return claimed, nil
+}
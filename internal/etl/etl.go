@@ -14,6 +14,8 @@ var ProviderSet = wire.NewSet(
 	NewETLUsecase,
 	NewVideoRankProcessor,
 	NewVideoDetailProcessor,
+	NewVideoCommentProcessor,
+	NewAudienceProcessor,
 )
 
 // Processor defines a generic ETL processor.
@@ -35,15 +37,27 @@ func NewETLUsecase(
 	sdRepo data.SourceDataRepo,
 	vrp *VideoRankProcessor, // video rank processor
 	vdp *VideoDetailProcessor, // video detail processor
+	vcp *VideoCommentProcessor, // video comment processor
+	ap *AudienceProcessor, // audience profile processor
 ) *ETLUsecase {
 	// key 是 source_data 表的 data_type
 	processors := map[string]Processor{
-		// 旧的 rank 处理器保持不变
-		"video_rank_day": vrp,
+		// 日榜、周榜、月榜共用同一个 rank 处理器，周期由 data_type 后缀决定
+		data.VideoRankDataType(data.RankPeriodDay):   vrp,
+		data.VideoRankDataType(data.RankPeriodWeek):  vrp,
+		data.VideoRankDataType(data.RankPeriodMonth): vrp,
 
 		// 新的：将 summary 和 trend 两种数据类型都指向同一个 Detail 处理器
 		"video_summary_headless": vdp,
 		"video_trend_headless":   vdp,
+
+		// 评论列表和评论关键词
+		data.DataTypeVideoComment:        vcp,
+		data.DataTypeVideoCommentKeyword: vcp,
+
+		// 视频观众画像和博主粉丝画像
+		data.DataTypeVideoAudience:   ap,
+		data.DataTypeBloggerAudience: ap,
 	}
 
 	return &ETLUsecase{
@@ -70,7 +84,20 @@ func (u *ETLUsecase) RunWithType(ctx context.Context, dataType string) error {
 
 	u.log.WithContext(ctx).Infof("发现 %d 条类型为 [%s] 的数据待处理。", len(list), dataType)
 
+	u.processList(ctx, list)
+
+	u.log.WithContext(ctx).Infof("类型为 [%s] 的数据已全部处理完毕。", dataType)
+	return nil
+}
+
+// processList 依次将每条原始数据交给对应的处理器，单条失败不会中断整个批次
+func (u *ETLUsecase) processList(ctx context.Context, list []*v1.SourceData) {
 	for _, raw := range list {
+		// 上下文取消后剩余数据保持未处理状态，留给下一次 ETL
+		if ctx.Err() != nil {
+			u.log.WithContext(ctx).Warnf("处理被取消，剩余数据留待下次处理: %v", ctx.Err())
+			return
+		}
 		// 根据数据类型从 map 中查找对应的处理器
 		processor, ok := u.processors[raw.DataType]
 		if !ok {
@@ -86,9 +113,6 @@ func (u *ETLUsecase) RunWithType(ctx context.Context, dataType string) error {
 		}
 		time.Sleep(2 * time.Microsecond)
 	}
-
-	u.log.WithContext(ctx).Infof("类型为 [%s] 的数据已全部处理完毕。", dataType)
-	return nil
 }
 
 // Run processes all unprocessed source data.
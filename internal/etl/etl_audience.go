@@ -0,0 +1,127 @@
+package etl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AudienceProcessor 负责处理视频观众画像和博主粉丝画像数据
+type AudienceProcessor struct {
+	log            *log.Helper
+	sourceDataRepo data.SourceDataRepo
+	audienceRepo   data.AudienceRepo
+}
+
+// NewAudienceProcessor .
+func NewAudienceProcessor(
+	logger log.Logger,
+	sourceDataRepo data.SourceDataRepo,
+	audienceRepo data.AudienceRepo,
+) *AudienceProcessor {
+	return &AudienceProcessor{
+		log:            log.NewHelper(log.With(logger, "module", "processor/audience")),
+		sourceDataRepo: sourceDataRepo,
+		audienceRepo:   audienceRepo,
+	}
+}
+
+// Process 解析画像的各维度分布并整体替换已有画像
+func (p *AudienceProcessor) Process(ctx context.Context, rawData *v1.SourceData) error {
+	var subjectType string
+	switch rawData.DataType {
+	case data.DataTypeVideoAudience:
+		subjectType = data.AudienceSubjectVideo
+	case data.DataTypeBloggerAudience:
+		subjectType = data.AudienceSubjectBlogger
+	default:
+		logMsg := fmt.Sprintf("未知的画像数据类型: %s", rawData.DataType)
+		p.log.Warn(logMsg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
+	}
+
+	var resp FeiguaAudienceData
+	if err := json.Unmarshal([]byte(rawData.RawContent), &resp); err != nil {
+		return &ProcessError{Msg: "unmarshal audience response failed", SourceID: rawData.Id, Err: err}
+	}
+	if !resp.Status {
+		logMsg := fmt.Sprintf("API(audience)返回错误: Code=%d, Msg=%s", resp.Code, resp.Msg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
+	}
+
+	var shares []*data.AudienceShare
+	for dimension, items := range map[string][]*FeiguaAudienceItem{
+		data.AudienceDimensionGender:   resp.Data.Gender,
+		data.AudienceDimensionAge:      resp.Data.Age,
+		data.AudienceDimensionProvince: resp.Data.Province,
+		data.AudienceDimensionCity:     resp.Data.City,
+	} {
+		shares = append(shares, audienceShares(dimension, items)...)
+	}
+	// 样本不足时接口返回空分布，保留上一次的画像
+	if len(shares) == 0 {
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusProcessed, "API返回的画像数据为空")
+	}
+
+	if err := p.audienceRepo.ReplaceProfile(ctx, subjectType, rawData.EntityId, shares); err != nil {
+		return &ProcessError{Msg: "replace audience profile failed", SourceID: rawData.Id, Err: err}
+	}
+	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, data.SourceDataStatusProcessed)
+}
+
+// audienceShares 把一个维度的分布转换为占比(%)，同名分组合并。
+// 占比的单位由每个分组自身的字段格式决定，不依赖合计，见 audienceRatio。
+func audienceShares(dimension string, items []*FeiguaAudienceItem) []*data.AudienceShare {
+	byBucket := make(map[string]*data.AudienceShare, len(items))
+	shares := make([]*data.AudienceShare, 0, len(items))
+	for _, item := range items {
+		bucket := strings.TrimSpace(item.Name)
+		share := audienceRatio(item)
+		if bucket == "" || share <= 0 {
+			continue
+		}
+		if s, ok := byBucket[bucket]; ok {
+			s.Share += share
+			continue
+		}
+		s := &data.AudienceShare{Dimension: dimension, Bucket: bucket, Share: share}
+		byBucket[bucket] = s
+		shares = append(shares, s)
+	}
+	return shares
+}
+
+// audienceRatio 返回分组的占比(%)。与 InteractionRate/InteractionRateStr 一样，
+// 接口的 RatioStr 为带 "%" 的百分数文本，Ratio 为 0~1 的小数；优先使用 RatioStr，没有时把 Ratio 换算为百分数。
+func audienceRatio(item *FeiguaAudienceItem) float64 {
+	if s := strings.TrimSpace(item.RatioStr); strings.HasSuffix(s, "%") {
+		if v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, "%")), 64); err == nil {
+			return v
+		}
+	}
+	return toFloat64(item.Ratio) * 100
+}
+
+// FeiguaAudienceData 画像接口的响应，视频观众画像和博主粉丝画像结构相同
+type FeiguaAudienceData struct {
+	FeiguaBaseResponse
+	Data struct {
+		Gender   []*FeiguaAudienceItem `json:"Gender"`
+		Age      []*FeiguaAudienceItem `json:"Age"`
+		Province []*FeiguaAudienceItem `json:"Province"`
+		City     []*FeiguaAudienceItem `json:"City"`
+	} `json:"data"`
+}
+
+// FeiguaAudienceItem 画像某个维度下的一个分组
+type FeiguaAudienceItem struct {
+	Name     string      `json:"Name"`
+	Ratio    json.Number `json:"Ratio"`
+	RatioStr string      `json:"RatioStr"`
+}
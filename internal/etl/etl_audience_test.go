@@ -0,0 +1,86 @@
+package etl
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+func TestAudienceRatio(t *testing.T) {
+	tests := []struct {
+		name string
+		item *FeiguaAudienceItem
+		want float64
+	}{
+		{name: "percent text", item: &FeiguaAudienceItem{RatioStr: "12.5%", Ratio: "0.125"}, want: 12.5},
+		{name: "percent text with spaces", item: &FeiguaAudienceItem{RatioStr: " 40 % "}, want: 40},
+		{name: "fraction only", item: &FeiguaAudienceItem{Ratio: "0.125"}, want: 12.5},
+		{name: "small fraction stays scaled", item: &FeiguaAudienceItem{Ratio: "0.004"}, want: 0.4},
+		{name: "text without percent falls back to ratio", item: &FeiguaAudienceItem{RatioStr: "12.5", Ratio: "0.3"}, want: 30},
+		{name: "malformed text falls back to ratio", item: &FeiguaAudienceItem{RatioStr: "abc%", Ratio: "0.3"}, want: 30},
+		{name: "empty", item: &FeiguaAudienceItem{}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := audienceRatio(tt.item)
+			if diff := got - tt.want; diff > 1e-9 || diff < -1e-9 {
+				t.Errorf("audienceRatio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAudienceShares(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []*FeiguaAudienceItem
+		want  []*data.AudienceShare
+	}{
+		{
+			name: "partial list is not rescaled",
+			items: []*FeiguaAudienceItem{
+				{Name: "广东", RatioStr: "20%"},
+				{Name: "浙江", RatioStr: "10%"},
+			},
+			want: []*data.AudienceShare{
+				{Dimension: "province", Bucket: "广东", Share: 20},
+				{Dimension: "province", Bucket: "浙江", Share: 10},
+			},
+		},
+		{
+			name: "duplicate buckets merged",
+			items: []*FeiguaAudienceItem{
+				{Name: "广东", RatioStr: "20%"},
+				{Name: " 广东 ", RatioStr: "5%"},
+				{Name: "浙江", Ratio: json.Number("0.1")},
+			},
+			want: []*data.AudienceShare{
+				{Dimension: "province", Bucket: "广东", Share: 25},
+				{Dimension: "province", Bucket: "浙江", Share: 10},
+			},
+		},
+		{
+			name: "empty names and zero shares skipped",
+			items: []*FeiguaAudienceItem{
+				{Name: "", RatioStr: "20%"},
+				{Name: "广东", RatioStr: "0%"},
+				{Name: "浙江"},
+				{Name: "江苏", RatioStr: "3%"},
+			},
+			want: []*data.AudienceShare{
+				{Dimension: "province", Bucket: "江苏", Share: 3},
+			},
+		},
+		{name: "no items", items: nil, want: []*data.AudienceShare{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := audienceShares("province", tt.items)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("audienceShares() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
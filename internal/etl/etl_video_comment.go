@@ -0,0 +1,184 @@
+package etl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// VideoCommentProcessor 负责处理视频评论列表和评论关键词数据
+type VideoCommentProcessor struct {
+	log              *log.Helper
+	sourceDataRepo   data.SourceDataRepo
+	videoCommentRepo data.VideoCommentRepo
+}
+
+// NewVideoCommentProcessor .
+func NewVideoCommentProcessor(
+	logger log.Logger,
+	sourceDataRepo data.SourceDataRepo,
+	videoCommentRepo data.VideoCommentRepo,
+) *VideoCommentProcessor {
+	return &VideoCommentProcessor{
+		log:              log.NewHelper(log.With(logger, "module", "processor/video_comment")),
+		sourceDataRepo:   sourceDataRepo,
+		videoCommentRepo: videoCommentRepo,
+	}
+}
+
+// Process 按数据类型分发到评论列表或关键词的处理逻辑
+func (p *VideoCommentProcessor) Process(ctx context.Context, rawData *v1.SourceData) error {
+	switch rawData.DataType {
+	case data.DataTypeVideoComment:
+		return p.processComments(ctx, rawData)
+	case data.DataTypeVideoCommentKeyword:
+		return p.processKeywords(ctx, rawData)
+	default:
+		logMsg := fmt.Sprintf("未知的视频评论数据类型: %s", rawData.DataType)
+		p.log.Warn(logMsg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
+	}
+}
+
+// processComments 处理评论列表的一页，评论按 comment_id 去重写入
+func (p *VideoCommentProcessor) processComments(ctx context.Context, rawData *v1.SourceData) error {
+	var resp FeiguaVideoCommentData
+	if err := json.Unmarshal([]byte(rawData.RawContent), &resp); err != nil {
+		return &ProcessError{Msg: "unmarshal video comment response failed", SourceID: rawData.Id, Err: err}
+	}
+	if !resp.Status {
+		logMsg := fmt.Sprintf("API(comment)返回错误: Code=%d, Msg=%s", resp.Code, resp.Msg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
+	}
+
+	// 同一批写入中 comment_id 重复会导致 upsert 失败，先去重
+	seen := make(map[string]bool, len(resp.Data.List))
+	comments := make([]*data.VideoComment, 0, len(resp.Data.List))
+	for _, item := range resp.Data.List {
+		if item.CommentId == "" || seen[item.CommentId] {
+			continue
+		}
+		seen[item.CommentId] = true
+		comments = append(comments, &data.VideoComment{
+			CommentId:   item.CommentId,
+			AwemeId:     rawData.EntityId,
+			Content:     strings.TrimSpace(item.Content),
+			Nickname:    item.NickName,
+			LikeCount:   toInt64(item.LikeCount),
+			ReplyCount:  toInt64(item.ReplyCount),
+			IpLabel:     item.IpLabel,
+			CommentTime: parseCommentTime(item.CreateTime),
+		})
+	}
+	if len(comments) == 0 {
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusProcessed, "API返回的评论数据为空")
+	}
+	if err := p.videoCommentRepo.UpsertComments(ctx, comments); err != nil {
+		return &ProcessError{Msg: "upsert video comments failed", SourceID: rawData.Id, Err: err}
+	}
+	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, data.SourceDataStatusProcessed)
+}
+
+// processKeywords 处理评论关键词统计，整体替换该视频已有的关键词
+func (p *VideoCommentProcessor) processKeywords(ctx context.Context, rawData *v1.SourceData) error {
+	var resp FeiguaVideoCommentKeywordData
+	if err := json.Unmarshal([]byte(rawData.RawContent), &resp); err != nil {
+		return &ProcessError{Msg: "unmarshal video comment keyword response failed", SourceID: rawData.Id, Err: err}
+	}
+	if !resp.Status {
+		logMsg := fmt.Sprintf("API(comment keyword)返回错误: Code=%d, Msg=%s", resp.Code, resp.Msg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
+	}
+
+	// 接口可能返回重复的词，合并后再写入，避免违反 (aweme_id, keyword) 唯一约束
+	byKeyword := make(map[string]*data.VideoCommentKeyword, len(resp.Data))
+	keywords := make([]*data.VideoCommentKeyword, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		word := strings.TrimSpace(item.Word)
+		if word == "" {
+			continue
+		}
+		if k, ok := byKeyword[word]; ok {
+			k.Count += toInt64(item.Count)
+			k.Ratio += toFloat64(item.Ratio)
+			continue
+		}
+		k := &data.VideoCommentKeyword{
+			AwemeId: rawData.EntityId,
+			Keyword: word,
+			Count:   toInt64(item.Count),
+			Ratio:   toFloat64(item.Ratio),
+		}
+		byKeyword[word] = k
+		keywords = append(keywords, k)
+	}
+	if err := p.videoCommentRepo.ReplaceKeywords(ctx, rawData.EntityId, keywords); err != nil {
+		return &ProcessError{Msg: "replace video comment keywords failed", SourceID: rawData.Id, Err: err}
+	}
+	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, data.SourceDataStatusProcessed)
+}
+
+// commentTimeLocation 为评论时间所在的时区（北京时间），加载失败时退回本地时区
+var commentTimeLocation = func() *time.Location {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}()
+
+// parseCommentTime 解析评论时间，接口返回 "2006-01-02 15:04:05" 格式（北京时间）或秒级时间戳，无法解析时返回 nil
+func parseCommentTime(s string) *time.Time {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05", s, commentTimeLocation); err == nil {
+		return &t
+	}
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil && sec > 0 {
+		t := time.Unix(sec, 0)
+		return &t
+	}
+	return nil
+}
+
+// FeiguaVideoCommentData 评论列表接口的响应
+type FeiguaVideoCommentData struct {
+	FeiguaBaseResponse
+	Data struct {
+		TotalCount json.Number               `json:"TotalCount"`
+		List       []*FeiguaVideoCommentItem `json:"List"`
+	} `json:"data"`
+}
+
+// FeiguaVideoCommentItem 单条评论
+type FeiguaVideoCommentItem struct {
+	CommentId  string      `json:"CommentId"`
+	Content    string      `json:"Content"`
+	NickName   string      `json:"NickName"`
+	LikeCount  json.Number `json:"LikeCount"`
+	ReplyCount json.Number `json:"ReplyCount"`
+	IpLabel    string      `json:"IpLabel"`
+	CreateTime string      `json:"CreateTime"`
+}
+
+// FeiguaVideoCommentKeywordData 评论关键词接口的响应
+type FeiguaVideoCommentKeywordData struct {
+	FeiguaBaseResponse
+	Data []*FeiguaVideoCommentKeywordItem `json:"data"`
+}
+
+// FeiguaVideoCommentKeywordItem 单个关键词及其出现次数、占比(%)
+type FeiguaVideoCommentKeywordItem struct {
+	Word  string      `json:"Word"`
+	Count json.Number `json:"Count"`
+	Ratio json.Number `json:"Ratio"`
+}
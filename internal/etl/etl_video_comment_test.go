@@ -0,0 +1,42 @@
+package etl
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCommentTime(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want time.Time
+		ok   bool
+	}{
+		{name: "beijing datetime", in: "2025-07-10 08:30:00", want: time.Date(2025, 7, 10, 0, 30, 0, 0, time.UTC), ok: true},
+		{name: "surrounding spaces", in: "  2025-07-10 08:30:00 ", want: time.Date(2025, 7, 10, 0, 30, 0, 0, time.UTC), ok: true},
+		{name: "unix seconds", in: "1752107400", want: time.Date(2025, 7, 10, 0, 30, 0, 0, time.UTC), ok: true},
+		{name: "empty", in: "", ok: false},
+		{name: "blank", in: "   ", ok: false},
+		{name: "zero timestamp", in: "0", ok: false},
+		{name: "negative timestamp", in: "-1", ok: false},
+		{name: "date only", in: "2025-07-10", ok: false},
+		{name: "garbage", in: "刚刚", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseCommentTime(tt.in)
+			if !tt.ok {
+				if got != nil {
+					t.Errorf("parseCommentTime(%q) = %v, want nil", tt.in, got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("parseCommentTime(%q) = nil, want %v", tt.in, tt.want)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseCommentTime(%q) = %v, want %v", tt.in, got.UTC(), tt.want)
+			}
+		})
+	}
+}
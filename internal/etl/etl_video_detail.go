@@ -24,6 +24,7 @@ type VideoDetailProcessor struct {
 	videoRepo      data.VideoRepo
 	bloggerRepo    data.BloggerRepo
 	videoTrendRepo data.VideoTrendRepo
+	rollupRepo     data.RollupRepo
 }
 
 // NewVideoDetailProcessor .
@@ -33,6 +34,7 @@ func NewVideoDetailProcessor(
 	videoRepo data.VideoRepo,
 	bloggerRepo data.BloggerRepo,
 	videoTrendRepo data.VideoTrendRepo,
+	rollupRepo data.RollupRepo,
 ) *VideoDetailProcessor {
 	return &VideoDetailProcessor{
 		log:            log.NewHelper(log.With(logger, "module", "processor/video_detail")),
@@ -40,6 +42,7 @@ func NewVideoDetailProcessor(
 		videoRepo:      videoRepo,
 		bloggerRepo:    bloggerRepo,
 		videoTrendRepo: videoTrendRepo,
+		rollupRepo:     rollupRepo,
 	}
 }
 
@@ -55,7 +58,7 @@ func (p *VideoDetailProcessor) Process(ctx context.Context, rawData *v1.SourceDa
 		logMsg := fmt.Sprintf("未知的视频详情数据类型: %s", rawData.DataType)
 		p.log.Warn(logMsg)
 		// Assuming v1.SourceData and data.SourceData are compatible enough for this call
-		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, logMsg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
 	}
 }
 
@@ -69,7 +72,7 @@ func (p *VideoDetailProcessor) processSummary(ctx context.Context, rawData *v1.S
 
 	if !resp.Status {
 		logMsg := fmt.Sprintf("API(summary)返回错误: Code=%d, Msg=%s", resp.Code, resp.Msg)
-		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, logMsg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
 	}
 
 	// 2. Get the blogger info to fetch the fan count.
@@ -78,7 +81,7 @@ func (p *VideoDetailProcessor) processSummary(ctx context.Context, rawData *v1.S
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			logMsg := fmt.Sprintf("数据不一致(summary): videos 表中未找到 AwemeId %s", rawData.EntityId)
-			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, logMsg)
+			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
 		}
 		return &ProcessError{Msg: "failed to get video by awemeId", SourceID: rawData.Id, Err: err}
 	}
@@ -87,7 +90,7 @@ func (p *VideoDetailProcessor) processSummary(ctx context.Context, rawData *v1.S
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			logMsg := fmt.Sprintf("数据不一致(summary): bloggers 表中未找到 BloggerId %d", video.BloggerId)
-			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, logMsg)
+			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
 		}
 		return &ProcessError{Msg: "failed to get blogger by id", SourceID: rawData.Id, Err: err}
 	}
@@ -99,7 +102,7 @@ func (p *VideoDetailProcessor) processSummary(ctx context.Context, rawData *v1.S
 	if likeCount <= 50 && fansCount <= 200 {
 		logMsg := fmt.Sprintf("过滤条件触发(summary): 视频实时点赞数 (%d) 且博主粉丝数 (%d) 均不满足要求。", likeCount, fansCount)
 		p.log.Infof(logMsg)
-		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, 2, logMsg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusFiltered, logMsg)
 	}
 
 	// 4. If not filtered, proceed to update the video dimension table.
@@ -121,12 +124,13 @@ func (p *VideoDetailProcessor) processSummary(ctx context.Context, rawData *v1.S
 		AwemeType:          summary.AwemeType,
 		SummaryUpdatedAt:   utils.TimeToPtr(time.Now()),
 	}
+	videoDim.NormalizeMetrics()
 
 	if err := p.videoRepo.UpdateFromSummary(ctx, videoDim); err != nil {
 		return &ProcessError{Msg: "update video summary failed", SourceID: rawData.Id, Err: err}
 	}
 
-	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, 1)
+	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, data.SourceDataStatusProcessed)
 }
 
 // processTrend handles video trend data.
@@ -142,7 +146,7 @@ func (p *VideoDetailProcessor) processTrend(ctx context.Context, rawData *v1.Sou
 		// 如果视频记录本身不存在，则无法继续，记录错误并跳过
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			logMsg := fmt.Sprintf("数据前置条件不足(trend): videos 表中未找到 AwemeId %s", rawData.EntityId)
-			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, logMsg)
+			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
 		}
 		return &ProcessError{Msg: "failed to get video by awemeId for filtering", SourceID: rawData.Id, Err: err}
 	}
@@ -150,7 +154,7 @@ func (p *VideoDetailProcessor) processTrend(ctx context.Context, rawData *v1.Sou
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			logMsg := fmt.Sprintf("数据前置条件不足(trend): bloggers 表中未找到 BloggerId %d", video.BloggerId)
-			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, logMsg)
+			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
 		}
 		return &ProcessError{Msg: "failed to get blogger by id for filtering", SourceID: rawData.Id, Err: err}
 	}
@@ -163,7 +167,7 @@ func (p *VideoDetailProcessor) processTrend(ctx context.Context, rawData *v1.Sou
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			logMsg := fmt.Sprintf("数据前置条件不足(trend): source_data 表中未找到 AwemeId %s 对应的 summary 数据", rawData.EntityId)
 			// 注意：这里我们选择跳过而不是报错，因为可能summary数据确实还没采集到
-			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, 2, logMsg)
+			return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusFiltered, logMsg)
 		}
 		return &ProcessError{Msg: "failed to find summary source data for filtering", SourceID: rawData.Id, Err: err}
 	}
@@ -178,7 +182,7 @@ func (p *VideoDetailProcessor) processTrend(ctx context.Context, rawData *v1.Sou
 	if likeCount <= 50 && fansCount <= 200 {
 		logMsg := fmt.Sprintf("过滤条件触发(trend): 视频点赞数 (%d) 且博主粉丝数 (%d) 均不满足要求。", likeCount, fansCount)
 		p.log.Infof(logMsg)
-		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, 2, logMsg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusFiltered, logMsg)
 	}
 	// --- 修正结束 ---
 
@@ -189,11 +193,11 @@ func (p *VideoDetailProcessor) processTrend(ctx context.Context, rawData *v1.Sou
 	}
 	if !resp.Status {
 		logMsg := fmt.Sprintf("API(trend)返回错误: Code=%d, Msg=%s", resp.Code, resp.Msg)
-		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, logMsg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
 	}
 	if len(resp.Data) == 0 {
 		_ = p.videoRepo.UpdateTrendTimestamp(ctx, rawData.EntityId)
-		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, 1, "API返回的趋势数据为空")
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusProcessed, "API返回的趋势数据为空")
 	}
 
 	// 5. 转换数据并调用 BatchOverwrite
@@ -246,11 +250,20 @@ func (p *VideoDetailProcessor) processTrend(ctx context.Context, rawData *v1.Sou
 		return &ProcessError{Msg: "batch overwrite video trends failed", SourceID: rawData.Id, Err: err}
 	}
 
+	// 趋势增量会计入日榜汇总，登记涉及的日期以便增量重建
+	dateCodes := make([]string, 0, len(trendsToOverwrite))
+	for _, trend := range trendsToOverwrite {
+		dateCodes = append(dateCodes, strconv.Itoa(trend.DateCode))
+	}
+	if err := p.rollupRepo.MarkDirty(ctx, data.RankPeriodDay, dateCodes...); err != nil {
+		p.log.Errorf("登记汇总重建失败 (source_data_id: %d): %v", rawData.Id, err)
+	}
+
 	if err := p.videoRepo.UpdateTrendTimestamp(ctx, video.AwemeId); err != nil {
 		p.log.Warnf("更新 video trend_updated_at 失败 (VideoID: %s): %v", video.AwemeId, err)
 	}
 
-	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, 1)
+	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, data.SourceDataStatusProcessed)
 }
 
 // FeiguaVideoSummaryData ...
@@ -9,7 +9,6 @@ import (
 	"github.com/Jayleonc/aresdata/pkg/crypto"
 	"github.com/Jayleonc/aresdata/pkg/utils"
 	"github.com/go-kratos/kratos/v2/log"
-	"strings"
 	"time"
 )
 
@@ -73,16 +72,24 @@ type VideoRankProcessor struct {
 	videoRepo      data.VideoRepo
 	productRepo    data.ProductRepo // 新增
 	bloggerRepo    data.BloggerRepo // 新增
+	rollupRepo     data.RollupRepo
+	categoryRepo   data.CategoryRepo
+	brandRepo      data.BrandRepo
+	shopRepo       data.ShopRepo
 	log            *log.Helper
 }
 
-func NewVideoRankProcessor(vrRepo data.VideoRankRepo, sdRepo data.SourceDataRepo, vRepo data.VideoRepo, pRepo data.ProductRepo, bRepo data.BloggerRepo, logger log.Logger) *VideoRankProcessor {
+func NewVideoRankProcessor(vrRepo data.VideoRankRepo, sdRepo data.SourceDataRepo, vRepo data.VideoRepo, pRepo data.ProductRepo, bRepo data.BloggerRepo, rRepo data.RollupRepo, cRepo data.CategoryRepo, brRepo data.BrandRepo, shRepo data.ShopRepo, logger log.Logger) *VideoRankProcessor {
 	return &VideoRankProcessor{
 		videoRankRepo:  vrRepo,
 		sourceDataRepo: sdRepo,
 		videoRepo:      vRepo,
 		productRepo:    pRepo,
 		bloggerRepo:    bRepo,
+		rollupRepo:     rRepo,
+		categoryRepo:   cRepo,
+		brandRepo:      brRepo,
+		shopRepo:       shRepo,
 		log:            log.NewHelper(log.With(logger, "module", "etl/video-rank")),
 	}
 }
@@ -97,7 +104,7 @@ func (p *VideoRankProcessor) Process(ctx context.Context, rawData *v1.SourceData
 	// Step 2: 预检API业务状态
 	if !resp.Status {
 		logMsg := fmt.Sprintf("API returned error status: Code=%d, Msg=%s", resp.Code, resp.Msg)
-		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, logMsg)
+		return p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, logMsg)
 	}
 
 	// Step 3: 解密数据
@@ -122,23 +129,35 @@ func (p *VideoRankProcessor) Process(ctx context.Context, rawData *v1.SourceData
 	if err := json.Unmarshal([]byte(decrypted), &listPayload); err != nil {
 		// 注意：这里的错误可能是因为解密后的内容不是预期的JSON，例如内容为空
 		// 我们需要更健壮地处理这种情况
-		p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, -1, "failed to unmarshal decrypted list payload: "+err.Error())
+		p.sourceDataRepo.UpdateStatusAndLog(ctx, rawData.Id, data.SourceDataStatusError, "failed to unmarshal decrypted list payload: "+err.Error())
 		return &ProcessError{Msg: "failed to unmarshal decrypted list", SourceID: rawData.Id, Err: err}
 	}
 
 	if len(listPayload.List) == 0 {
-		return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, 1)
+		return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, data.SourceDataStatusProcessed)
 	}
 
+	// 名次 = 分页偏移量 + 页内序号；分页参数缺失时名次记为0（未知）
+	positionBase, hasPosition := rankPositionBase(rawData.RequestParams)
+	if !hasPosition {
+		p.log.Warnf("无法从请求参数解析分页信息，名次将记为未知 (source_data_id: %d)", rawData.Id)
+	}
+
+	// 从 source_data 获取榜单周期和日期信息
+	period, ok := data.VideoRankPeriodFromDataType(rawData.DataType)
+	if !ok {
+		return &ProcessError{Msg: "unknown video rank data type: " + rawData.DataType, SourceID: rawData.Id}
+	}
+	startDate, endDate, rankDate := data.VideoRankPeriodDates(period, rawData.Date)
+
 	// Step 4: Map each item to data.VideoRank
 	ranksToCreate := make([]*data.VideoRank, 0, len(listPayload.List))
-	for _, item := range listPayload.List {
+	// 同一页内同一博主、同一商品可能出现多次，去重后写入快照
+	bloggerSnapshots := make(map[int64]*data.BloggerSnapshot)
+	productSnapshots := make(map[string]*data.ProductSnapshot)
+	for i, item := range listPayload.List {
 		// 解析时间
 		pubTime, _ := time.Parse("2006/01/02 15:04:05", item.AwemeDto.AwemePubTime)
-		// 从 source_data 获取榜单周期和日期信息
-		period := strings.Replace(rawData.DataType, "video_rank_", "", 1)
-		datecode := rawData.Date
-		startDate, endDate, rankDate := getPeriodDates(period, datecode)
 
 		vr := &data.VideoRank{
 			// Rank Info
@@ -183,7 +202,13 @@ func (p *VideoRankProcessor) Process(ctx context.Context, rawData *v1.SourceData
 			TotalSalesStr:   item.TotalSales,
 			LikeCountIncStr: item.LikeCountInc,
 			PlayCountIncStr: item.PlayCountInc,
+
+			SourceDataId: rawData.Id,
 		}
+		if hasPosition {
+			vr.RankPosition = positionBase + i + 1
+		}
+
 		// --- 新增：解析销量和销售额范围 ---
 		salesCountLow, salesCountHigh := utils.ParseRangeStr(item.SalesCount)
 		vr.SalesCountLow = salesCountLow
@@ -226,9 +251,35 @@ func (p *VideoRankProcessor) Process(ctx context.Context, rawData *v1.SourceData
 			BrandName:       item.GoodsDto.DouyinBrandName,
 			CategoryNames:   item.GoodsDto.CateNames,
 		}
+		// 品牌、店铺按名称归并，失败时只记录日志，商品仍以原始名称入库
+		if id, err := p.brandRepo.Ensure(ctx, productDim.BrandName); err != nil {
+			p.log.Errorf("归并品牌失败 (goods_id: %s): %v", productDim.GoodsId, err)
+		} else {
+			productDim.BrandId = id
+		}
+		if id, err := p.shopRepo.Ensure(ctx, productDim.ShopName); err != nil {
+			p.log.Errorf("归并店铺失败 (goods_id: %s): %v", productDim.GoodsId, err)
+		} else {
+			productDim.ShopId = id
+		}
 		if err := p.productRepo.Upsert(ctx, productDim); err != nil {
 			p.log.Errorf("failed to upsert product dimension for goodsId %s: %v", productDim.GoodsId, err)
 		}
+		if err := p.categoryRepo.SyncProduct(ctx, productDim.GoodsId, productDim.CategoryNames); err != nil {
+			p.log.Errorf("同步商品类目失败 (goods_id: %s): %v", productDim.GoodsId, err)
+		}
+		// 商品快照同样按天记录，只由日榜写入
+		if period == data.RankPeriodDay && productDim.GoodsId != "" {
+			productSnapshots[productDim.GoodsId] = &data.ProductSnapshot{
+				GoodsId:         productDim.GoodsId,
+				SnapshotDate:    rawData.Date,
+				GoodsPrice:      productDim.GoodsPrice,
+				GoodsPriceRange: productDim.GoodsPriceRange,
+				CosRatio:        productDim.CosRatio,
+				CommissionPrice: productDim.CommissionPrice,
+				SourceDataId:    rawData.Id,
+			}
+		}
 
 		// 3. 更新/插入 Blogger 维度表
 		bloggerDim := &data.Blogger{
@@ -242,19 +293,50 @@ func (p *VideoRankProcessor) Process(ctx context.Context, rawData *v1.SourceData
 		if err := p.bloggerRepo.Upsert(ctx, bloggerDim); err != nil {
 			p.log.Errorf("failed to upsert blogger dimension for bloggerId %d: %v", bloggerDim.BloggerId, err)
 		}
+		// 快照按天记录，周榜、月榜的 rawData.Date 不是快照日期，只由日榜写入
+		if period == data.RankPeriodDay && bloggerDim.BloggerId != 0 {
+			bloggerSnapshots[bloggerDim.BloggerId] = &data.BloggerSnapshot{
+				BloggerId:    bloggerDim.BloggerId,
+				SnapshotDate: rawData.Date,
+				FansNum:      bloggerDim.BloggerFansNum,
+				BloggerTag:   bloggerDim.BloggerTag,
+				SourceDataId: rawData.Id,
+			}
+		}
 
 	}
 
-	// Step 5: Batch insert
+	// Step 5: 以 source_data 为单位替换写入，重放同一页数据不会产生重复榜单记录
 	var ranksToCreateDTO = make([]*v1.VideoRankDTO, 0, len(ranksToCreate))
 	for _, r := range ranksToCreate {
 		ranksToCreateDTO = append(ranksToCreateDTO, data.CopyVideoRankToDTO(r))
 	}
 
-	if err := p.videoRankRepo.BatchCreate(ctx, ranksToCreateDTO); err != nil {
-		return &ProcessError{Msg: "failed to batch create video ranks", SourceID: rawData.Id, Err: err}
+	if err := p.videoRankRepo.ReplaceBySource(ctx, rawData.Id, ranksToCreateDTO); err != nil {
+		return &ProcessError{Msg: "failed to replace video ranks", SourceID: rawData.Id, Err: err}
+	}
+
+	// 快照写入失败不影响榜单入库，与维度表更新一致只记录日志
+	bloggerSnapshotList := make([]*data.BloggerSnapshot, 0, len(bloggerSnapshots))
+	for _, s := range bloggerSnapshots {
+		bloggerSnapshotList = append(bloggerSnapshotList, s)
+	}
+	if err := p.bloggerRepo.UpsertSnapshots(ctx, bloggerSnapshotList); err != nil {
+		p.log.Errorf("写入博主快照失败 (source_data_id: %d): %v", rawData.Id, err)
+	}
+	productSnapshotList := make([]*data.ProductSnapshot, 0, len(productSnapshots))
+	for _, s := range productSnapshots {
+		productSnapshotList = append(productSnapshotList, s)
+	}
+	if err := p.productRepo.UpsertSnapshots(ctx, productSnapshotList); err != nil {
+		p.log.Errorf("写入商品快照失败 (source_data_id: %d): %v", rawData.Id, err)
+	}
+
+	// 登记该期榜单需要重建汇总，由 build:daily_rollups 任务增量处理
+	if err := p.rollupRepo.MarkDirty(ctx, period, rankDate); err != nil {
+		p.log.Errorf("登记汇总重建失败 (source_data_id: %d): %v", rawData.Id, err)
 	}
 
 	// Step 6: Update source data status
-	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, 1)
+	return p.sourceDataRepo.UpdateStatus(ctx, rawData.Id, data.SourceDataStatusProcessed)
 }
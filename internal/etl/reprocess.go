@@ -0,0 +1,99 @@
+package etl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+// defaultReprocessLimit 是单次重放的默认最大记录数，防止误操作导致全量重放
+const defaultReprocessLimit = 1000
+
+// ErrEmptyReprocessFilter 表示重放条件为空
+var ErrEmptyReprocessFilter = errors.New("reprocess filter must specify at least one condition")
+
+// ReprocessResult 描述一次重放（或预览）的结果
+type ReprocessResult struct {
+	DryRun  bool
+	Matched int64                         // 匹配条件的记录数
+	Reset   int64                         // 被重置（或将被重置）为未处理的记录数
+	Skipped int64                         // 没有对应处理器而跳过的记录数
+	Counts  []*data.SourceDataStatusCount // 匹配记录按数据类型和状态的分布
+	IDs     []int64                       // 将被（或已被）重放的记录ID
+}
+
+// PrepareReprocess 根据条件挑选需要重放的原始数据，并将其重置为未处理。
+// dryRun 为 true 时只计算将会发生的变化，不修改任何数据。
+// 返回结果中的 IDs 可交给 ProcessByIDs 执行实际的 ETL。
+func (u *ETLUsecase) PrepareReprocess(ctx context.Context, filter *data.SourceDataFilter, dryRun bool) (*ReprocessResult, error) {
+	if filter == nil || filter.IsEmpty() {
+		return nil, ErrEmptyReprocessFilter
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultReprocessLimit
+	}
+
+	briefs, err := u.sourceDataRepo.FindByFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReprocessResult{DryRun: dryRun, Matched: int64(len(briefs))}
+	counts := make(map[string]*data.SourceDataStatusCount)
+	var resetIDs []int64
+	for _, b := range briefs {
+		key := fmt.Sprintf("%s|%d", b.DataType, b.Status)
+		if c, ok := counts[key]; ok {
+			c.Count++
+		} else {
+			c = &data.SourceDataStatusCount{DataType: b.DataType, Status: b.Status, Count: 1}
+			counts[key] = c
+			result.Counts = append(result.Counts, c)
+		}
+
+		if _, ok := u.processors[b.DataType]; !ok {
+			result.Skipped++
+			continue
+		}
+		result.IDs = append(result.IDs, b.ID)
+		if b.Status != data.SourceDataStatusUnprocessed {
+			resetIDs = append(resetIDs, b.ID)
+		}
+	}
+
+	if dryRun {
+		result.Reset = int64(len(resetIDs))
+		return result, nil
+	}
+
+	reset, err := u.sourceDataRepo.MarkForReprocess(ctx, resetIDs)
+	if err != nil {
+		return nil, err
+	}
+	result.Reset = reset
+	u.log.WithContext(ctx).Infof("重放准备完成：匹配 %d 条，重置 %d 条，跳过 %d 条。", result.Matched, result.Reset, result.Skipped)
+	return result, nil
+}
+
+// ProcessByIDs 对指定的原始数据执行 ETL，只处理当前仍为未处理状态的记录
+func (u *ETLUsecase) ProcessByIDs(ctx context.Context, ids []int64) error {
+	list, err := u.sourceDataRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	pending := list[:0]
+	for _, raw := range list {
+		if data.SourceDataStatusFromProto(raw.Status) == data.SourceDataStatusUnprocessed {
+			pending = append(pending, raw)
+		}
+	}
+	u.log.WithContext(ctx).Infof("开始重放 %d 条原始数据...", len(pending))
+	u.processList(ctx, pending)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	u.log.WithContext(ctx).Infof("重放完成，共处理 %d 条原始数据。", len(pending))
+	return nil
+}
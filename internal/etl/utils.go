@@ -2,7 +2,8 @@ package etl
 
 import (
 	"encoding/json"
-	"time"
+	"net/url"
+	"strconv"
 )
 
 func toInt64(n json.Number) int64 {
@@ -29,44 +30,20 @@ func (e *ProcessError) Error() string {
 	return e.Msg
 }
 
-// getPeriodDates 生成榜单周期的起止日期和主日期
-func getPeriodDates(period, datecode string) (startDate, endDate, rankDate string) {
-	// 默认都为 datecode
-	startDate, endDate, rankDate = datecode, datecode, datecode
-	switch period {
-	case "day":
-		// 都为当天
-		startDate, endDate, rankDate = datecode, datecode, datecode
-	case "week":
-		d, err := time.Parse("20060102", datecode)
-		if err != nil {
-			return
-		}
-		weekday := int(d.Weekday())
-		if weekday == 0 {
-			weekday = 7 // 周日
-		}
-		monday := d.AddDate(0, 0, -weekday+1)
-		sunday := d.AddDate(0, 0, 7-weekday)
-		startDate = monday.Format("20060102")
-		endDate = sunday.Format("20060102")
-		rankDate = startDate
-	case "month":
-		d, err := time.Parse("20060102", datecode)
-		if err != nil {
-			return
-		}
-		first := time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, d.Location())
-		var nextMonth time.Time
-		if d.Month() == 12 {
-			nextMonth = time.Date(d.Year()+1, 1, 1, 0, 0, 0, 0, d.Location())
-		} else {
-			nextMonth = time.Date(d.Year(), d.Month()+1, 1, 0, 0, 0, 0, d.Location())
-		}
-		last := nextMonth.AddDate(0, 0, -1)
-		startDate = first.Format("20060102")
-		endDate = last.Format("20060102")
-		rankDate = startDate
+// rankPositionBase 根据采集时的分页参数计算本页第一条记录之前的名次偏移量，
+// 即 (pageIndex-1) × pageSize，pageIndex 从1开始。参数缺失或无法解析时 ok 为 false。
+func rankPositionBase(requestParams string) (base int, ok bool) {
+	values, err := url.ParseQuery(requestParams)
+	if err != nil {
+		return 0, false
 	}
-	return
+	pageIndex, err := strconv.Atoi(values.Get("pageIndex"))
+	if err != nil || pageIndex < 1 {
+		return 0, false
+	}
+	pageSize, err := strconv.Atoi(values.Get("pageSize"))
+	if err != nil || pageSize < 1 {
+		return 0, false
+	}
+	return (pageIndex - 1) * pageSize, true
 }
@@ -28,6 +28,18 @@ type Fetcher interface {
 	CaptureVideoDetails(ctx context.Context, entryURL string) (string, string, error)
 
 	FetchVideoSummary(ctx context.Context, awemeID, dateCode string) (string, *RequestMetadata, error)
+
+	// FetchVideoComments 获取视频评论列表的一页，按热度排序，主要由HTTP采集器使用。
+	FetchVideoComments(ctx context.Context, awemeID string, pageIndex, pageSize int) (string, *RequestMetadata, error)
+
+	// FetchVideoCommentKeywords 获取视频评论的关键词（分词）统计，主要由HTTP采集器使用。
+	FetchVideoCommentKeywords(ctx context.Context, awemeID string) (string, *RequestMetadata, error)
+
+	// FetchVideoAudience 获取视频观众画像（性别、年龄、地域分布），主要由HTTP采集器使用。
+	FetchVideoAudience(ctx context.Context, awemeID string) (string, *RequestMetadata, error)
+
+	// FetchBloggerAudience 获取博主粉丝画像（性别、年龄、地域分布），主要由HTTP采集器使用。
+	FetchBloggerAudience(ctx context.Context, bloggerID string) (string, *RequestMetadata, error)
 }
 
 func NewHeadlessAccountPool(cfg *conf.DataSource, logger log.Logger) *AccountPool {
@@ -39,7 +51,8 @@ func NewHeadlessAccountPool(cfg *conf.DataSource, logger log.Logger) *AccountPoo
 
 // ProviderSet 是 fetcher 的依赖注入集合。
 var ProviderSet = wire.NewSet(
-	provideDataSources,
+	ProvideDataSources,
+	ProvideTrendRefreshPolicy,
 	NewFetcherManager,
 	NewHttpUsecase,
 	NewHeadlessUsecase,
@@ -47,6 +60,7 @@ var ProviderSet = wire.NewSet(
 	NewHeadlessFetcher,
 )
 
-func provideDataSources(c *conf.Data) []*conf.DataSource {
+// ProvideDataSources 从配置中提取所有数据源定义，供 FetcherManager 初始化使用。
+func ProvideDataSources(c *conf.Data) []*conf.DataSource {
 	return c.GetDatasources()
 }
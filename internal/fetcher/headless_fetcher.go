@@ -228,3 +228,23 @@ func (f *HeadlessFetcher) FetchVideoSummary(ctx context.Context, awemeID, dateCo
 	//TODO implement me
 	panic("implement me")
 }
+
+// FetchVideoComments 明确此功能不由 HeadlessFetcher 实现，以满足接口要求
+func (f *HeadlessFetcher) FetchVideoComments(ctx context.Context, awemeID string, pageIndex, pageSize int) (string, *RequestMetadata, error) {
+	return "", nil, fmt.Errorf("FetchVideoComments 方法未在 HeadlessFetcher 中实现")
+}
+
+// FetchVideoCommentKeywords 明确此功能不由 HeadlessFetcher 实现，以满足接口要求
+func (f *HeadlessFetcher) FetchVideoCommentKeywords(ctx context.Context, awemeID string) (string, *RequestMetadata, error) {
+	return "", nil, fmt.Errorf("FetchVideoCommentKeywords 方法未在 HeadlessFetcher 中实现")
+}
+
+// FetchVideoAudience 明确此功能不由 HeadlessFetcher 实现，以满足接口要求
+func (f *HeadlessFetcher) FetchVideoAudience(ctx context.Context, awemeID string) (string, *RequestMetadata, error) {
+	return "", nil, fmt.Errorf("FetchVideoAudience 方法未在 HeadlessFetcher 中实现")
+}
+
+// FetchBloggerAudience 明确此功能不由 HeadlessFetcher 实现，以满足接口要求
+func (f *HeadlessFetcher) FetchBloggerAudience(ctx context.Context, bloggerID string) (string, *RequestMetadata, error) {
+	return "", nil, fmt.Errorf("FetchBloggerAudience 方法未在 HeadlessFetcher 中实现")
+}
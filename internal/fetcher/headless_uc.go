@@ -16,18 +16,24 @@ type HeadlessUsecase struct {
 	fetcherManager *FetcherManager     // <--- 新增依赖
 	videoRepo      data.VideoRepo      // 新增依赖
 	sourceDataRepo data.SourceDataRepo // 新增依赖
+	watchlistRepo  data.WatchlistRepo
+	refreshPolicy  *data.TrendRefreshPolicy // 为 nil 时不重新采集已采集过的视频
 }
 
 func NewHeadlessUsecase(
 	fm *FetcherManager, // <--- 修改参数
 	videoRepo data.VideoRepo,
 	sourceDataRepo data.SourceDataRepo,
+	watchlistRepo data.WatchlistRepo,
+	refreshPolicy *data.TrendRefreshPolicy,
 	logger log.Logger,
 ) *HeadlessUsecase {
 	return &HeadlessUsecase{
 		fetcherManager: fm, // <--- 修改字段赋值
 		videoRepo:      videoRepo,
 		sourceDataRepo: sourceDataRepo,
+		watchlistRepo:  watchlistRepo,
+		refreshPolicy:  refreshPolicy,
 		log:            log.NewHelper(log.With(logger, "module", "usecase.headless")),
 	}
 } // 兼容老用法，repo 仍然保留
@@ -46,6 +52,9 @@ func (uc *HeadlessUsecase) FetchAndStoreVideoDetails(ctx context.Context, video
 	}
 
 	summaryRaw, trendRaw, err := rawFetcher.CaptureVideoDetails(ctx, video.AwemeDetailUrl)
+	if finishErr := uc.videoRepo.FinishCollection(ctx, video.AwemeId, err); finishErr != nil {
+		uc.log.Errorf("%v", finishErr)
+	}
 	if err != nil {
 		uc.log.Errorf("采集视频 %s 的详情数据失败: %v", video.AwemeId, err)
 		return err
@@ -113,21 +122,62 @@ func (uc *HeadlessUsecase) GetPartiallyCollectedVideos(ctx context.Context, hour
 	return uc.videoRepo.FindVideosByIDs(ctx, partiallyCollectedIDs, limit)
 }
 
-// GetVideosForFirstCollection 获取用于首次详情采集的视频列表。
-// 这是一个业务层方法，它编排了多个Repo来实现复杂的查询逻辑。
-func (uc *HeadlessUsecase) GetVideosForFirstCollection(ctx context.Context, limit int) ([]*data.VideoForCollection, error) {
-	uc.log.Info("Usecase 开始查找需要首次采集的视频...")
+// GetVideosForCollection 获取本批次待采集详情的视频，按以下优先级依次补齐到 limit：
+//  1. 关注列表中到期需要刷新的视频；
+//  2. 按刷新策略到期需要重新采集趋势的活跃视频；
+//  3. 首次采集的视频。
+func (uc *HeadlessUsecase) GetVideosForCollection(ctx context.Context, limit int) ([]*data.VideoForCollection, error) {
+	videos, err := uc.watchlistRepo.FindDueVideos(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("Usecase 查找到期的关注视频失败: %w", err)
+	}
+	if len(videos) > 0 {
+		uc.log.Infof("关注列表中有 %d 个视频到期需要刷新。", len(videos))
+	}
+	// 同一个视频可能同时满足多个条件，去重
+	seen := make(map[string]bool, limit)
+	for _, v := range videos {
+		seen[v.AwemeId] = true
+	}
+	appendUnseen := func(batch []*data.VideoForCollection) {
+		for _, v := range batch {
+			if len(videos) < limit && !seen[v.AwemeId] {
+				seen[v.AwemeId] = true
+				videos = append(videos, v)
+			}
+		}
+	}
 
-	// 定义需要排除的数据类型
-	dataTypes := []string{"video_trend_headless", "video_summary_headless"}
+	if uc.refreshPolicy != nil && len(videos) < limit {
+		due, err := uc.videoRepo.FindVideosForTrendRefresh(ctx, uc.refreshPolicy, limit-len(videos))
+		if err != nil {
+			return nil, fmt.Errorf("Usecase 查找待刷新趋势的视频失败: %w", err)
+		}
+		if len(due) > 0 {
+			uc.log.Infof("有 %d 个活跃视频按刷新策略到期需要重新采集。", len(due))
+		}
+		appendUnseen(due)
+	}
 
-	// 步骤1：调用 sourceDataRepo，拿到所有“已采集过”的视频ID黑名单
-	excludeIDs, err := uc.sourceDataRepo.ListAllCollectedEntityIDs(ctx, dataTypes)
-	if err != nil {
-		return nil, fmt.Errorf("Usecase 获取已采集ID黑名单失败: %w", err)
+	if len(videos) < limit {
+		exclude := make([]string, 0, len(videos))
+		for _, v := range videos {
+			exclude = append(exclude, v.AwemeId)
+		}
+		backlog, err := uc.GetVideosForFirstCollection(ctx, exclude, limit-len(videos))
+		if err != nil {
+			return nil, err
+		}
+		appendUnseen(backlog)
 	}
+	return videos, nil
+}
+
+// GetVideosForFirstCollection 认领一批用于首次详情采集的视频，excludeIDs 中的视频不会被认领。
+// 认领在数据库中完成，多个 worker 并发调用时拿到的批次互不相交。
+func (uc *HeadlessUsecase) GetVideosForFirstCollection(ctx context.Context, excludeIDs []string, limit int) ([]*data.VideoForCollection, error) {
+	uc.log.Info("Usecase 开始查找需要首次采集的视频...")
 
-	// 步骤2：调用 videoRepo，获取所有不在黑名单里的视频
 	videos, err := uc.videoRepo.FindVideosExcludingIDs(ctx, excludeIDs, limit)
 	if err != nil {
 		return nil, fmt.Errorf("Usecase 调用 videoRepo 查找待采集视频失败: %w", err)
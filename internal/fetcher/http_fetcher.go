@@ -178,3 +178,89 @@ func (f *HttpFetcher) FetchVideoSummary(ctx context.Context, awemeID, dateCode s
 
 	return string(body), meta, nil
 }
+
+// FetchVideoComments 采集单个视频评论列表的一页
+func (f *HttpFetcher) FetchVideoComments(ctx context.Context, awemeID string, pageIndex, pageSize int) (string, *RequestMetadata, error) {
+	params := url.Values{}
+	params.Set("awemeId", awemeID)
+	params.Set("pageIndex", fmt.Sprintf("%d", pageIndex))
+	params.Set("pageSize", fmt.Sprintf("%d", pageSize))
+	params.Set("sort", "1") // 1: 按热度（点赞数）排序
+	params.Set("_", fmt.Sprintf("%d", time.Now().UnixMilli()))
+	return f.get(ctx, f.cfg.BaseUrl+"/api/v3/aweme/detail/comment/list", params)
+}
+
+// FetchVideoCommentKeywords 采集单个视频评论的关键词（分词）统计
+func (f *HttpFetcher) FetchVideoCommentKeywords(ctx context.Context, awemeID string) (string, *RequestMetadata, error) {
+	params := url.Values{}
+	params.Set("awemeId", awemeID)
+	params.Set("_", fmt.Sprintf("%d", time.Now().UnixMilli()))
+	return f.get(ctx, f.cfg.BaseUrl+"/api/v3/aweme/detail/comment/segment", params)
+}
+
+// FetchVideoAudience 采集单个视频的观众画像
+func (f *HttpFetcher) FetchVideoAudience(ctx context.Context, awemeID string) (string, *RequestMetadata, error) {
+	params := url.Values{}
+	params.Set("awemeId", awemeID)
+	params.Set("_", fmt.Sprintf("%d", time.Now().UnixMilli()))
+	return f.get(ctx, f.cfg.BaseUrl+"/api/v3/aweme/detail/audience/portrait", params)
+}
+
+// FetchBloggerAudience 采集单个博主的粉丝画像
+func (f *HttpFetcher) FetchBloggerAudience(ctx context.Context, bloggerID string) (string, *RequestMetadata, error) {
+	params := url.Values{}
+	params.Set("bloggerId", bloggerID)
+	params.Set("_", fmt.Sprintf("%d", time.Now().UnixMilli()))
+	return f.get(ctx, f.cfg.BaseUrl+"/api/v3/blogger/detail/fans/portrait", params)
+}
+
+// get 使用账号池中的账号发起 GET 请求，返回响应体和请求元数据
+func (f *HttpFetcher) get(ctx context.Context, apiEndpoint string, params url.Values) (string, *RequestMetadata, error) {
+	fullUrl := apiEndpoint + "?" + params.Encode()
+	f.log.WithContext(ctx).Infof("正在请求URL: %s", fullUrl)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fullUrl, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	if account := f.accountPool.GetNextAccount(); account != nil {
+		req.Header.Set("Cookie", account.GetCookieHeader())
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/138.0.0.0 Safari/537.36")
+	req.Header.Set("Accept", "application/json, text/plain, */*")
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	headersJson, _ := json.Marshal(req.Header)
+	meta := &RequestMetadata{
+		Method:  "GET",
+		URL:     apiEndpoint,
+		Params:  params.Encode(),
+		Headers: string(headersJson),
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", meta, fmt.Errorf("执行请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", meta, fmt.Errorf("错误的状态码: %d", resp.StatusCode)
+	}
+
+	var reader io.Reader = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return "", meta, fmt.Errorf("创建gzip读取器失败: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return "", meta, fmt.Errorf("读取响应体失败: %w", err)
+	}
+	return string(body), meta, nil
+}
@@ -6,6 +6,7 @@ import (
 	v1 "github.com/Jayleonc/aresdata/api/v1"
 	"github.com/Jayleonc/aresdata/internal/data"
 	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
 	"time"
 )
 
@@ -25,6 +26,9 @@ func NewHttpUsecase(repo data.SourceDataRepo, fm *FetcherManager, logger log.Log
 
 // FetchAndStoreVideoRank 是一个具体的业务方法，负责采集视频榜单并存储
 func (uc *HttpUsecase) FetchAndStoreVideoRank(ctx context.Context, period, datecode string, pageIndex, pageSize int) (*v1.SourceData, error) {
+	if !data.IsValidRankPeriod(period) {
+		return nil, fmt.Errorf("不支持的榜单周期: %s", period)
+	}
 	// 1. 从管理器获取数据源的 Fetcher
 	fetcher, ok := uc.fetcherManager.Get("feigua_http_backup")
 	if !ok {
@@ -39,9 +43,9 @@ func (uc *HttpUsecase) FetchAndStoreVideoRank(ctx context.Context, period, datec
 		if meta != nil {
 			failedData := &v1.SourceData{
 				ProviderName:   fetcher.GetConfig().Name,
-				DataType:       data.DataTypeVideoRank,
+				DataType:       data.VideoRankDataType(period),
 				EntityId:       fmt.Sprintf("%s_%s", period, datecode),
-				Status:         -1, // 标记为错误
+				Status:         v1.SourceDataStatus_SOURCE_DATA_STATUS_ERROR, // 标记为错误
 				FetchedAt:      time.Now().Format(time.RFC3339),
 				Date:           datecode,
 				RawContent:     err.Error(), // 内容字段记录错误信息
@@ -60,10 +64,10 @@ func (uc *HttpUsecase) FetchAndStoreVideoRank(ctx context.Context, period, datec
 	// 4. 构造 SourceData 对象准备入库
 	sourceData := &v1.SourceData{
 		ProviderName:   fetcher.GetConfig().Name,
-		DataType:       data.DataTypeVideoRank,
+		DataType:       data.VideoRankDataType(period),
 		RawContent:     rawContent,
 		EntityId:       fmt.Sprintf("%s_%s", period, datecode),
-		Status:         0, // 初始状态为 未处理
+		Status:         v1.SourceDataStatus_SOURCE_DATA_STATUS_UNPROCESSED, // 初始状态为 未处理
 		FetchedAt:      time.Now().Format(time.RFC3339),
 		Date:           datecode,
 		RequestMethod:  meta.Method,
@@ -95,7 +99,7 @@ func (uc *HttpUsecase) FetchAndStoreVideoSummary(ctx context.Context, awemeID, d
 				ProviderName:   fetcher.GetConfig().Name,
 				DataType:       dataType,
 				EntityId:       awemeID,
-				Status:         -1, // 标记为错误
+				Status:         v1.SourceDataStatus_SOURCE_DATA_STATUS_ERROR, // 标记为错误
 				FetchedAt:      time.Now().Format(time.RFC3339),
 				Date:           dateCode,
 				RawContent:     err.Error(), // 内容字段记录错误信息
@@ -118,7 +122,7 @@ func (uc *HttpUsecase) FetchAndStoreVideoSummary(ctx context.Context, awemeID, d
 		DataType:       dataType,
 		RawContent:     rawContent,
 		EntityId:       awemeID,
-		Status:         0, // 初始状态为 未处理
+		Status:         v1.SourceDataStatus_SOURCE_DATA_STATUS_UNPROCESSED, // 初始状态为 未处理
 		FetchedAt:      time.Now().Format(time.RFC3339),
 		Date:           dateCode,
 		RequestMethod:  meta.Method,
@@ -130,3 +134,74 @@ func (uc *HttpUsecase) FetchAndStoreVideoSummary(ctx context.Context, awemeID, d
 	// 调用Repo存储到数据库
 	return uc.repo.Save(ctx, sourceData)
 }
+
+// FetchAndStoreVideoComments 采集并存储视频评论列表的一页
+func (uc *HttpUsecase) FetchAndStoreVideoComments(ctx context.Context, awemeID string, pageIndex, pageSize int) (*v1.SourceData, error) {
+	fetcher, ok := uc.fetcherManager.Get("feigua_http_backup")
+	if !ok {
+		return nil, fmt.Errorf("http fetcher 'feigua_http_backup' not found")
+	}
+	rawContent, meta, err := fetcher.FetchVideoComments(ctx, awemeID, pageIndex, pageSize)
+	return uc.storeFetchResult(ctx, fetcher, data.DataTypeVideoComment, awemeID, rawContent, meta, err)
+}
+
+// FetchAndStoreVideoCommentKeywords 采集并存储视频评论的关键词统计
+func (uc *HttpUsecase) FetchAndStoreVideoCommentKeywords(ctx context.Context, awemeID string) (*v1.SourceData, error) {
+	fetcher, ok := uc.fetcherManager.Get("feigua_http_backup")
+	if !ok {
+		return nil, fmt.Errorf("http fetcher 'feigua_http_backup' not found")
+	}
+	rawContent, meta, err := fetcher.FetchVideoCommentKeywords(ctx, awemeID)
+	return uc.storeFetchResult(ctx, fetcher, data.DataTypeVideoCommentKeyword, awemeID, rawContent, meta, err)
+}
+
+// FetchAndStoreVideoAudience 采集并存储视频观众画像
+func (uc *HttpUsecase) FetchAndStoreVideoAudience(ctx context.Context, awemeID string) (*v1.SourceData, error) {
+	fetcher, ok := uc.fetcherManager.Get("feigua_http_backup")
+	if !ok {
+		return nil, fmt.Errorf("http fetcher 'feigua_http_backup' not found")
+	}
+	rawContent, meta, err := fetcher.FetchVideoAudience(ctx, awemeID)
+	return uc.storeFetchResult(ctx, fetcher, data.DataTypeVideoAudience, awemeID, rawContent, meta, err)
+}
+
+// FetchAndStoreBloggerAudience 采集并存储博主粉丝画像
+func (uc *HttpUsecase) FetchAndStoreBloggerAudience(ctx context.Context, bloggerID int64) (*v1.SourceData, error) {
+	fetcher, ok := uc.fetcherManager.Get("feigua_http_backup")
+	if !ok {
+		return nil, fmt.Errorf("http fetcher 'feigua_http_backup' not found")
+	}
+	entityID := strconv.FormatInt(bloggerID, 10)
+	rawContent, meta, err := fetcher.FetchBloggerAudience(ctx, entityID)
+	return uc.storeFetchResult(ctx, fetcher, data.DataTypeBloggerAudience, entityID, rawContent, meta, err)
+}
+
+// storeFetchResult 把一次采集的结果存入 source_data，日期为采集当天。
+// 采集失败时记录一条错误状态的数据（含请求上下文）并返回原错误。
+func (uc *HttpUsecase) storeFetchResult(ctx context.Context, fetcher Fetcher, dataType, entityID, rawContent string, meta *RequestMetadata, fetchErr error) (*v1.SourceData, error) {
+	sourceData := &v1.SourceData{
+		ProviderName: fetcher.GetConfig().Name,
+		DataType:     dataType,
+		EntityId:     entityID,
+		Status:       v1.SourceDataStatus_SOURCE_DATA_STATUS_UNPROCESSED,
+		FetchedAt:    time.Now().Format(time.RFC3339),
+		Date:         time.Now().Format("20060102"),
+		RawContent:   rawContent,
+	}
+	if meta != nil {
+		sourceData.RequestMethod = meta.Method
+		sourceData.RequestUrl = meta.URL
+		sourceData.RequestParams = meta.Params
+		sourceData.RequestHeaders = meta.Headers
+	}
+	if fetchErr != nil {
+		uc.log.WithContext(ctx).Errorf("采集 %s 失败 (entity_id: %s): %v", dataType, entityID, fetchErr)
+		if meta != nil {
+			sourceData.Status = v1.SourceDataStatus_SOURCE_DATA_STATUS_ERROR
+			sourceData.RawContent = fetchErr.Error()
+			_, _ = uc.repo.Save(ctx, sourceData)
+		}
+		return nil, fetchErr
+	}
+	return uc.repo.Save(ctx, sourceData)
+}
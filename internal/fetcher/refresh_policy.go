@@ -0,0 +1,67 @@
+package fetcher
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Jayleonc/aresdata/internal/conf"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+// defaultRefreshTiers 是未配置 job.trend_refresh.tiers 时的刷新间隔：视频越新刷新越频繁
+var defaultRefreshTiers = []data.RefreshTier{
+	{MaxAge: 3 * 24 * time.Hour, Interval: 6 * time.Hour},
+	{MaxAge: 7 * 24 * time.Hour, Interval: 12 * time.Hour},
+	{MaxAge: 30 * 24 * time.Hour, Interval: 24 * time.Hour},
+}
+
+const (
+	defaultRefreshInterval     = 72 * time.Hour
+	defaultRefreshGrowthFactor = 0.5
+	defaultRefreshInactiveDays = 7
+	defaultRefreshMaxAge       = 90 * 24 * time.Hour
+)
+
+// ProvideTrendRefreshPolicy 根据 job.trend_refresh 配置构造已采集视频的重新采集策略，未配置的项使用默认值。
+// 配置 disabled 时返回 nil，此时只采集关注列表和首次采集的视频。
+func ProvideTrendRefreshPolicy(bc *conf.Bootstrap) *data.TrendRefreshPolicy {
+	c := bc.GetJob().GetTrendRefresh()
+	if c.GetDisabled() {
+		return nil
+	}
+
+	policy := &data.TrendRefreshPolicy{
+		Tiers:           defaultRefreshTiers,
+		DefaultInterval: defaultRefreshInterval,
+		GrowthFactor:    defaultRefreshGrowthFactor,
+		InactiveDays:    defaultRefreshInactiveDays,
+		MaxAge:          defaultRefreshMaxAge,
+	}
+	if tiers := c.GetTiers(); len(tiers) > 0 {
+		policy.Tiers = make([]data.RefreshTier, 0, len(tiers))
+		for _, t := range tiers {
+			if t.GetMaxAge().AsDuration() <= 0 || t.GetInterval().AsDuration() <= 0 {
+				continue
+			}
+			policy.Tiers = append(policy.Tiers, data.RefreshTier{
+				MaxAge:   t.GetMaxAge().AsDuration(),
+				Interval: t.GetInterval().AsDuration(),
+			})
+		}
+		// SQL 中按顺序匹配第一个分段，需要按 MaxAge 升序
+		sort.Slice(policy.Tiers, func(i, j int) bool { return policy.Tiers[i].MaxAge < policy.Tiers[j].MaxAge })
+	}
+	if d := c.GetDefaultInterval().AsDuration(); d > 0 {
+		policy.DefaultInterval = d
+	}
+	if f := c.GetGrowthFactor(); f > 0 {
+		policy.GrowthFactor = f
+	}
+	if n := c.GetInactiveDays(); n > 0 {
+		policy.InactiveDays = int(n)
+	}
+	if d := c.GetMaxAge().AsDuration(); d > 0 {
+		policy.MaxAge = d
+	}
+	return policy
+}
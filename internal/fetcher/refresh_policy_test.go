@@ -0,0 +1,116 @@
+package fetcher
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Jayleonc/aresdata/internal/conf"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestProvideTrendRefreshPolicy(t *testing.T) {
+	defaults := &data.TrendRefreshPolicy{
+		Tiers:           defaultRefreshTiers,
+		DefaultInterval: defaultRefreshInterval,
+		GrowthFactor:    defaultRefreshGrowthFactor,
+		InactiveDays:    defaultRefreshInactiveDays,
+		MaxAge:          defaultRefreshMaxAge,
+	}
+
+	tests := []struct {
+		name string
+		c    *conf.TrendRefresh
+		want *data.TrendRefreshPolicy
+	}{
+		{name: "not configured", c: nil, want: defaults},
+		{name: "empty", c: &conf.TrendRefresh{}, want: defaults},
+		{name: "disabled", c: &conf.TrendRefresh{Disabled: true}, want: nil},
+		{
+			name: "overrides",
+			c: &conf.TrendRefresh{
+				DefaultInterval: durationpb.New(48 * time.Hour),
+				GrowthFactor:    0.2,
+				InactiveDays:    14,
+				MaxAge:          durationpb.New(60 * 24 * time.Hour),
+			},
+			want: &data.TrendRefreshPolicy{
+				Tiers:           defaultRefreshTiers,
+				DefaultInterval: 48 * time.Hour,
+				GrowthFactor:    0.2,
+				InactiveDays:    14,
+				MaxAge:          60 * 24 * time.Hour,
+			},
+		},
+		{
+			name: "non-positive values fall back to defaults",
+			c: &conf.TrendRefresh{
+				DefaultInterval: durationpb.New(-time.Hour),
+				GrowthFactor:    -1,
+				InactiveDays:    -3,
+				MaxAge:          durationpb.New(0),
+			},
+			want: defaults,
+		},
+		{
+			name: "tiers sorted by max age and invalid tiers skipped",
+			c: &conf.TrendRefresh{
+				Tiers: []*conf.TrendRefresh_Tier{
+					{MaxAge: durationpb.New(14 * 24 * time.Hour), Interval: durationpb.New(24 * time.Hour)},
+					{MaxAge: durationpb.New(0), Interval: durationpb.New(time.Hour)},
+					{MaxAge: durationpb.New(2 * 24 * time.Hour), Interval: durationpb.New(4 * time.Hour)},
+					{MaxAge: durationpb.New(5 * 24 * time.Hour)},
+				},
+			},
+			want: &data.TrendRefreshPolicy{
+				Tiers: []data.RefreshTier{
+					{MaxAge: 2 * 24 * time.Hour, Interval: 4 * time.Hour},
+					{MaxAge: 14 * 24 * time.Hour, Interval: 24 * time.Hour},
+				},
+				DefaultInterval: defaultRefreshInterval,
+				GrowthFactor:    defaultRefreshGrowthFactor,
+				InactiveDays:    defaultRefreshInactiveDays,
+				MaxAge:          defaultRefreshMaxAge,
+			},
+		},
+		{
+			name: "all tiers invalid",
+			c: &conf.TrendRefresh{
+				Tiers: []*conf.TrendRefresh_Tier{{Interval: durationpb.New(time.Hour)}},
+			},
+			want: &data.TrendRefreshPolicy{
+				Tiers:           []data.RefreshTier{},
+				DefaultInterval: defaultRefreshInterval,
+				GrowthFactor:    defaultRefreshGrowthFactor,
+				InactiveDays:    defaultRefreshInactiveDays,
+				MaxAge:          defaultRefreshMaxAge,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc := &conf.Bootstrap{Job: &conf.Job{TrendRefresh: tt.c}}
+			got := ProvideTrendRefreshPolicy(bc)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProvideTrendRefreshPolicy() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProvideTrendRefreshPolicyKeepsDefaultTiers(t *testing.T) {
+	policy := ProvideTrendRefreshPolicy(&conf.Bootstrap{Job: &conf.Job{TrendRefresh: &conf.TrendRefresh{
+		Tiers: []*conf.TrendRefresh_Tier{
+			{MaxAge: durationpb.New(10 * 24 * time.Hour), Interval: durationpb.New(time.Hour)},
+			{MaxAge: durationpb.New(24 * time.Hour), Interval: durationpb.New(time.Hour)},
+		},
+	}}})
+	if policy.Tiers[0].MaxAge != 24*time.Hour {
+		t.Fatalf("Tiers[0].MaxAge = %v, want %v", policy.Tiers[0].MaxAge, 24*time.Hour)
+	}
+	// 排序不能改到包级别的默认分段
+	if defaultRefreshTiers[0].MaxAge != 3*24*time.Hour {
+		t.Errorf("defaultRefreshTiers mutated: %+v", defaultRefreshTiers)
+	}
+}
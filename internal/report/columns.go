@@ -0,0 +1,51 @@
+package report
+
+import (
+	"strconv"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+)
+
+// descMaxRunes 是视频描述、商品标题在报告中保留的最大字符数，避免群消息过长
+const descMaxRunes = 30
+
+// column 是报告中的一列，value 从一条榜单记录中取出展示文本
+type column struct {
+	header string
+	value  func(*v1.VideoRankDTO) string
+}
+
+// videoRankColumns 是榜单报告可选的列，key 与 API 中的字段名保持一致
+var videoRankColumns = map[string]column{
+	"rank_position":      {"排名", func(r *v1.VideoRankDTO) string { return strconv.Itoa(int(r.RankPosition)) }},
+	"aweme_desc":         {"视频", func(r *v1.VideoRankDTO) string { return truncate(r.AwemeDesc, descMaxRunes) }},
+	"aweme_share_url":    {"链接", func(r *v1.VideoRankDTO) string { return r.AwemeShareUrl }},
+	"aweme_pub_time":     {"发布时间", func(r *v1.VideoRankDTO) string { return r.AwemePubTime }},
+	"duration_str":       {"时长", func(r *v1.VideoRankDTO) string { return r.DurationStr }},
+	"goods_title":        {"商品", func(r *v1.VideoRankDTO) string { return truncate(r.GoodsTitle, descMaxRunes) }},
+	"goods_price":        {"价格", func(r *v1.VideoRankDTO) string { return strconv.FormatFloat(r.GoodsPrice, 'f', -1, 64) }},
+	"shop_name":          {"店铺", func(r *v1.VideoRankDTO) string { return r.ShopName }},
+	"brand_name":         {"品牌", func(r *v1.VideoRankDTO) string { return r.BrandName }},
+	"category_names":     {"品类", func(r *v1.VideoRankDTO) string { return r.CategoryNames }},
+	"blogger_name":       {"达人", func(r *v1.VideoRankDTO) string { return r.BloggerName }},
+	"blogger_fans_num":   {"粉丝数", func(r *v1.VideoRankDTO) string { return strconv.Itoa(int(r.BloggerFansNum)) }},
+	"blogger_tag":        {"达人标签", func(r *v1.VideoRankDTO) string { return r.BloggerTag }},
+	"sales_count_str":    {"销量", func(r *v1.VideoRankDTO) string { return r.SalesCountStr }},
+	"total_sales_str":    {"销售额", func(r *v1.VideoRankDTO) string { return r.TotalSalesStr }},
+	"like_count_inc_str": {"点赞增量", func(r *v1.VideoRankDTO) string { return r.LikeCountIncStr }},
+	"play_count_inc_str": {"播放增量", func(r *v1.VideoRankDTO) string { return r.PlayCountIncStr }},
+}
+
+// defaultVideoRankColumns 是未配置 columns 时使用的列：名次、视频、达人、商品及销量和销售额
+var defaultVideoRankColumns = []string{
+	"rank_position", "aweme_desc", "blogger_name", "blogger_fans_num",
+	"goods_title", "sales_count_str", "total_sales_str",
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
@@ -0,0 +1,98 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/Jayleonc/aresdata/pkg/webhook"
+)
+
+// table 是渲染前的报告内容
+type table struct {
+	title    string
+	subtitle string
+	headers  []string
+	rows     [][]string
+}
+
+// render 按格式输出报告正文
+func render(format string, t *table) (string, error) {
+	switch format {
+	case webhook.FormatMarkdown:
+		return renderMarkdown(t), nil
+	case webhook.FormatHTML:
+		return renderHTML(t)
+	case webhook.FormatCSV:
+		return renderCSV(t)
+	default:
+		return "", fmt.Errorf("不支持的报告格式: %s", format)
+	}
+}
+
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r", " ", "\n", " ")
+
+func renderMarkdown(t *table) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "### %s\n\n", t.title)
+	if t.subtitle != "" {
+		fmt.Fprintf(&b, "> %s\n\n", t.subtitle)
+	}
+	if len(t.rows) == 0 {
+		b.WriteString("暂无数据\n")
+		return b.String()
+	}
+	b.WriteString("| " + strings.Join(t.headers, " | ") + " |\n")
+	b.WriteString(strings.Repeat("| --- ", len(t.headers)) + "|\n")
+	cells := make([]string, len(t.headers))
+	for _, row := range t.rows {
+		for i, v := range row {
+			cells[i] = markdownCellReplacer.Replace(v)
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+	return b.String()
+}
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Title}}</title></head>
+<body>
+<h3>{{.Title}}</h3>
+{{if .Subtitle}}<p>{{.Subtitle}}</p>
+{{end}}{{if .Rows}}<table border="1" cellspacing="0" cellpadding="4">
+<thead><tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+{{else}}<p>暂无数据</p>
+{{end}}</body>
+</html>
+`))
+
+func renderHTML(t *table) (string, error) {
+	var b bytes.Buffer
+	err := htmlTemplate.Execute(&b, map[string]any{
+		"Title":    t.title,
+		"Subtitle": t.subtitle,
+		"Headers":  t.headers,
+		"Rows":     t.rows,
+	})
+	return b.String(), err
+}
+
+// renderCSV 只输出表头和数据行，标题信息由推送消息的 title 携带
+func renderCSV(t *table) (string, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	if err := w.Write(t.headers); err != nil {
+		return "", err
+	}
+	if err := w.WriteAll(t.rows); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,70 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Jayleonc/aresdata/pkg/webhook"
+)
+
+func TestRender(t *testing.T) {
+	scraped := &table{
+		title:    "日榜 <Top>",
+		subtitle: "2025-07-01",
+		headers:  []string{"排名", "标题"},
+		rows:     [][]string{{"1", "<script>alert(1)</script> a|b\nc"}, {"2", `"引号", 逗号`}},
+	}
+	empty := &table{title: "周榜", headers: []string{"排名"}}
+	tests := []struct {
+		name      string
+		format    string
+		table     *table
+		contains  []string
+		forbidden []string
+		wantErr   bool
+	}{
+		{
+			name:   "markdown escapes cell separators and newlines",
+			format: webhook.FormatMarkdown,
+			table:  scraped,
+			contains: []string{
+				"### 日榜 <Top>\n", "> 2025-07-01\n", "| 排名 | 标题 |\n", "| --- | --- |\n",
+				`| 1 | <script>alert(1)</script> a\|b c |` + "\n",
+			},
+		},
+		{
+			name:      "html escapes scraped text",
+			format:    webhook.FormatHTML,
+			table:     scraped,
+			contains:  []string{"<title>日榜 &lt;Top&gt;</title>", "<td>&lt;script&gt;alert(1)&lt;/script&gt; a|b\nc</td>", "<td>&#34;引号&#34;, 逗号</td>"},
+			forbidden: []string{"<script>"},
+		},
+		{
+			name:     "csv quotes fields",
+			format:   webhook.FormatCSV,
+			table:    scraped,
+			contains: []string{"排名,标题\n", `2,"""引号"", 逗号"` + "\n", "1,\"<script>alert(1)</script> a|b\nc\"\n"},
+		},
+		{name: "markdown without rows", format: webhook.FormatMarkdown, table: empty, contains: []string{"暂无数据"}, forbidden: []string{"| 排名"}},
+		{name: "html without rows", format: webhook.FormatHTML, table: empty, contains: []string{"<p>暂无数据</p>"}, forbidden: []string{"<table"}},
+		{name: "unknown format", format: "pdf", table: empty, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := render(tt.format, tt.table)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(got, s) {
+					t.Errorf("render() = %q, want it to contain %q", got, s)
+				}
+			}
+			for _, s := range tt.forbidden {
+				if strings.Contains(got, s) {
+					t.Errorf("render() = %q, must not contain %q", got, s)
+				}
+			}
+		})
+	}
+}
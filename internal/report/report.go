@@ -0,0 +1,213 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/conf"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/pkg/webhook"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// ProviderSet is report providers.
+var ProviderSet = wire.NewSet(NewGenerator)
+
+const (
+	// SourceVideoRank 表示报告数据来自视频榜单
+	SourceVideoRank = "video_rank"
+	// defaultLimit 是未配置 limit 时报告的条数
+	defaultLimit = 50
+	// maxLimit 是单个报告的最大条数，群机器人对消息长度有限制
+	maxLimit = 500
+)
+
+var periodNames = map[string]string{
+	data.RankPeriodDay:   "日榜",
+	data.RankPeriodWeek:  "周榜",
+	data.RankPeriodMonth: "月榜",
+}
+
+// Generator 按配置生成报告并推送到 webhook
+type Generator struct {
+	log      *log.Helper
+	reports  []*conf.Reporting_Report
+	webhooks map[string]webhook.Target
+	sender   *webhook.Sender
+	rankRepo data.VideoRankRepo
+}
+
+// NewGenerator 校验报告配置并创建 Generator，配置错误时 worker 启动失败
+func NewGenerator(bc *conf.Bootstrap, rankRepo data.VideoRankRepo, logger log.Logger) (*Generator, error) {
+	g := &Generator{
+		log:      log.NewHelper(log.With(logger, "module", "report")),
+		webhooks: make(map[string]webhook.Target),
+		sender:   webhook.NewSender(nil),
+		rankRepo: rankRepo,
+	}
+	cfg := bc.GetReporting()
+	for _, h := range cfg.GetWebhooks() {
+		if h.Name == "" || h.Url == "" {
+			return nil, errors.New("报告 webhook 必须配置 name 和 url")
+		}
+		switch h.Type {
+		case "", webhook.TypeGeneric, webhook.TypeDingTalk, webhook.TypeFeishu, webhook.TypeWeCom:
+		default:
+			return nil, fmt.Errorf("webhook %s: 不支持的类型 %s", h.Name, h.Type)
+		}
+		g.webhooks[h.Name] = webhook.Target{
+			URL:     h.Url,
+			Type:    h.Type,
+			Secret:  h.Secret,
+			Headers: h.Headers,
+			Timeout: h.GetTimeout().AsDuration(),
+		}
+	}
+	names := make(map[string]bool)
+	for _, r := range cfg.GetReports() {
+		if err := g.validate(r); err != nil {
+			return nil, fmt.Errorf("报告 %s: %w", r.Name, err)
+		}
+		if names[r.Name] {
+			return nil, fmt.Errorf("报告名称重复: %s", r.Name)
+		}
+		names[r.Name] = true
+		g.reports = append(g.reports, r)
+	}
+	return g, nil
+}
+
+func (g *Generator) validate(r *conf.Reporting_Report) error {
+	if r.Name == "" {
+		return errors.New("必须配置 name")
+	}
+	if r.Source != "" && r.Source != SourceVideoRank {
+		return fmt.Errorf("不支持的数据来源: %s", r.Source)
+	}
+	if !data.IsValidRankPeriod(r.Period) {
+		return fmt.Errorf("不支持的榜单周期: %s", r.Period)
+	}
+	if !data.IsValidVideoRankSortField(r.SortBy) {
+		return fmt.Errorf("不支持的排序字段: %s", r.SortBy)
+	}
+	if r.Limit < 0 || r.Limit > maxLimit {
+		return fmt.Errorf("limit 必须在 0 到 %d 之间", maxLimit)
+	}
+	switch r.Format {
+	case "", webhook.FormatMarkdown, webhook.FormatHTML, webhook.FormatCSV:
+	default:
+		return fmt.Errorf("不支持的报告格式: %s", r.Format)
+	}
+	for _, c := range r.Columns {
+		if _, ok := videoRankColumns[c]; !ok {
+			return fmt.Errorf("未知的列: %s", c)
+		}
+	}
+	if len(r.Webhooks) == 0 {
+		return errors.New("至少需要一个推送目标")
+	}
+	for _, h := range r.Webhooks {
+		if _, ok := g.webhooks[h]; !ok {
+			return fmt.Errorf("未定义的 webhook: %s", h)
+		}
+	}
+	return nil
+}
+
+// Reports 返回指定周期的报告定义，period 为空时返回全部
+func (g *Generator) Reports(period string) []*conf.Reporting_Report {
+	var result []*conf.Reporting_Report
+	for _, r := range g.reports {
+		if period == "" || r.Period == period {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// Find 按名称查找报告定义
+func (g *Generator) Find(name string) (*conf.Reporting_Report, bool) {
+	for _, r := range g.reports {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// Send 生成一份报告并推送到它配置的全部 webhook。
+// rankDate 为空时使用该周期最新一期榜单；某个 webhook 推送失败不影响其余目标，错误会合并返回。
+func (g *Generator) Send(ctx context.Context, r *conf.Reporting_Report, rankDate string) error {
+	msg, err := g.Generate(ctx, r, rankDate)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, name := range r.Webhooks {
+		if err := g.sender.Send(ctx, g.webhooks[name], msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		g.log.Infof("报告 %s 已推送到 %s", r.Name, name)
+	}
+	return errors.Join(errs...)
+}
+
+// Generate 查询榜单并渲染报告
+func (g *Generator) Generate(ctx context.Context, r *conf.Reporting_Report, rankDate string) (webhook.Message, error) {
+	if rankDate == "" {
+		latest, err := g.rankRepo.LatestRankDate(ctx, r.Period)
+		if err != nil {
+			return webhook.Message{}, fmt.Errorf("查询最新榜单日期失败: %w", err)
+		}
+		rankDate = latest
+	}
+
+	limit := r.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	var ranks []*v1.VideoRankDTO
+	if rankDate != "" {
+		var err error
+		ranks, _, err = g.rankRepo.ListPage(ctx, &v1.PageRequest{Page: 1, Size: int64(limit)}, r.Period, rankDate, r.CategoryId, r.SortBy, r.SortOrder)
+		if err != nil {
+			return webhook.Message{}, fmt.Errorf("查询榜单失败: %w", err)
+		}
+	}
+
+	keys := r.Columns
+	if len(keys) == 0 {
+		keys = defaultVideoRankColumns
+	}
+	t := &table{title: r.Title, headers: make([]string, len(keys))}
+	if t.title == "" {
+		t.title = r.Name
+	}
+	if rankDate != "" {
+		t.subtitle = fmt.Sprintf("%s %s，共 %d 条", periodNames[r.Period], rankDate, len(ranks))
+	}
+	for i, key := range keys {
+		t.headers[i] = videoRankColumns[key].header
+	}
+	for _, rank := range ranks {
+		row := make([]string, len(keys))
+		for i, key := range keys {
+			row[i] = videoRankColumns[key].value(rank)
+		}
+		t.rows = append(t.rows, row)
+	}
+
+	format := r.Format
+	if format == "" {
+		format = webhook.FormatMarkdown
+	}
+	content, err := render(format, t)
+	if err != nil {
+		return webhook.Message{}, err
+	}
+	return webhook.Message{Title: t.title, Format: format, Content: content}, nil
+}
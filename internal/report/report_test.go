@@ -0,0 +1,41 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/Jayleonc/aresdata/internal/conf"
+	"github.com/Jayleonc/aresdata/pkg/webhook"
+)
+
+func TestGeneratorValidate(t *testing.T) {
+	g := &Generator{webhooks: map[string]webhook.Target{"ops": {URL: "http://example.invalid"}}}
+	valid := func() *conf.Reporting_Report {
+		return &conf.Reporting_Report{Name: "daily", Period: "day", Webhooks: []string{"ops"}}
+	}
+	tests := []struct {
+		name    string
+		modify  func(r *conf.Reporting_Report)
+		wantErr bool
+	}{
+		{name: "minimal", modify: func(r *conf.Reporting_Report) {}},
+		{name: "known sort", modify: func(r *conf.Reporting_Report) { r.SortBy = "totalSalesStr" }},
+		{name: "unknown sort", modify: func(r *conf.Reporting_Report) { r.SortBy = "total_sales" }, wantErr: true},
+		{name: "missing name", modify: func(r *conf.Reporting_Report) { r.Name = "" }, wantErr: true},
+		{name: "unknown source", modify: func(r *conf.Reporting_Report) { r.Source = "video" }, wantErr: true},
+		{name: "unknown period", modify: func(r *conf.Reporting_Report) { r.Period = "year" }, wantErr: true},
+		{name: "limit too large", modify: func(r *conf.Reporting_Report) { r.Limit = maxLimit + 1 }, wantErr: true},
+		{name: "unknown format", modify: func(r *conf.Reporting_Report) { r.Format = "pdf" }, wantErr: true},
+		{name: "unknown column", modify: func(r *conf.Reporting_Report) { r.Columns = []string{"nope"} }, wantErr: true},
+		{name: "no webhooks", modify: func(r *conf.Reporting_Report) { r.Webhooks = nil }, wantErr: true},
+		{name: "undefined webhook", modify: func(r *conf.Reporting_Report) { r.Webhooks = []string{"dev"} }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.modify(r)
+			if err := g.validate(r); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -16,6 +16,17 @@ func NewGRPCServer(c *conf.Server,
 	productService *service.ProductServiceService,
 	blogger *service.BloggerServiceService,
 	videoTrend *service.VideoTrendServiceService,
+	sourceData *service.SourceDataServiceService,
+	search *service.SearchServiceService,
+	stats *service.StatsServiceService,
+	category *service.CategoryServiceService,
+	brand *service.BrandServiceService,
+	shop *service.ShopServiceService,
+	export *service.ExportServiceService,
+	alert *service.AlertServiceService,
+	watchlist *service.WatchlistServiceService,
+	videoComment *service.VideoCommentServiceService,
+	audience *service.AudienceServiceService,
 	logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
@@ -37,5 +48,16 @@ func NewGRPCServer(c *conf.Server,
 	v1.RegisterProductServiceServer(srv, productService)
 	v1.RegisterBloggerServiceServer(srv, blogger)
 	v1.RegisterVideoTrendServiceServer(srv, videoTrend)
+	v1.RegisterSourceDataServiceServer(srv, sourceData)
+	v1.RegisterSearchServiceServer(srv, search)
+	v1.RegisterStatsServiceServer(srv, stats)
+	v1.RegisterCategoryServiceServer(srv, category)
+	v1.RegisterBrandServiceServer(srv, brand)
+	v1.RegisterShopServiceServer(srv, shop)
+	v1.RegisterExportServiceServer(srv, export)
+	v1.RegisterAlertServiceServer(srv, alert)
+	v1.RegisterWatchlistServiceServer(srv, watchlist)
+	v1.RegisterVideoCommentServiceServer(srv, videoComment)
+	v1.RegisterAudienceServiceServer(srv, audience)
 	return srv
 }
@@ -18,6 +18,17 @@ func NewHTTPServer(c *conf.Server,
 	productService *service.ProductServiceService,
 	blogger *service.BloggerServiceService,
 	videoTrend *service.VideoTrendServiceService,
+	sourceData *service.SourceDataServiceService,
+	search *service.SearchServiceService,
+	stats *service.StatsServiceService,
+	category *service.CategoryServiceService,
+	brand *service.BrandServiceService,
+	shop *service.ShopServiceService,
+	export *service.ExportServiceService,
+	alert *service.AlertServiceService,
+	watchlist *service.WatchlistServiceService,
+	videoComment *service.VideoCommentServiceService,
+	audience *service.AudienceServiceService,
 	logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
@@ -44,6 +55,18 @@ func NewHTTPServer(c *conf.Server,
 		}).Handler(handler)
 	}))
 
+	// 流式导出在路由之前处理：kratos 会给路由内的请求加上 server.http.timeout，
+	// 而流式导出需要更长的独立超时，并在客户端断开时停止
+	opts = append(opts, http.Filter(func(handler nethttp.Handler) nethttp.Handler {
+		return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if r.URL.Path == "/v1/exports/stream" {
+				export.StreamHandler(w, r)
+				return
+			}
+			handler.ServeHTTP(w, r)
+		})
+	}))
+
 	srv := http.NewServer(opts...)
 
 	v1.RegisterVideoRankHTTPServer(srv, videoRank)
@@ -51,6 +74,20 @@ func NewHTTPServer(c *conf.Server,
 	v1.RegisterProductServiceHTTPServer(srv, productService)
 	v1.RegisterBloggerServiceHTTPServer(srv, blogger)
 	v1.RegisterVideoTrendServiceHTTPServer(srv, videoTrend)
+	v1.RegisterSourceDataServiceHTTPServer(srv, sourceData)
+	v1.RegisterSearchServiceHTTPServer(srv, search)
+	v1.RegisterStatsServiceHTTPServer(srv, stats)
+	v1.RegisterCategoryServiceHTTPServer(srv, category)
+	v1.RegisterBrandServiceHTTPServer(srv, brand)
+	v1.RegisterShopServiceHTTPServer(srv, shop)
+	v1.RegisterExportServiceHTTPServer(srv, export)
+	v1.RegisterAlertServiceHTTPServer(srv, alert)
+	v1.RegisterWatchlistServiceHTTPServer(srv, watchlist)
+	v1.RegisterVideoCommentServiceHTTPServer(srv, videoComment)
+	v1.RegisterAudienceServiceHTTPServer(srv, audience)
+
+	// 导出文件下载直接返回文件内容，不经过 protobuf 编解码；流式导出见上面的过滤器
+	srv.HandleFunc("/v1/exports/download", export.DownloadHandler)
 
 	// 添加 OpenAPI 文档路由
 	srv.Handle("/openapi.yaml", OpenAPIHandler("./openapi.yaml"))
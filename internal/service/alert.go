@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// AlertServiceService 提供告警规则管理和告警记录查询的 gRPC/HTTP 服务
+type AlertServiceService struct {
+	pb.UnimplementedAlertServiceServer
+	uc *biz.AlertUsecase
+}
+
+// NewAlertServiceService 构造 AlertServiceService
+func NewAlertServiceService(uc *biz.AlertUsecase) *AlertServiceService {
+	return &AlertServiceService{uc: uc}
+}
+
+// CreateAlertRule 创建告警规则
+func (s *AlertServiceService) CreateAlertRule(ctx context.Context, req *pb.AlertRule) (*pb.AlertRule, error) {
+	return s.uc.CreateRule(ctx, req)
+}
+
+// UpdateAlertRule 更新告警规则
+func (s *AlertServiceService) UpdateAlertRule(ctx context.Context, req *pb.AlertRule) (*pb.AlertRule, error) {
+	return s.uc.UpdateRule(ctx, req)
+}
+
+// DeleteAlertRule 删除告警规则
+func (s *AlertServiceService) DeleteAlertRule(ctx context.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	if err := s.uc.DeleteRule(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteAlertRuleResponse{}, nil
+}
+
+// ListAlertRules 分页查询告警规则
+func (s *AlertServiceService) ListAlertRules(ctx context.Context, req *pb.ListAlertRulesRequest) (*pb.ListAlertRulesResponse, error) {
+	if req.Page == nil {
+		req.Page = &pb.PageRequest{Page: 1, Size: 10}
+	}
+	if req.Page.Size == 0 {
+		req.Page.Size = 10
+	}
+	return s.uc.ListRules(ctx, req.Page)
+}
+
+// ListAlertEvents 分页查询告警记录
+func (s *AlertServiceService) ListAlertEvents(ctx context.Context, req *pb.ListAlertEventsRequest) (*pb.ListAlertEventsResponse, error) {
+	if req.Page == nil {
+		req.Page = &pb.PageRequest{Page: 1, Size: 10}
+	}
+	if req.Page.Size == 0 {
+		req.Page.Size = 10
+	}
+	return s.uc.ListEvents(ctx, req.Page, req.RuleId)
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// AudienceServiceService 提供观众/粉丝画像查询的 gRPC/HTTP 服务
+type AudienceServiceService struct {
+	pb.UnimplementedAudienceServiceServer
+	uc *biz.AudienceUsecase
+}
+
+// NewAudienceServiceService 构造 AudienceServiceService
+func NewAudienceServiceService(uc *biz.AudienceUsecase) *AudienceServiceService {
+	return &AudienceServiceService{uc: uc}
+}
+
+// GetVideoAudience 查询视频的观众画像
+func (s *AudienceServiceService) GetVideoAudience(ctx context.Context, req *pb.GetVideoAudienceRequest) (*pb.AudienceProfile, error) {
+	return s.uc.GetVideoAudience(ctx, req.AwemeId)
+}
+
+// GetBloggerAudience 查询博主的粉丝画像
+func (s *AudienceServiceService) GetBloggerAudience(ctx context.Context, req *pb.GetBloggerAudienceRequest) (*pb.AudienceProfile, error) {
+	return s.uc.GetBloggerAudience(ctx, req.BloggerId)
+}
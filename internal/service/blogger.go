@@ -34,13 +34,23 @@ func (s *BloggerServiceService) ListBloggers(ctx context.Context, req *pb.ListBl
 		req.Page.Size = 10
 	}
 
-	bloggers, total, err := s.uc.ListBloggers(ctx, int(req.Page.Page), int(req.Page.Size), req.Query, req.SortBy, req.SortOrder)
+	bloggers, pageResp, err := s.uc.ListBloggers(ctx, req.Page, req.Query, req.Filter, req.SortBy, req.SortOrder)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ListBloggersResponse{
-		Page:     &pb.PageResponse{Total: total},
+		Page:     pageResp,
 		Bloggers: bloggers,
 	}, nil
 }
+
+// GetBloggerProfile 查询博主画像
+func (s *BloggerServiceService) GetBloggerProfile(ctx context.Context, req *pb.BloggerProfileRequest) (*pb.BloggerProfileResponse, error) {
+	return s.uc.GetBloggerProfile(ctx, req.BloggerId, req.RankType, req.StartDate, req.EndDate, int(req.VideoLimit))
+}
+
+// GetBloggerFansHistory 查询博主粉丝增长曲线
+func (s *BloggerServiceService) GetBloggerFansHistory(ctx context.Context, req *pb.BloggerFansHistoryRequest) (*pb.BloggerFansHistoryResponse, error) {
+	return s.uc.GetBloggerFansHistory(ctx, req.BloggerId, req.StartDate, req.EndDate)
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// BrandServiceService 提供品牌维度的 gRPC/HTTP 服务
+type BrandServiceService struct {
+	pb.UnimplementedBrandServiceServer
+	uc *biz.BrandUsecase
+}
+
+// NewBrandServiceService 构造 BrandServiceService
+func NewBrandServiceService(uc *biz.BrandUsecase) *BrandServiceService {
+	return &BrandServiceService{uc: uc}
+}
+
+// ListBrands 分页查询品牌
+func (s *BrandServiceService) ListBrands(ctx context.Context, req *pb.ListDimensionsRequest) (*pb.ListDimensionsResponse, error) {
+	if req.Page == nil {
+		req.Page = &pb.PageRequest{Page: 1, Size: 10}
+	}
+	if req.Page.Size == 0 {
+		req.Page.Size = 10
+	}
+	return s.uc.ListBrands(ctx, req.Page, req.Query, req.SortBy, req.SortOrder)
+}
+
+// GetBrand 查询单个品牌
+func (s *BrandServiceService) GetBrand(ctx context.Context, req *pb.GetDimensionRequest) (*pb.GetDimensionResponse, error) {
+	return s.uc.GetBrand(ctx, req.Id)
+}
+
+// GetBrandAnalytics 查询品牌分析
+func (s *BrandServiceService) GetBrandAnalytics(ctx context.Context, req *pb.DimensionAnalyticsRequest) (*pb.DimensionAnalyticsResponse, error) {
+	return s.uc.GetBrandAnalytics(ctx, req.Id, req.RankType, req.StartDate, req.EndDate, int(req.TopLimit))
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// CategoryServiceService 提供类目树查询的 gRPC/HTTP 服务
+type CategoryServiceService struct {
+	pb.UnimplementedCategoryServiceServer
+	uc *biz.CategoryUsecase
+}
+
+// NewCategoryServiceService 构造 CategoryServiceService
+func NewCategoryServiceService(uc *biz.CategoryUsecase) *CategoryServiceService {
+	return &CategoryServiceService{uc: uc}
+}
+
+// ListCategories 查询类目列表
+func (s *CategoryServiceService) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	return s.uc.ListCategories(ctx, req.ParentId, req.Recursive)
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	nethttp "net/http"
+	"strconv"
+	"time"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// exportFlushBytes 是流式导出时每写出多少字节主动刷新一次响应
+const exportFlushBytes = 64 * 1024
+
+// ExportServiceService 提供导出任务的 gRPC/HTTP 服务，以及流式导出和文件下载的 HTTP 处理器
+type ExportServiceService struct {
+	pb.UnimplementedExportServiceServer
+	uc *biz.ExportUsecase
+}
+
+// NewExportServiceService 构造 ExportServiceService
+func NewExportServiceService(uc *biz.ExportUsecase) *ExportServiceService {
+	return &ExportServiceService{uc: uc}
+}
+
+// CreateExportJob 创建异步导出任务
+func (s *ExportServiceService) CreateExportJob(ctx context.Context, req *pb.ExportRequest) (*pb.ExportJob, error) {
+	return s.uc.CreateJob(ctx, req)
+}
+
+// GetExportJob 查询导出任务
+func (s *ExportServiceService) GetExportJob(ctx context.Context, req *pb.GetExportJobRequest) (*pb.ExportJob, error) {
+	return s.uc.GetJob(ctx, req.Id)
+}
+
+// ListExportJobs 分页查询导出任务
+func (s *ExportServiceService) ListExportJobs(ctx context.Context, req *pb.ListExportJobsRequest) (*pb.ListExportJobsResponse, error) {
+	if req.Page == nil {
+		req.Page = &pb.PageRequest{Page: 1, Size: 10}
+	}
+	if req.Page.Size == 0 {
+		req.Page.Size = 10
+	}
+	return s.uc.ListJobs(ctx, req.Page)
+}
+
+// StreamHandler 处理 POST /v1/exports/stream，请求体为 ExportRequest 的 JSON，响应以 chunked 方式边查边写。
+// 导出耗时通常超过 server.http.timeout，该路径在 HTTP 过滤器中处理、不经过 kratos 的超时控制，而是使用独立的超时；
+// 客户端断开时请求上下文被取消，查询随之停止。
+func (s *ExportServiceService) StreamHandler(w nethttp.ResponseWriter, r *nethttp.Request) {
+	if r.Method != nethttp.MethodPost {
+		writeExportError(w, nethttp.StatusMethodNotAllowed, "仅支持 POST")
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeExportError(w, nethttp.StatusBadRequest, err.Error())
+		return
+	}
+	var req pb.ExportRequest
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, &req); err != nil {
+		writeExportError(w, nethttp.StatusBadRequest, "无效的导出请求: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.uc.StreamTimeout())
+	defer cancel()
+
+	fileName := biz.ExportFileName(&req, time.Now().Format("20060102150405"))
+	w.Header().Set("Content-Type", biz.ExportContentType(req.Format))
+	w.Header().Set("Content-Disposition", `attachment; filename="`+fileName+`"`)
+	fw := &flushWriter{w: w}
+	if _, err := s.uc.Export(ctx, &req, fw); err != nil {
+		// 客户端已断开，响应无处可写
+		if r.Context().Err() != nil {
+			return
+		}
+		if fw.written == 0 {
+			writeExportError(w, exportErrorCode(err), err.Error())
+			return
+		}
+		// 响应已经开始，无法再返回错误状态码，只能中断连接让客户端感知到文件不完整
+		panic(nethttp.ErrAbortHandler)
+	}
+}
+
+// exportErrorCode 把导出错误映射为 HTTP 状态码：请求无效为 400，超时为 504，其余为服务端错误
+func exportErrorCode(err error) int {
+	switch {
+	case errors.Is(err, biz.ErrInvalidExportRequest):
+		return nethttp.StatusBadRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return nethttp.StatusGatewayTimeout
+	default:
+		return nethttp.StatusInternalServerError
+	}
+}
+
+// DownloadHandler 处理 GET /v1/exports/download?id=<任务ID>，下载已完成的异步导出文件
+func (s *ExportServiceService) DownloadHandler(w nethttp.ResponseWriter, r *nethttp.Request) {
+	id, err := strconv.ParseUint(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		writeExportError(w, nethttp.StatusBadRequest, "无效的任务 id")
+		return
+	}
+	f, fileName, format, err := s.uc.OpenJobFile(r.Context(), id)
+	if err != nil {
+		writeExportError(w, downloadErrorCode(err), err.Error())
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		writeExportError(w, nethttp.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", biz.ExportContentType(format))
+	w.Header().Set("Content-Disposition", `attachment; filename="`+fileName+`"`)
+	nethttp.ServeContent(w, r, fileName, info.ModTime(), f)
+}
+
+// downloadErrorCode 把下载错误映射为 HTTP 状态码：任务或文件不存在为 404，任务未完成为 409，其余为服务端错误
+func downloadErrorCode(err error) int {
+	switch {
+	case errors.Is(err, biz.ErrExportJobNotFound), errors.Is(err, fs.ErrNotExist):
+		return nethttp.StatusNotFound
+	case errors.Is(err, biz.ErrExportJobNotReady):
+		return nethttp.StatusConflict
+	default:
+		return nethttp.StatusInternalServerError
+	}
+}
+
+// flushWriter 记录已写出的字节数，并定期刷新响应，让客户端尽早收到数据
+type flushWriter struct {
+	w         nethttp.ResponseWriter
+	written   int64
+	unflushed int
+}
+
+func (f *flushWriter) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	f.written += int64(n)
+	f.unflushed += n
+	if f.unflushed >= exportFlushBytes {
+		if flusher, ok := f.w.(nethttp.Flusher); ok {
+			flusher.Flush()
+		}
+		f.unflushed = 0
+	}
+	return n, err
+}
+
+func writeExportError(w nethttp.ResponseWriter, code int, message string) {
+	w.Header().Del("Content-Disposition")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": code, "message": message})
+}
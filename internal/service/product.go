@@ -33,13 +33,28 @@ func (s *ProductServiceService) ListProducts(ctx context.Context, req *pb.ListPr
 		req.Page.Size = 10
 	}
 
-	products, total, err := s.uc.ListProducts(ctx, int(req.Page.Page), int(req.Page.Size), req.Query, req.SortBy, req.SortOrder)
+	products, pageResp, err := s.uc.ListProducts(ctx, req.Page, req.Query, req.Filter, req.SortBy, req.SortOrder)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ListProductsResponse{
-		Page:     &pb.PageResponse{Total: total},
+		Page:     pageResp,
 		Products: products,
 	}, nil
 }
+
+// GetProductPerformance 查询商品的带货表现
+func (s *ProductServiceService) GetProductPerformance(ctx context.Context, req *pb.ProductPerformanceRequest) (*pb.ProductPerformanceResponse, error) {
+	return s.uc.GetProductPerformance(ctx, req.GoodsId, req.RankType, req.StartDate, req.EndDate)
+}
+
+// ListProductTopVideos 查询商品销售额最高的带货视频
+func (s *ProductServiceService) ListProductTopVideos(ctx context.Context, req *pb.ListProductTopVideosRequest) (*pb.ListProductTopVideosResponse, error) {
+	return s.uc.ListProductTopVideos(ctx, req.GoodsId, req.RankType, req.StartDate, req.EndDate, int(req.Limit))
+}
+
+// GetProductPriceHistory 查询商品价格和佣金的历史时间线
+func (s *ProductServiceService) GetProductPriceHistory(ctx context.Context, req *pb.ProductPriceHistoryRequest) (*pb.ProductPriceHistoryResponse, error) {
+	return s.uc.GetProductPriceHistory(ctx, req.GoodsId, req.StartDate, req.EndDate)
+}
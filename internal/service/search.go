@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// SearchServiceService 提供统一搜索的 gRPC/HTTP 服务
+type SearchServiceService struct {
+	pb.UnimplementedSearchServiceServer
+	uc *biz.SearchUsecase
+}
+
+// NewSearchServiceService 构造 SearchServiceService
+func NewSearchServiceService(uc *biz.SearchUsecase) *SearchServiceService {
+	return &SearchServiceService{uc: uc}
+}
+
+// Search 跨视频、商品、博主检索
+func (s *SearchServiceService) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	return s.uc.Search(ctx, req.Query, req.EntityTypes, req.Filters, int(req.Limit))
+}
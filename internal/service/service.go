@@ -9,4 +9,15 @@ var ProviderSet = wire.NewSet(
 	NewProductServiceService,
 	NewBloggerServiceService,
 	NewVideoTrendServiceService,
+	NewSourceDataServiceService,
+	NewSearchServiceService,
+	NewStatsServiceService,
+	NewCategoryServiceService,
+	NewBrandServiceService,
+	NewShopServiceService,
+	NewExportServiceService,
+	NewAlertServiceService,
+	NewWatchlistServiceService,
+	NewVideoCommentServiceService,
+	NewAudienceServiceService,
 )
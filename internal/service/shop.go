@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// ShopServiceService 提供店铺维度的 gRPC/HTTP 服务
+type ShopServiceService struct {
+	pb.UnimplementedShopServiceServer
+	uc *biz.ShopUsecase
+}
+
+// NewShopServiceService 构造 ShopServiceService
+func NewShopServiceService(uc *biz.ShopUsecase) *ShopServiceService {
+	return &ShopServiceService{uc: uc}
+}
+
+// ListShops 分页查询店铺
+func (s *ShopServiceService) ListShops(ctx context.Context, req *pb.ListDimensionsRequest) (*pb.ListDimensionsResponse, error) {
+	if req.Page == nil {
+		req.Page = &pb.PageRequest{Page: 1, Size: 10}
+	}
+	if req.Page.Size == 0 {
+		req.Page.Size = 10
+	}
+	return s.uc.ListShops(ctx, req.Page, req.Query, req.SortBy, req.SortOrder)
+}
+
+// GetShop 查询单个店铺
+func (s *ShopServiceService) GetShop(ctx context.Context, req *pb.GetDimensionRequest) (*pb.GetDimensionResponse, error) {
+	return s.uc.GetShop(ctx, req.Id)
+}
+
+// GetShopAnalytics 查询店铺分析
+func (s *ShopServiceService) GetShopAnalytics(ctx context.Context, req *pb.DimensionAnalyticsRequest) (*pb.DimensionAnalyticsResponse, error) {
+	return s.uc.GetShopAnalytics(ctx, req.Id, req.RankType, req.StartDate, req.EndDate, int(req.TopLimit))
+}
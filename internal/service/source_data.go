@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+// SourceDataServiceService 提供原始数据运维相关的 gRPC/HTTP 服务
+type SourceDataServiceService struct {
+	pb.UnimplementedSourceDataServiceServer
+	uc *biz.SourceDataUsecase
+}
+
+// NewSourceDataServiceService 构造 SourceDataServiceService
+func NewSourceDataServiceService(uc *biz.SourceDataUsecase) *SourceDataServiceService {
+	return &SourceDataServiceService{uc: uc}
+}
+
+// GetSourceDataStats 按数据类型统计各处理状态的数量
+func (s *SourceDataServiceService) GetSourceDataStats(ctx context.Context, req *pb.SourceDataStatsRequest) (*pb.SourceDataStatsResponse, error) {
+	counts, err := s.uc.GetStats(ctx, req.DataType)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SourceDataStatsResponse{Counts: counts}, nil
+}
+
+// ReprocessSourceData 重放原始数据，支持 dry_run 预览
+func (s *SourceDataServiceService) ReprocessSourceData(ctx context.Context, req *pb.ReprocessSourceDataRequest) (*pb.ReprocessSourceDataResponse, error) {
+	statuses := make([]data.SourceDataStatus, 0, len(req.Statuses))
+	for _, st := range req.Statuses {
+		statuses = append(statuses, data.SourceDataStatusFromProto(st))
+	}
+	filter := &data.SourceDataFilter{
+		IDs:          req.Ids,
+		DataType:     req.DataType,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		ProviderName: req.ProviderName,
+		Statuses:     statuses,
+		Limit:        int(req.Limit),
+	}
+	return s.uc.Reprocess(ctx, filter, req.DryRun)
+}
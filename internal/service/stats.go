@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// StatsServiceService 提供看板统计的 gRPC/HTTP 服务
+type StatsServiceService struct {
+	pb.UnimplementedStatsServiceServer
+	uc *biz.StatsUsecase
+}
+
+// NewStatsServiceService 构造 StatsServiceService
+func NewStatsServiceService(uc *biz.StatsUsecase) *StatsServiceService {
+	return &StatsServiceService{uc: uc}
+}
+
+// ListDailyStats 查询某个维度的每日统计
+func (s *StatsServiceService) ListDailyStats(ctx context.Context, req *pb.ListDailyStatsRequest) (*pb.ListDailyStatsResponse, error) {
+	return s.uc.ListDailyStats(ctx, req.Dimension, req.DimensionValue, req.RankType, req.StartDate, req.EndDate)
+}
+
+// ListTopDimensions 查询维度排行
+func (s *StatsServiceService) ListTopDimensions(ctx context.Context, req *pb.ListTopDimensionsRequest) (*pb.ListTopDimensionsResponse, error) {
+	return s.uc.ListTopDimensions(ctx, req.Dimension, req.RankType, req.StartDate, req.EndDate, req.SortBy, int(req.Limit))
+}
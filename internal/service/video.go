@@ -24,13 +24,13 @@ func (s *VideoServiceService) ListVideos(ctx context.Context, req *pb.ListVideos
 		req.Page.Size = 10
 	}
 
-	videos, total, err := s.uc.ListVideos(ctx, int(req.Page.Page), int(req.Page.Size), req.Query, req.SortBy, req.SortOrder)
+	videos, pageResp, err := s.uc.ListVideos(ctx, req.Page, req.Query, req.Filter, req.SortBy, req.SortOrder)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ListVideosResponse{
-		Page:   &pb.PageResponse{Total: total},
+		Page:   pageResp,
 		Videos: videos,
 	}, nil
 }
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// VideoCommentServiceService 提供视频评论查询的 gRPC/HTTP 服务
+type VideoCommentServiceService struct {
+	pb.UnimplementedVideoCommentServiceServer
+	uc *biz.VideoCommentUsecase
+}
+
+// NewVideoCommentServiceService 构造 VideoCommentServiceService
+func NewVideoCommentServiceService(uc *biz.VideoCommentUsecase) *VideoCommentServiceService {
+	return &VideoCommentServiceService{uc: uc}
+}
+
+// ListVideoComments 分页查询视频评论
+func (s *VideoCommentServiceService) ListVideoComments(ctx context.Context, req *pb.ListVideoCommentsRequest) (*pb.ListVideoCommentsResponse, error) {
+	if req.Page == nil {
+		req.Page = &pb.PageRequest{Page: 1, Size: 10}
+	}
+	if req.Page.Size == 0 {
+		req.Page.Size = 10
+	}
+	return s.uc.ListComments(ctx, req)
+}
+
+// GetCommentKeywords 查询视频评论关键词
+func (s *VideoCommentServiceService) GetCommentKeywords(ctx context.Context, req *pb.GetCommentKeywordsRequest) (*pb.GetCommentKeywordsResponse, error) {
+	return s.uc.GetKeywords(ctx, req.AwemeId, int(req.Limit))
+}
@@ -41,12 +41,22 @@ func (s *VideoRankService) ListVideoRank(ctx context.Context, req *pb.ListVideoR
 	sortBy := req.GetSortBy()
 	sortOrder := req.GetSortOrder()
 
-	ranks, total, err := s.uc.ListVideoRank(ctx, int(req.Page.Page), int(req.Page.Size), req.RankType, req.RankDate, sortBy, sortOrder)
+	ranks, pageResp, err := s.uc.ListVideoRank(ctx, req.Page, req.RankType, req.RankDate, req.CategoryId, sortBy, sortOrder)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.ListVideoRankResponse{
-		Page:  &pb.PageResponse{Total: total},
+		Page:  pageResp,
 		Ranks: ranks,
 	}, nil
 }
+
+// GetRankHistory 查询单个视频的上榜历史
+func (s *VideoRankService) GetRankHistory(ctx context.Context, req *pb.GetRankHistoryRequest) (*pb.GetRankHistoryResponse, error) {
+	return s.uc.GetRankHistory(ctx, req.AwemeId, req.RankType, req.StartDate, req.EndDate)
+}
+
+// ListRankMovers 查询某一期榜单的名次变动
+func (s *VideoRankService) ListRankMovers(ctx context.Context, req *pb.ListRankMoversRequest) (*pb.ListRankMoversResponse, error) {
+	return s.uc.ListRankMovers(ctx, req.RankDate, req.RankType, req.Direction, int(req.Limit))
+}
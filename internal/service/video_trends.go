@@ -27,13 +27,23 @@ func (s *VideoTrendServiceService) ListVideoTrends(ctx context.Context, req *pb.
 		req.Page.Size = 100
 	}
 
-	trends, total, err := s.uc.ListVideoTrends(ctx, int(req.Page.Page), int(req.Page.Size), req.AwemeId, req.StartDate, req.EndDate)
+	trends, pageResp, err := s.uc.ListVideoTrends(ctx, req.Page, req.AwemeId, req.StartDate, req.EndDate)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ListVideoTrendsResponse{
-		Page:   &pb.PageResponse{Total: total},
+		Page:   pageResp,
 		Trends: trends,
 	}, nil
 }
+
+// GetVideoTrendAnalytics 查询单个视频的趋势分析
+func (s *VideoTrendServiceService) GetVideoTrendAnalytics(ctx context.Context, req *pb.VideoTrendAnalyticsRequest) (*pb.VideoTrendAnalyticsResponse, error) {
+	return s.uc.GetVideoTrendAnalytics(ctx, req.AwemeId, req.StartDate, req.EndDate, req.Metric)
+}
+
+// ListTrendingVideos 查询增长最快的视频
+func (s *VideoTrendServiceService) ListTrendingVideos(ctx context.Context, req *pb.ListTrendingVideosRequest) (*pb.ListTrendingVideosResponse, error) {
+	return s.uc.ListTrendingVideos(ctx, req.StartDate, req.EndDate, req.Metric, req.SortBy, req.Lifecycle, int(req.Limit))
+}
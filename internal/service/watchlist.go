@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/Jayleonc/aresdata/api/v1"
+	"github.com/Jayleonc/aresdata/internal/biz"
+)
+
+// WatchlistServiceService 提供关注列表管理的 gRPC/HTTP 服务
+type WatchlistServiceService struct {
+	pb.UnimplementedWatchlistServiceServer
+	uc *biz.WatchlistUsecase
+}
+
+// NewWatchlistServiceService 构造 WatchlistServiceService
+func NewWatchlistServiceService(uc *biz.WatchlistUsecase) *WatchlistServiceService {
+	return &WatchlistServiceService{uc: uc}
+}
+
+// AddWatchlistItems 批量添加关注对象
+func (s *WatchlistServiceService) AddWatchlistItems(ctx context.Context, req *pb.AddWatchlistItemsRequest) (*pb.AddWatchlistItemsResponse, error) {
+	return s.uc.AddItems(ctx, req)
+}
+
+// RemoveWatchlistItem 取消关注
+func (s *WatchlistServiceService) RemoveWatchlistItem(ctx context.Context, req *pb.RemoveWatchlistItemRequest) (*pb.RemoveWatchlistItemResponse, error) {
+	if err := s.uc.RemoveItem(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveWatchlistItemResponse{}, nil
+}
+
+// ListWatchlistItems 分页查询关注对象
+func (s *WatchlistServiceService) ListWatchlistItems(ctx context.Context, req *pb.ListWatchlistItemsRequest) (*pb.ListWatchlistItemsResponse, error) {
+	if req.Page == nil {
+		req.Page = &pb.PageRequest{Page: 1, Size: 10}
+	}
+	if req.Page.Size == 0 {
+		req.Page.Size = 10
+	}
+	return s.uc.ListItems(ctx, req.Page, req.EntityType)
+}
@@ -0,0 +1,59 @@
+package task
+
+import (
+	"context"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const backfillVideoMetricsBatchSize = 500
+
+// BackfillVideoMetricsTask 为历史视频数据回填由 *Str 字段解析得到的数值化指标列
+type BackfillVideoMetricsTask struct {
+	log       *log.Helper
+	videoRepo data.VideoRepo
+}
+
+func NewBackfillVideoMetricsTask(logger log.Logger, videoRepo data.VideoRepo) *BackfillVideoMetricsTask {
+	return &BackfillVideoMetricsTask{
+		log:       log.NewHelper(log.With(logger, "module", "task.backfill_video_metrics")),
+		videoRepo: videoRepo,
+	}
+}
+
+func (t *BackfillVideoMetricsTask) Name() string {
+	return BackfillVideoMetrics
+}
+
+// Run 按 aweme_id 游标分批处理全部视频，任务可重复执行，单条更新失败不会中断整体流程
+func (t *BackfillVideoMetricsTask) Run(ctx context.Context, args ...string) error {
+	t.log.Info("开始回填视频数值化指标...")
+	var (
+		cursor  string
+		updated int
+		failed  int
+	)
+	for {
+		videos, err := t.videoRepo.ListForMetricsBackfill(ctx, cursor, backfillVideoMetricsBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(videos) == 0 {
+			break
+		}
+		for _, v := range videos {
+			v.NormalizeMetrics()
+			if err := t.videoRepo.UpdateMetrics(ctx, v); err != nil {
+				t.log.Errorf("回填视频 %s 的数值化指标失败: %v", v.AwemeId, err)
+				failed++
+				continue
+			}
+			updated++
+		}
+		cursor = videos[len(videos)-1].AwemeId
+		t.log.Infof("已回填 %d 条，当前游标: %s", updated, cursor)
+	}
+	t.log.Infof("视频数值化指标回填完成，成功 %d 条，失败 %d 条", updated, failed)
+	return nil
+}
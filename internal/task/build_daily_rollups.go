@@ -0,0 +1,99 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const buildDailyRollupsBatchSize = 100
+
+// BuildDailyRollupsTask 重建 daily_rollups 汇总表。
+// 默认只处理 ETL 登记的待重建日期（增量），也可以通过参数强制重建某一期：
+//
+//	-task build:daily_rollups period=day date=20250701
+type BuildDailyRollupsTask struct {
+	log        *log.Helper
+	rollupRepo data.RollupRepo
+}
+
+func NewBuildDailyRollupsTask(logger log.Logger, rollupRepo data.RollupRepo) *BuildDailyRollupsTask {
+	return &BuildDailyRollupsTask{
+		log:        log.NewHelper(log.With(logger, "module", "task.build_daily_rollups")),
+		rollupRepo: rollupRepo,
+	}
+}
+
+func (t *BuildDailyRollupsTask) Name() string {
+	return BuildDailyRollups
+}
+
+func (t *BuildDailyRollupsTask) Run(ctx context.Context, args ...string) error {
+	period, datecode, err := parseBuildRollupsArgs(args)
+	if err != nil {
+		return err
+	}
+	if datecode != "" {
+		_, _, rankDate := data.VideoRankPeriodDates(period, datecode)
+		t.log.Infof("强制重建汇总: period=%s rank_date=%s", period, rankDate)
+		return t.rollupRepo.Rebuild(ctx, period, rankDate)
+	}
+
+	// 失败的日期会进入退避期，不再出现在 ListDirty 中，循环最终会处理完全部可重建的日期
+	var rebuilt, failed int
+	for {
+		dirty, err := t.rollupRepo.ListDirty(ctx, buildDailyRollupsBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(dirty) == 0 {
+			break
+		}
+		for _, d := range dirty {
+			if err := t.rollupRepo.Rebuild(ctx, d.PeriodType, d.RankDate); err != nil {
+				t.log.Errorf("重建汇总失败 (period=%s rank_date=%s attempts=%d): %v", d.PeriodType, d.RankDate, d.Attempts+1, err)
+				if err := t.rollupRepo.MarkFailed(ctx, d, err); err != nil {
+					return err
+				}
+				failed++
+				continue
+			}
+			if err := t.rollupRepo.ClearDirty(ctx, d); err != nil {
+				return err
+			}
+			rebuilt++
+		}
+	}
+	t.log.Infof("汇总重建完成，成功 %d 期，失败 %d 期", rebuilt, failed)
+	return nil
+}
+
+// parseBuildRollupsArgs 解析 period=、date= 参数；未指定 date 时表示增量处理
+func parseBuildRollupsArgs(args []string) (period, datecode string, err error) {
+	period = data.RankPeriodDay
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return "", "", fmt.Errorf("无效的参数 %q，应为 key=value 格式", arg)
+		}
+		switch key {
+		case "period":
+			period = value
+		case "date":
+			if _, err := time.Parse("20060102", value); err != nil {
+				return "", "", fmt.Errorf("无效的 date %q，应为 YYYYMMDD 格式", value)
+			}
+			datecode = value
+		default:
+			return "", "", fmt.Errorf("未知参数 %q", key)
+		}
+	}
+	if !data.IsValidRankPeriod(period) {
+		return "", "", fmt.Errorf("不支持的榜单周期: %s", period)
+	}
+	return period, datecode, nil
+}
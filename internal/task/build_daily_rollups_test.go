@@ -0,0 +1,31 @@
+package task
+
+import "testing"
+
+func TestParseBuildRollupsArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		wantPeriod   string
+		wantDatecode string
+		wantErr      bool
+	}{
+		{name: "incremental", args: nil, wantPeriod: "day"},
+		{name: "forced day", args: []string{"date=20250701"}, wantPeriod: "day", wantDatecode: "20250701"},
+		{name: "forced week", args: []string{"period=week", "date=20250703"}, wantPeriod: "week", wantDatecode: "20250703"},
+		{name: "dashed date", args: []string{"date=2025-07-01"}, wantErr: true},
+		{name: "unknown period", args: []string{"period=year"}, wantErr: true},
+		{name: "unknown key", args: []string{"rank_date=20250701"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, datecode, err := parseBuildRollupsArgs(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseBuildRollupsArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if period != tt.wantPeriod || datecode != tt.wantDatecode {
+				t.Errorf("parseBuildRollupsArgs() = (%q, %q), want (%q, %q)", period, datecode, tt.wantPeriod, tt.wantDatecode)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Jayleonc/aresdata/internal/alert"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// EvaluateAlertsTask 评估全部已启用的告警规则并发送通知。
+// 调度器在每次 ETL 完成后触发，默认评估各数据来源的最新日期，也可以指定日期重新评估：
+//
+//	-task evaluate:alerts date=20250701
+type EvaluateAlertsTask struct {
+	log    *log.Helper
+	engine *alert.Engine
+}
+
+func NewEvaluateAlertsTask(logger log.Logger, engine *alert.Engine) *EvaluateAlertsTask {
+	return &EvaluateAlertsTask{
+		log:    log.NewHelper(log.With(logger, "module", "task.evaluate_alerts")),
+		engine: engine,
+	}
+}
+
+func (t *EvaluateAlertsTask) Name() string {
+	return EvaluateAlerts
+}
+
+func (t *EvaluateAlertsTask) Run(ctx context.Context, args ...string) error {
+	var datecode string
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || key != "date" {
+			return fmt.Errorf("无效的参数 %q，仅支持 date=YYYYMMDD", arg)
+		}
+		datecode = value
+	}
+	n, err := t.engine.Run(ctx, datecode)
+	t.log.Infof("告警规则评估完成，新增告警 %d 条", n)
+	return err
+}
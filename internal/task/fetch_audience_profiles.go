@@ -0,0 +1,111 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// FetchAudienceProfilesTask 采集视频观众画像和博主粉丝画像，销售额高的视频、粉丝多的博主优先。
+// 画像变化较慢，同一对象在 interval 内只采集一次，支持参数：
+//
+//	-task fetch:audience_profiles type=video|blogger limit=50 interval=168h
+//
+// 不指定 type 时视频和博主各采集 limit 个。
+type FetchAudienceProfilesTask struct {
+	log          *log.Helper
+	provider     *HttpTaskProvider
+	audienceRepo data.AudienceRepo
+}
+
+func NewFetchAudienceProfilesTask(logger log.Logger, provider *HttpTaskProvider, audienceRepo data.AudienceRepo) *FetchAudienceProfilesTask {
+	return &FetchAudienceProfilesTask{
+		log:          log.NewHelper(log.With(logger, "module", "task.fetch_audience_profiles")),
+		provider:     provider,
+		audienceRepo: audienceRepo,
+	}
+}
+
+func (t *FetchAudienceProfilesTask) Name() string {
+	return FetchAudienceProfiles
+}
+
+func (t *FetchAudienceProfilesTask) Run(ctx context.Context, args ...string) error {
+	subjectType, limit, interval := "", 50, 7*24*time.Hour
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return fmt.Errorf("无效的参数 %q，应为 key=value 格式", arg)
+		}
+		var err error
+		switch key {
+		case "type":
+			if value != data.AudienceSubjectVideo && value != data.AudienceSubjectBlogger {
+				return fmt.Errorf("不支持的画像对象类型: %s", value)
+			}
+			subjectType = value
+		case "limit":
+			limit, err = strconv.Atoi(value)
+		case "interval":
+			interval, err = time.ParseDuration(value)
+		default:
+			return fmt.Errorf("未知参数 %q", key)
+		}
+		if err != nil {
+			return fmt.Errorf("无效的参数 %q: %w", arg, err)
+		}
+	}
+	if limit <= 0 || interval <= 0 {
+		return fmt.Errorf("limit、interval 必须大于0")
+	}
+
+	var finalErr error
+	if subjectType == "" || subjectType == data.AudienceSubjectVideo {
+		if err := t.fetchVideos(ctx, interval, limit); err != nil {
+			finalErr = err
+		}
+	}
+	if subjectType == "" || subjectType == data.AudienceSubjectBlogger {
+		if err := t.fetchBloggers(ctx, interval, limit); err != nil {
+			finalErr = err
+		}
+	}
+	return finalErr
+}
+
+func (t *FetchAudienceProfilesTask) fetchVideos(ctx context.Context, interval time.Duration, limit int) error {
+	ids, err := t.audienceRepo.FindVideosForCollection(ctx, interval, limit)
+	if err != nil {
+		return err
+	}
+	t.log.WithContext(ctx).Infof("开始采集 %d 个视频的观众画像", len(ids))
+	var finalErr error
+	for _, id := range ids {
+		if _, err := t.provider.HttpUC.FetchAndStoreVideoAudience(ctx, id); err != nil {
+			finalErr = err
+		}
+		time.Sleep(2 * time.Second)
+	}
+	return finalErr
+}
+
+func (t *FetchAudienceProfilesTask) fetchBloggers(ctx context.Context, interval time.Duration, limit int) error {
+	ids, err := t.audienceRepo.FindBloggersForCollection(ctx, interval, limit)
+	if err != nil {
+		return err
+	}
+	t.log.WithContext(ctx).Infof("开始采集 %d 个博主的粉丝画像", len(ids))
+	var finalErr error
+	for _, id := range ids {
+		if _, err := t.provider.HttpUC.FetchAndStoreBloggerAudience(ctx, id); err != nil {
+			finalErr = err
+		}
+		time.Sleep(2 * time.Second)
+	}
+	return finalErr
+}
@@ -0,0 +1,91 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// FetchVideoCommentsTask 采集有评论的视频的热门评论和评论关键词，评论多的视频优先。
+// 同一视频在 interval 内只采集一次，支持参数：
+//
+//	-task fetch:video_comments limit=50 pages=3 interval=24h
+type FetchVideoCommentsTask struct {
+	log              *log.Helper
+	provider         *HttpTaskProvider
+	videoCommentRepo data.VideoCommentRepo
+}
+
+func NewFetchVideoCommentsTask(logger log.Logger, provider *HttpTaskProvider, videoCommentRepo data.VideoCommentRepo) *FetchVideoCommentsTask {
+	return &FetchVideoCommentsTask{
+		log:              log.NewHelper(log.With(logger, "module", "task.fetch_video_comments")),
+		provider:         provider,
+		videoCommentRepo: videoCommentRepo,
+	}
+}
+
+func (t *FetchVideoCommentsTask) Name() string {
+	return FetchVideoComments
+}
+
+func (t *FetchVideoCommentsTask) Run(ctx context.Context, args ...string) error {
+	limit, pages, interval := 50, 3, 24*time.Hour
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return fmt.Errorf("无效的参数 %q，应为 key=value 格式", arg)
+		}
+		var err error
+		switch key {
+		case "limit":
+			limit, err = strconv.Atoi(value)
+		case "pages":
+			pages, err = strconv.Atoi(value)
+		case "interval":
+			interval, err = time.ParseDuration(value)
+		default:
+			return fmt.Errorf("未知参数 %q", key)
+		}
+		if err != nil {
+			return fmt.Errorf("无效的参数 %q: %w", arg, err)
+		}
+	}
+	if limit <= 0 || pages <= 0 || interval <= 0 {
+		return fmt.Errorf("limit、pages、interval 必须大于0")
+	}
+
+	videos, err := t.videoCommentRepo.FindVideosForCommentCollection(ctx, interval, limit)
+	if err != nil {
+		return err
+	}
+	t.log.WithContext(ctx).Infof("开始采集 %d 个视频的评论，每个视频 %d 页", len(videos), pages)
+
+	const pageSize = 20
+	var finalErr error
+	for _, v := range videos {
+		for pageIndex := 1; pageIndex <= pages; pageIndex++ {
+			if _, err := t.provider.HttpUC.FetchAndStoreVideoComments(ctx, v.AwemeId, pageIndex, pageSize); err != nil {
+				finalErr = err
+				break
+			}
+			time.Sleep(2 * time.Second)
+		}
+		// 关键词最后采集，它的采集记录同时标记该视频本轮评论采集已完成
+		if _, err := t.provider.HttpUC.FetchAndStoreVideoCommentKeywords(ctx, v.AwemeId); err != nil {
+			finalErr = err
+		}
+		time.Sleep(2 * time.Second)
+	}
+
+	if finalErr != nil {
+		t.log.WithContext(ctx).Errorf("评论采集完成，但过程中存在错误。")
+		return finalErr
+	}
+	t.log.WithContext(ctx).Infof("%d 个视频的评论采集完成", len(videos))
+	return nil
+}
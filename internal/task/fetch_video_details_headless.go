@@ -131,8 +131,8 @@ func (t *FetchVideoDetailsHeadlessTask) Run(ctx context.Context, args ...string)
 				batchSize := t.scheduler.GetNextBatchSize()
 				t.log.Infof("数据源 [%s] 新批次启动，计划处理 %d 个视频", datasourceName, batchSize)
 
-				// 4.2 【核心修正】通过 Usecase 层获取待采集视频，不再直接调用 Repo
-				videos, err := t.provider.HeadlessUC.GetVideosForFirstCollection(ctx, batchSize)
+				// 4.2 【核心修正】通过 Usecase 层获取待采集视频，不再直接调用 Repo；关注列表中到期的视频排在最前
+				videos, err := t.provider.HeadlessUC.GetVideosForCollection(ctx, batchSize)
 				if err != nil {
 					t.log.Errorf("从 Usecase 获取待采集视频失败: %v", err)
 					time.Sleep(30 * time.Second) // 发生错误，等待一段时间再试
@@ -2,7 +2,10 @@ package task
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/Jayleonc/aresdata/internal/data"
 	"github.com/go-kratos/kratos/v2/log"
 	"time"
 )
@@ -24,10 +27,13 @@ func (t *FetchVideoRankTask) Name() string {
 	return FetchVideoRank
 }
 
+// Run 采集视频榜单，支持参数 period=day|week|month（默认 day）和 date=20060102（默认按周期取最近一个已出榜的日期）
 func (t *FetchVideoRankTask) Run(ctx context.Context, args ...string) error {
-	// 默认采集前天的数据，例如今天是22号，则采集20号的数据
-	datecode := time.Now().AddDate(0, 0, -3).Format("20060102")
-	t.log.WithContext(ctx).Infof("开始采集日榜数据，日期: %s", datecode)
+	period, datecode, err := parseFetchVideoRankArgs(args, time.Now())
+	if err != nil {
+		return err
+	}
+	t.log.WithContext(ctx).Infof("开始采集%s榜数据，日期: %s", period, datecode)
 	// 定义分批次采集的参数
 	totalBatches := 10 // 采集20次
 	pageSize := 50     // 每次50条，最多只能拿50条，写100也是50条
@@ -37,9 +43,9 @@ func (t *FetchVideoRankTask) Run(ctx context.Context, args ...string) error {
 		pageIndex := i
 		t.log.WithContext(ctx).Infof("正在采集第 %d/%d 批数据...", pageIndex, totalBatches)
 
-		_, err := t.provider.HttpUC.FetchAndStoreVideoRank(ctx, "day", datecode, pageIndex, pageSize)
+		_, err := t.provider.HttpUC.FetchAndStoreVideoRank(ctx, period, datecode, pageIndex, pageSize)
 		if err != nil {
-			t.log.WithContext(ctx).Errorf("采集日榜数据失败，日期: %s，批次: %d，错误: %v", datecode, pageIndex, err)
+			t.log.WithContext(ctx).Errorf("采集%s榜数据失败，日期: %s，批次: %d，错误: %v", period, datecode, pageIndex, err)
 			finalErr = err // 记录遇到的最后一个错误
 		} else {
 			t.log.WithContext(ctx).Infof("第 %d/%d 批数据采集任务已成功下发", pageIndex, totalBatches)
@@ -54,6 +60,44 @@ func (t *FetchVideoRankTask) Run(ctx context.Context, args ...string) error {
 		return finalErr
 	}
 
-	t.log.WithContext(ctx).Infof("所有批次日榜数据采集任务均已成功下发，日期: %s", datecode)
+	t.log.WithContext(ctx).Infof("所有批次%s榜数据采集任务均已成功下发，日期: %s", period, datecode)
 	return nil
 }
+
+// parseFetchVideoRankArgs 解析 period=、date= 参数。未指定日期时：
+// 日榜取3天前（数据源有延迟），周榜取上一周，月榜取上一个月。
+func parseFetchVideoRankArgs(args []string, now time.Time) (period, datecode string, err error) {
+	period = data.RankPeriodDay
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return "", "", fmt.Errorf("无效的参数 %q，应为 key=value 格式", arg)
+		}
+		switch key {
+		case "period":
+			period = value
+		case "date":
+			if _, err := time.Parse("20060102", value); err != nil {
+				return "", "", fmt.Errorf("无效的 date %q，应为 YYYYMMDD 格式", value)
+			}
+			datecode = value
+		default:
+			return "", "", fmt.Errorf("未知参数 %q", key)
+		}
+	}
+	if !data.IsValidRankPeriod(period) {
+		return "", "", fmt.Errorf("不支持的榜单周期: %s", period)
+	}
+	if datecode == "" {
+		switch period {
+		case data.RankPeriodWeek:
+			datecode = now.AddDate(0, 0, -7).Format("20060102")
+		case data.RankPeriodMonth:
+			datecode = now.AddDate(0, -1, 0).Format("20060102")
+		default:
+			datecode = now.AddDate(0, 0, -3).Format("20060102")
+		}
+	}
+	_, _, datecode = data.VideoRankPeriodDates(period, datecode)
+	return period, datecode, nil
+}
@@ -0,0 +1,41 @@
+package task
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseFetchVideoRankArgs(t *testing.T) {
+	// 2025-07-10 为周四
+	now := time.Date(2025, 7, 10, 9, 0, 0, 0, time.Local)
+	tests := []struct {
+		name         string
+		args         []string
+		wantPeriod   string
+		wantDatecode string
+		wantErr      bool
+	}{
+		{name: "default day", args: nil, wantPeriod: "day", wantDatecode: "20250707"},
+		{name: "default week", args: []string{"period=week"}, wantPeriod: "week", wantDatecode: "20250630"},
+		{name: "default month", args: []string{"period=month"}, wantPeriod: "month", wantDatecode: "20250601"},
+		{name: "explicit day", args: []string{"date=20250701"}, wantPeriod: "day", wantDatecode: "20250701"},
+		{name: "explicit week aligned", args: []string{"period=week", "date=20250703"}, wantPeriod: "week", wantDatecode: "20250630"},
+		{name: "explicit month aligned", args: []string{"period=month", "date=20250215"}, wantPeriod: "month", wantDatecode: "20250201"},
+		{name: "dashed date", args: []string{"period=week", "date=2025-07-03"}, wantErr: true},
+		{name: "impossible date", args: []string{"date=20250230"}, wantErr: true},
+		{name: "unknown period", args: []string{"period=year"}, wantErr: true},
+		{name: "unknown key", args: []string{"day=20250701"}, wantErr: true},
+		{name: "missing equals", args: []string{"week"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, datecode, err := parseFetchVideoRankArgs(tt.args, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFetchVideoRankArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if period != tt.wantPeriod || datecode != tt.wantDatecode {
+				t.Errorf("parseFetchVideoRankArgs() = (%q, %q), want (%q, %q)", period, datecode, tt.wantPeriod, tt.wantDatecode)
+			}
+		})
+	}
+}
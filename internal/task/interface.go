@@ -14,6 +14,15 @@ const (
 	FetchVideoDetailsHeadless  = "fetch:video_detail_headless"
 	ProcessVideoDetailHeadless = "process:video_detail_headless" // <-- 新增此行
 	RemedyVideoDetailsHeadless = "remedy:video_details_headless"
+	ReprocessSourceData        = "reprocess:source_data"
+	BackfillVideoMetrics       = "backfill:video_metrics"
+	BuildDailyRollups          = "build:daily_rollups"
+	SendReports                = "send:reports"
+	EvaluateAlerts             = "evaluate:alerts"
+	FetchVideoComments         = "fetch:video_comments"
+	ProcessVideoComments       = "process:video_comments"
+	FetchAudienceProfiles      = "fetch:audience_profiles"
+	ProcessAudienceProfiles    = "process:audience_profiles"
 )
 
 // Task 定义了所有可执行任务的标准接口
@@ -0,0 +1,38 @@
+package task
+
+import (
+	"context"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/internal/etl"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ProcessAudienceProfilesTask 处理已采集的视频观众画像和博主粉丝画像
+type ProcessAudienceProfilesTask struct {
+	etl *etl.ETLUsecase
+	log *log.Helper
+}
+
+// NewProcessAudienceProfilesTask .
+func NewProcessAudienceProfilesTask(etl *etl.ETLUsecase, logger log.Logger) *ProcessAudienceProfilesTask {
+	return &ProcessAudienceProfilesTask{
+		etl: etl,
+		log: log.NewHelper(log.With(logger, "module", "task.process_audience_profiles")),
+	}
+}
+
+func (t *ProcessAudienceProfilesTask) Name() string {
+	return ProcessAudienceProfiles
+}
+
+func (t *ProcessAudienceProfilesTask) Run(ctx context.Context, args ...string) error {
+	var finalErr error
+	for _, dataType := range []string{data.DataTypeVideoAudience, data.DataTypeBloggerAudience} {
+		if err := t.etl.RunWithType(ctx, dataType); err != nil {
+			t.log.WithContext(ctx).Errorf("处理 %s 数据失败: %v", dataType, err)
+			finalErr = err
+		}
+	}
+	return finalErr
+}
@@ -0,0 +1,38 @@
+package task
+
+import (
+	"context"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/internal/etl"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ProcessVideoCommentsTask 处理已采集的评论列表和评论关键词
+type ProcessVideoCommentsTask struct {
+	etl *etl.ETLUsecase
+	log *log.Helper
+}
+
+// NewProcessVideoCommentsTask .
+func NewProcessVideoCommentsTask(etl *etl.ETLUsecase, logger log.Logger) *ProcessVideoCommentsTask {
+	return &ProcessVideoCommentsTask{
+		etl: etl,
+		log: log.NewHelper(log.With(logger, "module", "task.process_video_comments")),
+	}
+}
+
+func (t *ProcessVideoCommentsTask) Name() string {
+	return ProcessVideoComments
+}
+
+func (t *ProcessVideoCommentsTask) Run(ctx context.Context, args ...string) error {
+	var finalErr error
+	for _, dataType := range []string{data.DataTypeVideoComment, data.DataTypeVideoCommentKeyword} {
+		if err := t.etl.RunWithType(ctx, dataType); err != nil {
+			t.log.WithContext(ctx).Errorf("处理 %s 数据失败: %v", dataType, err)
+			finalErr = err
+		}
+	}
+	return finalErr
+}
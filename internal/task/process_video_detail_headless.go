@@ -4,21 +4,24 @@ package task
 
 import (
 	"context"
+	"github.com/Jayleonc/aresdata/internal/alert"
 	"github.com/Jayleonc/aresdata/internal/etl"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 // ProcessVideoDetailHeadlessTask 负责处理所有由无头浏览器采集的详情数据
 type ProcessVideoDetailHeadlessTask struct {
-	etl *etl.ETLUsecase
-	log *log.Helper
+	etl         *etl.ETLUsecase
+	alertEngine *alert.Engine
+	log         *log.Helper
 }
 
 // NewProcessVideoDetailHeadlessTask .
-func NewProcessVideoDetailHeadlessTask(etl *etl.ETLUsecase, logger log.Logger) *ProcessVideoDetailHeadlessTask {
+func NewProcessVideoDetailHeadlessTask(etl *etl.ETLUsecase, alertEngine *alert.Engine, logger log.Logger) *ProcessVideoDetailHeadlessTask {
 	return &ProcessVideoDetailHeadlessTask{
-		etl: etl,
-		log: log.NewHelper(log.With(logger, "module", "task.process_video_detail_headless")),
+		etl:         etl,
+		alertEngine: alertEngine,
+		log:         log.NewHelper(log.With(logger, "module", "task.process_video_detail_headless")),
 	}
 }
 
@@ -47,6 +50,13 @@ func (t *ProcessVideoDetailHeadlessTask) Run(ctx context.Context, args ...string
 		t.log.WithContext(ctx).Info("video_trend_headless 数据处理完成。")
 	}
 
+	// 3. 趋势数据写入 video_trends 后评估告警规则，告警失败不影响 ETL 结果
+	if n, err := t.alertEngine.Run(ctx, ""); err != nil {
+		t.log.WithContext(ctx).Errorf("评估告警规则失败: %v", err)
+	} else {
+		t.log.WithContext(ctx).Infof("告警规则评估完成，新增告警 %d 条", n)
+	}
+
 	t.log.WithContext(ctx).Info("[ETL-无头浏览器视频详情] 任务执行完毕。")
 	return nil
 }
@@ -2,6 +2,10 @@ package task
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Jayleonc/aresdata/internal/data"
 	"github.com/Jayleonc/aresdata/internal/etl"
 )
 
@@ -17,7 +21,23 @@ func (t *ProcessVideoRankTask) Name() string {
 	return ProcessVideoRank
 }
 
+// Run 处理榜单原始数据，支持参数 period=day|week|month；未指定时依次处理全部周期
 func (t *ProcessVideoRankTask) Run(ctx context.Context, args ...string) error {
-	// 处理所有 dataType 为 video_rank_day 的未处理数据
-	return t.etl.RunWithType(ctx, "video_rank_day")
+	periods := data.VideoRankPeriods
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || key != "period" {
+			return fmt.Errorf("无效的参数 %q，仅支持 period=day|week|month", arg)
+		}
+		if !data.IsValidRankPeriod(value) {
+			return fmt.Errorf("不支持的榜单周期: %s", value)
+		}
+		periods = []string{value}
+	}
+	for _, period := range periods {
+		if err := t.etl.RunWithType(ctx, data.VideoRankDataType(period)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Jayleonc/aresdata/internal/data"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -0,0 +1,123 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/internal/etl"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ReprocessSourceDataTask 按条件重置并重放原始数据
+// 参数形如 key=value，start/end 对应 source_data.date，格式为 YYYYMMDD，例如：
+//
+//	-task reprocess:source_data data_type=video_rank_day start=20250701 end=20250707 status=processed,error dry_run=true
+type ReprocessSourceDataTask struct {
+	etl *etl.ETLUsecase
+	log *log.Helper
+}
+
+func NewReprocessSourceDataTask(etl *etl.ETLUsecase, logger log.Logger) *ReprocessSourceDataTask {
+	return &ReprocessSourceDataTask{
+		etl: etl,
+		log: log.NewHelper(log.With(logger, "module", "task.reprocess_source_data")),
+	}
+}
+
+func (t *ReprocessSourceDataTask) Name() string {
+	return ReprocessSourceData
+}
+
+func (t *ReprocessSourceDataTask) Run(ctx context.Context, args ...string) error {
+	filter, dryRun, err := parseReprocessArgs(args)
+	if err != nil {
+		return err
+	}
+
+	result, err := t.etl.PrepareReprocess(ctx, filter, dryRun)
+	if err != nil {
+		return err
+	}
+	for _, c := range result.Counts {
+		t.log.Infof("匹配分布: data_type=%s status=%s count=%d", c.DataType, c.Status, c.Count)
+	}
+	t.log.Infof("重放准备完成: dry_run=%v matched=%d reset=%d skipped=%d", result.DryRun, result.Matched, result.Reset, result.Skipped)
+
+	if dryRun {
+		return nil
+	}
+	return t.etl.ProcessByIDs(ctx, result.IDs)
+}
+
+// parseReprocessArgs 将 key=value 形式的任务参数解析为过滤条件
+func parseReprocessArgs(args []string) (*data.SourceDataFilter, bool, error) {
+	filter := &data.SourceDataFilter{}
+	dryRun := false
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return nil, false, fmt.Errorf("无效的参数 %q，应为 key=value 格式", arg)
+		}
+		switch key {
+		case "ids":
+			for _, s := range strings.Split(value, ",") {
+				id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+				if err != nil {
+					return nil, false, fmt.Errorf("无效的 id %q: %w", s, err)
+				}
+				filter.IDs = append(filter.IDs, id)
+			}
+		case "data_type":
+			filter.DataType = value
+		case "start":
+			filter.StartDate = value
+		case "end":
+			filter.EndDate = value
+		case "provider":
+			filter.ProviderName = value
+		case "status":
+			for _, s := range strings.Split(value, ",") {
+				status, err := parseSourceDataStatus(strings.TrimSpace(s))
+				if err != nil {
+					return nil, false, err
+				}
+				filter.Statuses = append(filter.Statuses, status)
+			}
+		case "dry_run":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, false, fmt.Errorf("无效的 dry_run %q: %w", value, err)
+			}
+			dryRun = b
+		case "limit":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, false, fmt.Errorf("无效的 limit %q: %w", value, err)
+			}
+			filter.Limit = n
+		default:
+			return nil, false, fmt.Errorf("未知参数 %q", key)
+		}
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, false, err
+	}
+	return filter, dryRun, nil
+}
+
+func parseSourceDataStatus(s string) (data.SourceDataStatus, error) {
+	for _, st := range []data.SourceDataStatus{
+		data.SourceDataStatusError,
+		data.SourceDataStatusUnprocessed,
+		data.SourceDataStatusProcessed,
+		data.SourceDataStatusFiltered,
+	} {
+		if st.String() == s {
+			return st, nil
+		}
+	}
+	return 0, fmt.Errorf("未知的状态 %q", s)
+}
@@ -0,0 +1,55 @@
+package task
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Jayleonc/aresdata/internal/data"
+)
+
+func TestParseReprocessArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantFilter data.SourceDataFilter
+		wantDryRun bool
+		wantErr    bool
+	}{
+		{
+			name:       "full",
+			args:       []string{"data_type=video_rank_day", "start=20250701", "end=20250707", "status=processed,error", "dry_run=true", "limit=50"},
+			wantFilter: data.SourceDataFilter{DataType: "video_rank_day", StartDate: "20250701", EndDate: "20250707", Statuses: []data.SourceDataStatus{data.SourceDataStatusProcessed, data.SourceDataStatusError}, Limit: 50},
+			wantDryRun: true,
+		},
+		{
+			name:       "ids",
+			args:       []string{"ids=3, 5"},
+			wantFilter: data.SourceDataFilter{IDs: []int64{3, 5}},
+		},
+		{name: "dashed date", args: []string{"start=2025-07-01"}, wantErr: true},
+		{name: "unknown status", args: []string{"status=done"}, wantErr: true},
+		{name: "bad id", args: []string{"ids=1,x"}, wantErr: true},
+		{name: "bad dry_run", args: []string{"data_type=video_detail", "dry_run=maybe"}, wantErr: true},
+		{name: "missing equals", args: []string{"video_detail"}, wantErr: true},
+		{name: "unknown key", args: []string{"since=20250701"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, dryRun, err := parseReprocessArgs(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseReprocessArgs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if dryRun != tt.wantDryRun {
+				t.Errorf("dryRun = %v, want %v", dryRun, tt.wantDryRun)
+			}
+			w := tt.wantFilter
+			if !slices.Equal(filter.IDs, w.IDs) || filter.DataType != w.DataType || filter.StartDate != w.StartDate ||
+				filter.EndDate != w.EndDate || !slices.Equal(filter.Statuses, w.Statuses) || filter.Limit != w.Limit {
+				t.Errorf("filter = %+v, want %+v", *filter, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Jayleonc/aresdata/internal/conf"
+	"github.com/Jayleonc/aresdata/internal/data"
+	"github.com/Jayleonc/aresdata/internal/report"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SendReportsTask 生成配置中定义的报告并推送到 webhook。
+// 调度器在 process:video_rank 完成后按周期触发，也可以手动指定报告和榜单日期：
+//
+//	-task send:reports period=day
+//	-task send:reports name=day_top50 date=20250701
+type SendReportsTask struct {
+	log       *log.Helper
+	generator *report.Generator
+}
+
+func NewSendReportsTask(logger log.Logger, generator *report.Generator) *SendReportsTask {
+	return &SendReportsTask{
+		log:       log.NewHelper(log.With(logger, "module", "task.send_reports")),
+		generator: generator,
+	}
+}
+
+func (t *SendReportsTask) Name() string {
+	return SendReports
+}
+
+func (t *SendReportsTask) Run(ctx context.Context, args ...string) error {
+	var period, name, datecode string
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return fmt.Errorf("无效的参数 %q，应为 key=value 格式", arg)
+		}
+		switch key {
+		case "period":
+			period = value
+		case "name":
+			name = value
+		case "date":
+			datecode = value
+		default:
+			return fmt.Errorf("未知参数 %q", key)
+		}
+	}
+	if period != "" && !data.IsValidRankPeriod(period) {
+		return fmt.Errorf("不支持的榜单周期: %s", period)
+	}
+
+	reports := t.generator.Reports(period)
+	if name != "" {
+		r, ok := t.generator.Find(name)
+		if !ok {
+			return fmt.Errorf("未定义的报告: %s", name)
+		}
+		reports = []*conf.Reporting_Report{r}
+	}
+	if len(reports) == 0 {
+		t.log.Infof("没有需要生成的报告 (period=%s)", period)
+		return nil
+	}
+
+	var errs []error
+	for _, r := range reports {
+		rankDate := ""
+		if datecode != "" {
+			_, _, rankDate = data.VideoRankPeriodDates(r.Period, datecode)
+		}
+		if err := t.generator.Send(ctx, r, rankDate); err != nil {
+			t.log.Errorf("报告 %s 发送失败: %v", r.Name, err)
+			errs = append(errs, fmt.Errorf("%s: %w", r.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
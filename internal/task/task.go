@@ -9,6 +9,7 @@ import (
 var ProviderSet = wire.NewSet(
 	NewHumanizedScheduler,
 	NewHeadlessTaskProvider,
+	NewHttpTaskProvider,
 	NewTaskSet,
 	NewFetchVideoRankTask,
 	NewFetchVideoTrendTask,
@@ -16,6 +17,15 @@ var ProviderSet = wire.NewSet(
 	NewProcessVideoRankTask,
 	NewProcessVideoDetailHeadlessTask,
 	NewRemedyVideoDetailsHeadlessTask,
+	NewReprocessSourceDataTask,
+	NewBackfillVideoMetricsTask,
+	NewBuildDailyRollupsTask,
+	NewSendReportsTask,
+	NewEvaluateAlertsTask,
+	NewFetchVideoCommentsTask,
+	NewProcessVideoCommentsTask,
+	NewFetchAudienceProfilesTask,
+	NewProcessAudienceProfilesTask,
 )
 
 // NewTaskSet 负责将所有具体的任务实例聚合为一个 []Task 切片
@@ -26,6 +36,15 @@ func NewTaskSet(
 	p10 *ProcessVideoRankTask,
 	p11 *ProcessVideoDetailHeadlessTask,
 	p8 *RemedyVideoDetailsHeadlessTask,
+	p12 *ReprocessSourceDataTask,
+	p13 *BackfillVideoMetricsTask,
+	p14 *BuildDailyRollupsTask,
+	p15 *SendReportsTask,
+	p16 *EvaluateAlertsTask,
+	p17 *FetchVideoCommentsTask,
+	p18 *ProcessVideoCommentsTask,
+	p19 *FetchAudienceProfilesTask,
+	p20 *ProcessAudienceProfilesTask,
 ) []Task {
-	return []Task{p1, p3, p7, p8, p10, p11}
+	return []Task{p1, p3, p7, p8, p10, p11, p12, p13, p14, p15, p16, p17, p18, p19, p20}
 }
@@ -1,9 +1,11 @@
 package utils
 
 import (
+	"math"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 )
 
 // TimeToPtr returns a pointer to a time.Time value.
@@ -23,38 +25,95 @@ func ParseTimeRFC3339(s string) time.Time {
 // ParseRangeStr 解析销量/销售额范围字符串，例如 "7500-1w" 或 "2.5w-5w"
 // 返回范围的低值和高值
 func ParseRangeStr(rangeStr string) (int64, int64) {
-	if rangeStr == "" || rangeStr == "--" {
+	low, high, ok := ParseMetricRange(rangeStr)
+	if !ok {
 		return 0, 0
 	}
+	return int64(low), int64(high)
+}
+
+// ParseMetricRange 解析飞瓜等数据源返回的指标字符串，支持：
+//   - 单位：w/W/万（×1e4）、亿（×1e8）、k/K（×1e3）
+//   - 百分比：例如 "3.5%" 解析为 3.5
+//   - 货币符号和千分位：例如 "¥1,234.5"
+//   - 范围：例如 "7500-1w"、"1w~5w"、"1万至5万"，单值时低值与高值相同
+//
+// 空字符串、"--" 或无法解析时 ok 为 false
+func ParseMetricRange(s string) (low, high float64, ok bool) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "--" || s == "-" {
+		return 0, 0, false
+	}
 
-	parts := strings.Split(rangeStr, "-")
-	lowVal := parseValue(parts[0])
-	if len(parts) > 1 {
-		highVal := parseValue(parts[1])
-		return lowVal, highVal
+	parts := splitRange(s)
+	low, ok = parseMetricValue(parts[0])
+	if !ok {
+		return 0, 0, false
+	}
+	if len(parts) == 1 {
+		return low, low, true
 	}
-	return lowVal, lowVal
+	high, ok = parseMetricValue(parts[1])
+	if !ok {
+		return 0, 0, false
+	}
+	return low, high, true
 }
 
-// parseValue 解析单个值，处理 "w" (万) 单位
-func parseValue(valStr string) int64 {
+// splitRange 按范围分隔符切分，只切第一个分隔符；开头的负号不视为分隔符
+func splitRange(s string) []string {
+	for _, sep := range []string{"~", "至", "-"} {
+		idx := strings.Index(s[1:], sep)
+		if idx >= 0 {
+			idx++
+			return []string{s[:idx], s[idx+len(sep):]}
+		}
+	}
+	return []string{s}
+}
+
+// metricUnits 是指标字符串支持的单位后缀及其倍数
+var metricUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"亿", 1e8},
+	{"万", 1e4},
+	{"w", 1e4},
+	{"W", 1e4},
+	{"k", 1e3},
+	{"K", 1e3},
+	{"%", 1},
+}
+
+// parseMetricValue 解析单个带单位的数值
+func parseMetricValue(valStr string) (float64, bool) {
+	valStr = strings.TrimSpace(valStr)
+	valStr = strings.TrimLeft(valStr, "¥￥$")
+	valStr = strings.ReplaceAll(valStr, ",", "")
 	valStr = strings.TrimSpace(valStr)
 	if valStr == "" {
-		return 0
+		return 0, false
 	}
 
 	var multiplier float64 = 1.0
-	if strings.HasSuffix(valStr, "w") {
-		multiplier = 10000.0
-		valStr = strings.TrimSuffix(valStr, "w")
+	for _, u := range metricUnits {
+		if strings.HasSuffix(valStr, u.suffix) {
+			multiplier = u.multiplier
+			valStr = strings.TrimSpace(strings.TrimSuffix(valStr, u.suffix))
+			break
+		}
 	}
 
 	val, err := strconv.ParseFloat(valStr, 64)
 	if err != nil {
-		return 0
+		return 0, false
 	}
-
-	return int64(val * multiplier)
+	if multiplier == 1 {
+		return val, true
+	}
+	// 消除 1.15*1e4 = 11499.999... 这类浮点误差，避免转整数时被截断
+	return math.Round(val*multiplier*1e6) / 1e6, true
 }
 
 func ParseUnitStrToInt64(str string) int64 {
@@ -64,3 +123,97 @@ func ParseUnitStrToInt64(str string) int64 {
 	}
 	return int64(atoi)
 }
+
+// CategoryPathSeparator 是规范化后类目路径各层级之间的分隔符
+const CategoryPathSeparator = ">"
+
+// ParseCategoryPaths 把飞瓜的类目字符串（如 "美妆>护肤>面霜"）解析为类目路径，每条路径为从一级到末级的类目名。
+// 多个类目之间可以用逗号、分号或竖线分隔；层级之间支持 ">"、"＞"，各层名称会去除首尾空白，空层级被忽略，重复的路径只保留一条。
+func ParseCategoryPaths(s string) [][]string {
+	var paths [][]string
+	seen := make(map[string]struct{})
+	for _, item := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == '，' || r == ';' || r == '；' || r == '|'
+	}) {
+		var names []string
+		for _, name := range strings.FieldsFunc(item, func(r rune) bool {
+			return r == '>' || r == '＞'
+		}) {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		key := strings.Join(names, CategoryPathSeparator)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		paths = append(paths, names)
+	}
+	return paths
+}
+
+// dimensionNameNoise 为名称括号中不区分实体的修饰词，归一化时连同括号一起去掉
+var dimensionNameNoise = map[string]struct{}{
+	"官方":    {},
+	"正品":    {},
+	"自营":    {},
+	"直营":    {},
+	"官方自营":  {},
+	"官方直营":  {},
+	"官方授权":  {},
+	"品牌授权":  {},
+	"旗舰店":   {},
+	"官方旗舰店": {},
+}
+
+// NormalizeDimensionName 生成品牌、店铺等名称的去重键，用于把同一实体的不同写法归并到一起：
+// 全角字符转为半角、英文转小写，去掉空白和标点。括号内的文字保留在去重键中，
+// 以免 "X店（北京）" 与 "X店（上海）" 被归并为同一实体；只有整段为已知修饰词（如 "【官方】"）时才连同括号去掉。
+func NormalizeDimensionName(name string) string {
+	folded := []rune(strings.ToLower(foldWidth(name)))
+	var key, inner strings.Builder
+	depth := 0
+	for _, r := range folded {
+		switch {
+		case r == '(' || r == '[' || r == '【':
+			depth++
+			continue
+		case (r == ')' || r == ']' || r == '】') && depth > 0:
+			depth--
+			if depth == 0 {
+				if _, noise := dimensionNameNoise[inner.String()]; !noise {
+					key.WriteString(inner.String())
+				}
+				inner.Reset()
+			}
+			continue
+		case unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r):
+			continue
+		}
+		if depth > 0 {
+			inner.WriteRune(r)
+		} else {
+			key.WriteRune(r)
+		}
+	}
+	// 未闭合的括号内容按普通文字处理
+	key.WriteString(inner.String())
+	return key.String()
+}
+
+// foldWidth 把全角 ASCII 字符和全角空格转换为半角
+func foldWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '　':
+			return ' '
+		case r >= '！' && r <= '～':
+			return r - 0xFEE0
+		}
+		return r
+	}, s)
+}
@@ -1,6 +1,9 @@
 package utils
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestParseUnitStrToInt64(t *testing.T) {
 	type args struct {
@@ -21,3 +24,103 @@ func TestParseUnitStrToInt64(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMetricRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		wantLow  float64
+		wantHigh float64
+		wantOk   bool
+	}{
+		{name: "plain", str: "5841", wantLow: 5841, wantHigh: 5841, wantOk: true},
+		{name: "wan", str: "2.5w", wantLow: 25000, wantHigh: 25000, wantOk: true},
+		{name: "wan chinese", str: "1.15万", wantLow: 11500, wantHigh: 11500, wantOk: true},
+		{name: "yi", str: "1.2亿", wantLow: 120000000, wantHigh: 120000000, wantOk: true},
+		{name: "k", str: "3.5k", wantLow: 3500, wantHigh: 3500, wantOk: true},
+		{name: "percent", str: "3.52%", wantLow: 3.52, wantHigh: 3.52, wantOk: true},
+		{name: "currency", str: "¥1,234.5", wantLow: 1234.5, wantHigh: 1234.5, wantOk: true},
+		{name: "range", str: "7500-1w", wantLow: 7500, wantHigh: 10000, wantOk: true},
+		{name: "range tilde", str: "1w~5w", wantLow: 10000, wantHigh: 50000, wantOk: true},
+		{name: "range chinese", str: "1万至5万", wantLow: 10000, wantHigh: 50000, wantOk: true},
+		{name: "currency range", str: "¥2.5w-¥5w", wantLow: 25000, wantHigh: 50000, wantOk: true},
+		{name: "empty", str: "", wantOk: false},
+		{name: "placeholder", str: "--", wantOk: false},
+		{name: "invalid", str: "abc", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			low, high, ok := ParseMetricRange(tt.str)
+			if ok != tt.wantOk || low != tt.wantLow || high != tt.wantHigh {
+				t.Errorf("ParseMetricRange(%q) = (%v, %v, %v), want (%v, %v, %v)", tt.str, low, high, ok, tt.wantLow, tt.wantHigh, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestParseRangeStr(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		wantLow  int64
+		wantHigh int64
+	}{
+		{name: "range", str: "7500-1w", wantLow: 7500, wantHigh: 10000},
+		{name: "single", str: "2.5w", wantLow: 25000, wantHigh: 25000},
+		{name: "placeholder", str: "--", wantLow: 0, wantHigh: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			low, high := ParseRangeStr(tt.str)
+			if low != tt.wantLow || high != tt.wantHigh {
+				t.Errorf("ParseRangeStr(%q) = (%v, %v), want (%v, %v)", tt.str, low, high, tt.wantLow, tt.wantHigh)
+			}
+		})
+	}
+}
+
+func TestParseCategoryPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want [][]string
+	}{
+		{name: "single", str: "美妆>护肤>面霜", want: [][]string{{"美妆", "护肤", "面霜"}}},
+		{name: "fullwidth and spaces", str: " 美妆 ＞ 护肤 ", want: [][]string{{"美妆", "护肤"}}},
+		{name: "multiple", str: "美妆>护肤,食品>零食；美妆>护肤", want: [][]string{{"美妆", "护肤"}, {"食品", "零食"}}},
+		{name: "empty levels", str: ">美妆>>彩妆>", want: [][]string{{"美妆", "彩妆"}}},
+		{name: "empty", str: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseCategoryPaths(tt.str); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCategoryPaths(%q) = %v, want %v", tt.str, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeDimensionName(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want string
+	}{
+		{name: "plain", str: "欧莱雅", want: "欧莱雅"},
+		{name: "alias in parentheses", str: "欧莱雅（L'OREAL）", want: "欧莱雅loreal"},
+		{name: "qualifier kept", str: "X店（北京）", want: "x店北京"},
+		{name: "noise in parentheses", str: "花西子（官方旗舰店）", want: "花西子"},
+		{name: "fullwidth and case", str: "ＳＫ－Ⅱ", want: "skⅱ"},
+		{name: "spaces", str: " 完美 日记 官方旗舰店 ", want: "完美日记官方旗舰店"},
+		{name: "only parentheses", str: "(Perfect Diary)", want: "perfectdiary"},
+		{name: "brackets", str: "【官方】花西子", want: "花西子"},
+		{name: "empty", str: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeDimensionName(tt.str); got != tt.want {
+				t.Errorf("NormalizeDimensionName(%q) = %q, want %q", tt.str, got, tt.want)
+			}
+		})
+	}
+}
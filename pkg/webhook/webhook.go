@@ -0,0 +1,237 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// 支持的推送目标类型，钉钉、飞书、企业微信使用各自群机器人的消息格式
+const (
+	TypeGeneric  = "generic"
+	TypeDingTalk = "dingtalk"
+	TypeFeishu   = "feishu"
+	TypeWeCom    = "wecom"
+)
+
+// 消息内容的格式
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatCSV      = "csv"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Message 是一条待推送的消息
+type Message struct {
+	Title   string
+	Format  string
+	Content string
+}
+
+// Target 是一个推送目标
+type Target struct {
+	URL  string
+	Type string
+	// Secret 是钉钉、飞书机器人的加签密钥，为空表示不加签
+	Secret string
+	// Headers 是附加的请求头
+	Headers map[string]string
+	Timeout time.Duration
+}
+
+// Sender 把消息按目标类型组装成对应的请求体并发送
+type Sender struct {
+	client *http.Client
+	// now 便于测试时固定签名时间
+	now func() time.Time
+}
+
+// NewSender 创建 Sender，client 为空时使用 http.DefaultClient
+func NewSender(client *http.Client) *Sender {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Sender{client: client, now: time.Now}
+}
+
+// Send 推送一条消息，HTTP 状态码非 2xx 或机器人返回错误码时返回错误
+func (s *Sender) Send(ctx context.Context, target Target, msg Message) error {
+	if target.URL == "" {
+		return fmt.Errorf("webhook 地址为空")
+	}
+	targetURL, payload, err := s.build(target, msg)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	timeout := target.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	for k, v := range target.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("推送 webhook 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook 返回 HTTP %d: %s", resp.StatusCode, respBody)
+	}
+	return checkBotResponse(respBody)
+}
+
+// build 按目标类型返回实际请求地址和请求体
+func (s *Sender) build(target Target, msg Message) (string, any, error) {
+	switch target.Type {
+	case TypeGeneric, "":
+		return target.URL, map[string]string{
+			"title":   msg.Title,
+			"format":  msg.Format,
+			"content": msg.Content,
+		}, nil
+	case TypeDingTalk:
+		targetURL := target.URL
+		if target.Secret != "" {
+			signed, err := dingTalkSignedURL(target.URL, target.Secret, s.now())
+			if err != nil {
+				return "", nil, err
+			}
+			targetURL = signed
+		}
+		return targetURL, dingTalkPayload(msg), nil
+	case TypeFeishu:
+		payload := feishuPayload(msg)
+		if target.Secret != "" {
+			timestamp := strconv.FormatInt(s.now().Unix(), 10)
+			payload["timestamp"] = timestamp
+			payload["sign"] = feishuSign(timestamp, target.Secret)
+		}
+		return target.URL, payload, nil
+	case TypeWeCom:
+		return target.URL, weComPayload(msg), nil
+	default:
+		return "", nil, fmt.Errorf("不支持的 webhook 类型: %s", target.Type)
+	}
+}
+
+// 群机器人只能渲染 Markdown，HTML、CSV 等其他格式以纯文本发送
+
+func dingTalkPayload(msg Message) map[string]any {
+	if msg.Format == FormatMarkdown {
+		return map[string]any{
+			"msgtype":  "markdown",
+			"markdown": map[string]string{"title": msg.Title, "text": msg.Content},
+		}
+	}
+	return map[string]any{
+		"msgtype": "text",
+		"text":    map[string]string{"content": textWithTitle(msg)},
+	}
+}
+
+func feishuPayload(msg Message) map[string]any {
+	if msg.Format == FormatMarkdown {
+		return map[string]any{
+			"msg_type": "interactive",
+			"card": map[string]any{
+				"header": map[string]any{
+					"title": map[string]string{"tag": "plain_text", "content": msg.Title},
+				},
+				"elements": []map[string]string{{"tag": "markdown", "content": msg.Content}},
+			},
+		}
+	}
+	return map[string]any{
+		"msg_type": "text",
+		"content":  map[string]string{"text": textWithTitle(msg)},
+	}
+}
+
+func weComPayload(msg Message) map[string]any {
+	if msg.Format == FormatMarkdown {
+		return map[string]any{
+			"msgtype":  "markdown",
+			"markdown": map[string]string{"content": msg.Content},
+		}
+	}
+	return map[string]any{
+		"msgtype": "text",
+		"text":    map[string]string{"content": textWithTitle(msg)},
+	}
+}
+
+func textWithTitle(msg Message) string {
+	if msg.Title == "" {
+		return msg.Content
+	}
+	return msg.Title + "\n" + msg.Content
+}
+
+// dingTalkSignedURL 按钉钉机器人的加签规则，在地址上追加 timestamp 和 sign 参数
+func dingTalkSignedURL(rawURL, secret string, now time.Time) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("无效的 webhook 地址: %w", err)
+	}
+	timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + secret))
+	q := u.Query()
+	q.Set("timestamp", timestamp)
+	q.Set("sign", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// feishuSign 按飞书机器人的加签规则计算签名：以 "timestamp\nsecret" 为密钥对空串做 HMAC-SHA256
+func feishuSign(timestamp, secret string) string {
+	mac := hmac.New(sha256.New, []byte(timestamp+"\n"+secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// checkBotResponse 识别机器人在 HTTP 200 中返回的业务错误：
+// 钉钉、企业微信使用 errcode/errmsg，飞书使用 code/msg；非 JSON 响应视为成功
+func checkBotResponse(body []byte) error {
+	var resp struct {
+		ErrCode *int   `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+		Code    *int   `json:"code"`
+		Msg     string `json:"msg"`
+	}
+	if len(body) == 0 || json.Unmarshal(body, &resp) != nil {
+		return nil
+	}
+	if resp.ErrCode != nil && *resp.ErrCode != 0 {
+		return fmt.Errorf("webhook 返回错误 %d: %s", *resp.ErrCode, resp.ErrMsg)
+	}
+	if resp.Code != nil && *resp.Code != 0 {
+		return fmt.Errorf("webhook 返回错误 %d: %s", *resp.Code, resp.Msg)
+	}
+	return nil
+}
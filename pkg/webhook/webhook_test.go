@@ -0,0 +1,179 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// receiver 是本地的 webhook 接收端，记录最近一次请求
+type receiver struct {
+	server *httptest.Server
+	query  url.Values
+	header http.Header
+	body   map[string]any
+}
+
+func newReceiver(t *testing.T, reply string) *receiver {
+	r := &receiver{}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		raw, _ := io.ReadAll(req.Body)
+		r.query = req.URL.Query()
+		r.header = req.Header.Clone()
+		r.body = nil
+		if err := json.Unmarshal(raw, &r.body); err != nil {
+			t.Errorf("请求体不是 JSON: %v", err)
+		}
+		_, _ = io.WriteString(w, reply)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func fixedSender() *Sender {
+	s := NewSender(nil)
+	s.now = func() time.Time { return time.UnixMilli(1700000000000) }
+	return s
+}
+
+func TestSenderPayloads(t *testing.T) {
+	markdown := Message{Title: "日榜 Top 50", Format: FormatMarkdown, Content: "| 排名 |\n|---|\n| 1 |"}
+	tests := []struct {
+		name  string
+		typ   string
+		msg   Message
+		check func(t *testing.T, body map[string]any)
+	}{
+		{
+			name: "generic",
+			typ:  TypeGeneric,
+			msg:  Message{Title: "t", Format: FormatCSV, Content: "a,b\n1,2\n"},
+			check: func(t *testing.T, body map[string]any) {
+				if body["format"] != FormatCSV || body["content"] != "a,b\n1,2\n" || body["title"] != "t" {
+					t.Errorf("generic body = %v", body)
+				}
+			},
+		},
+		{
+			name: "dingtalk markdown",
+			typ:  TypeDingTalk,
+			msg:  markdown,
+			check: func(t *testing.T, body map[string]any) {
+				md, _ := body["markdown"].(map[string]any)
+				if body["msgtype"] != "markdown" || md["title"] != markdown.Title || md["text"] != markdown.Content {
+					t.Errorf("dingtalk body = %v", body)
+				}
+			},
+		},
+		{
+			name: "dingtalk text",
+			typ:  TypeDingTalk,
+			msg:  Message{Title: "t", Format: FormatHTML, Content: "<table></table>"},
+			check: func(t *testing.T, body map[string]any) {
+				text, _ := body["text"].(map[string]any)
+				if body["msgtype"] != "text" || text["content"] != "t\n<table></table>" {
+					t.Errorf("dingtalk body = %v", body)
+				}
+			},
+		},
+		{
+			name: "feishu markdown",
+			typ:  TypeFeishu,
+			msg:  markdown,
+			check: func(t *testing.T, body map[string]any) {
+				card, _ := body["card"].(map[string]any)
+				elements, _ := card["elements"].([]any)
+				if body["msg_type"] != "interactive" || len(elements) != 1 {
+					t.Fatalf("feishu body = %v", body)
+				}
+				if el := elements[0].(map[string]any); el["content"] != markdown.Content {
+					t.Errorf("feishu element = %v", el)
+				}
+			},
+		},
+		{
+			name: "wecom markdown",
+			typ:  TypeWeCom,
+			msg:  markdown,
+			check: func(t *testing.T, body map[string]any) {
+				md, _ := body["markdown"].(map[string]any)
+				if body["msgtype"] != "markdown" || md["content"] != markdown.Content {
+					t.Errorf("wecom body = %v", body)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReceiver(t, `{"errcode":0,"errmsg":"ok"}`)
+			err := fixedSender().Send(context.Background(), Target{URL: r.server.URL, Type: tt.typ}, tt.msg)
+			if err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+			tt.check(t, r.body)
+		})
+	}
+}
+
+func TestSenderSign(t *testing.T) {
+	t.Run("dingtalk", func(t *testing.T) {
+		r := newReceiver(t, `{"errcode":0}`)
+		target := Target{URL: r.server.URL + "/robot/send?access_token=abc", Type: TypeDingTalk, Secret: "SEC123"}
+		if err := fixedSender().Send(context.Background(), target, Message{Format: FormatMarkdown}); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+		if r.query.Get("access_token") != "abc" || r.query.Get("timestamp") != "1700000000000" || r.query.Get("sign") == "" {
+			t.Errorf("dingtalk query = %v", r.query)
+		}
+	})
+	t.Run("feishu", func(t *testing.T) {
+		r := newReceiver(t, `{"code":0,"msg":"success"}`)
+		target := Target{URL: r.server.URL, Type: TypeFeishu, Secret: "SEC123"}
+		if err := fixedSender().Send(context.Background(), target, Message{Format: FormatMarkdown}); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+		if r.body["timestamp"] != "1700000000" || r.body["sign"] != feishuSign("1700000000", "SEC123") {
+			t.Errorf("feishu body = %v", r.body)
+		}
+	})
+}
+
+func TestSenderErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		reply string
+		code  int
+	}{
+		{name: "http status", reply: "boom", code: http.StatusInternalServerError},
+		{name: "dingtalk errcode", reply: `{"errcode":310000,"errmsg":"sign not match"}`, code: http.StatusOK},
+		{name: "feishu code", reply: `{"code":19021,"msg":"sign match fail"}`, code: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.code)
+				_, _ = io.WriteString(w, tt.reply)
+			}))
+			defer server.Close()
+			if err := NewSender(nil).Send(context.Background(), Target{URL: server.URL}, Message{}); err == nil {
+				t.Errorf("Send() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestSenderHeaders(t *testing.T) {
+	r := newReceiver(t, "ok")
+	target := Target{URL: r.server.URL, Headers: map[string]string{"X-Token": "t1"}}
+	if err := NewSender(nil).Send(context.Background(), target, Message{}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if r.header.Get("X-Token") != "t1" {
+		t.Errorf("header X-Token = %q", r.header.Get("X-Token"))
+	}
+}